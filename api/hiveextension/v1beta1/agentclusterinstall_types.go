@@ -78,6 +78,24 @@ const (
 	ClusterInputErrorReason   string = "InputError"
 	ClusterInputErrorMsg      string = "The Spec could not be synced due to an input error:"
 
+	ClusterSpokeAPIReachableCondition hivev1.ClusterInstallConditionType = "SpokeAPIReachable"
+	ClusterSpokeAPIReachableReason    string                             = "SpokeAPIReachable"
+	ClusterSpokeAPIReachableMsg       string                             = "The API of the installed cluster is reachable"
+	ClusterSpokeAPIUnreachableReason  string                             = "SpokeAPIUnreachable"
+	ClusterSpokeAPIUnreachableMsg     string                             = "The API of the installed cluster is unreachable:"
+
+	ClusterDryRunCondition              hivev1.ClusterInstallConditionType = "DryRun"
+	ClusterDryRunNoChangesReason        string                             = "NoChanges"
+	ClusterDryRunNoChangesMsg           string                             = "The Spec matches the cluster, no change would be applied"
+	ClusterDryRunChangesPendingReason   string                             = "ChangesPending"
+	ClusterDryRunChangesPendingMsg      string                             = "The Spec would apply the changes listed in the dry run status"
+	ClusterDryRunValidationFailedReason string                             = "ValidationFailed"
+	ClusterDryRunValidationFailedMsg    string                             = "The changes of the Spec would be rejected:"
+	ClusterDryRunNotRegisteredReason    string                             = "ClusterNotRegistered"
+	ClusterDryRunNotRegisteredMsg       string                             = "The cluster would be registered, the dry run only covers the changes of registered clusters"
+	ClusterDryRunReason                 string                             = "DryRun"
+	ClusterDryRunMsg                    string                             = "The Spec is not applied while the dry-run annotation is set"
+
 	ClusterLastInstallationPreparationFailedOKReason    string                             = "There is no failing prior preparation attempt"
 	ClusterLastInstallationPreparationFailedErrorReason string                             = "The last installation preparation failed"
 	ClusterLastInstallationPreparationPending           string                             = "Cluster preparation has never been performed for this cluster"
@@ -220,6 +238,11 @@ type AgentClusterInstallSpec struct {
 	// LoadBalancer defines the load balancer used by the cluster for ingress traffic.
 	// +optional
 	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
+
+	// CSRApprovalPolicy defines how the certificate signing requests of the nodes added to the installed cluster
+	// are approved by the hub.
+	// +optional
+	CSRApprovalPolicy *CSRApprovalPolicy `json:"csrApprovalPolicy,omitempty"`
 }
 
 // IgnitionEndpoint stores the data to of the custom ignition endpoint.
@@ -298,6 +321,39 @@ type AgentClusterInstallStatus struct {
 	// ValidationsInfo is a JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)
 	// +optional
 	ValidationsInfo common.ValidationsStatus `json:"validationsInfo,omitempty"`
+
+	// DryRun is the result of the dry run of the Spec, set while the dry-run annotation is set.
+	// +optional
+	DryRun *DryRunStatus `json:"dryRun,omitempty"`
+}
+
+// DryRunStatus lists the changes of the cluster parameters that the Spec would apply.
+type DryRunStatus struct {
+	// ObservedGeneration is the generation of the AgentClusterInstall the dry run was computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Changes are the cluster parameters that would be updated.
+	// +optional
+	Changes []DryRunChange `json:"changes,omitempty"`
+
+	// ValidationError is the error the inventory would reject the changes with, including the unsupported features.
+	// +optional
+	ValidationError string `json:"validationError,omitempty"`
+}
+
+// DryRunChange is a cluster parameter that would be updated.
+type DryRunChange struct {
+	// Field is the name of the parameter in the cluster update API of the inventory.
+	Field string `json:"field"`
+
+	// Current is the JSON value of the parameter in the cluster.
+	// +optional
+	Current string `json:"current,omitempty"`
+
+	// Desired is the JSON value of the parameter that would be applied.
+	// +optional
+	Desired string `json:"desired,omitempty"`
 }
 
 type DebugInfo struct {
@@ -551,6 +607,38 @@ const (
 	LoadBalancerTypeUserManaged LoadBalancerType = "UserManaged"
 )
 
+// CSRApprovalPolicy defines how the certificate signing requests of the nodes are approved by the hub.
+type CSRApprovalPolicy struct {
+	// Mode defines whether the hub approves the certificate signing requests of the nodes. With Automatic, the
+	// requests matching the hostname and the addresses of an Agent are approved. With Manual, the requests must be
+	// approved in the installed cluster by the user. The default value is Automatic.
+	// +kubebuilder:default:="Automatic"
+	// +kubebuilder:validation:Enum:="Automatic";"Manual"
+	// +optional
+	Mode CSRApprovalMode `json:"mode,omitempty"`
+
+	// AdditionalSANs are the DNS names and IP addresses allowed in the serving certificate signing requests of the
+	// nodes, in addition to the addresses of the nodes.
+	// +optional
+	AdditionalSANs []string `json:"additionalSANs,omitempty"`
+
+	// ApprovalWindow is the duration, from the first approval attempt for an Agent, during which the certificate
+	// signing requests of its node are approved. The requests are approved until the node is ready when not set.
+	// +optional
+	ApprovalWindow *metav1.Duration `json:"approvalWindow,omitempty"`
+}
+
+// CSRApprovalMode defines whether the hub approves the certificate signing requests of the nodes.
+type CSRApprovalMode string
+
+const (
+	// CSRApprovalModeAutomatic approves the certificate signing requests matching the Agents
+	CSRApprovalModeAutomatic CSRApprovalMode = "Automatic"
+
+	// CSRApprovalModeManual leaves the approval of the certificate signing requests to the user
+	CSRApprovalModeManual CSRApprovalMode = "Manual"
+)
+
 func init() {
 	SchemeBuilder.Register(&AgentClusterInstall{}, &AgentClusterInstallList{})
 }
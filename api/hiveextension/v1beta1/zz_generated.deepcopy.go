@@ -24,6 +24,7 @@ import (
 	"github.com/openshift/assisted-service/api/common"
 	"github.com/openshift/hive/apis/hive/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -167,6 +168,11 @@ func (in *AgentClusterInstallSpec) DeepCopyInto(out *AgentClusterInstallSpec) {
 		*out = new(LoadBalancer)
 		**out = **in
 	}
+	if in.CSRApprovalPolicy != nil {
+		in, out := &in.CSRApprovalPolicy, &out.CSRApprovalPolicy
+		*out = new(CSRApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentClusterInstallSpec.
@@ -227,6 +233,11 @@ func (in *AgentClusterInstallStatus) DeepCopyInto(out *AgentClusterInstallStatus
 			(*out)[key] = outVal
 		}
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(DryRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentClusterInstallStatus.
@@ -254,6 +265,31 @@ func (in *AgentMachinePool) DeepCopy() *AgentMachinePool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRApprovalPolicy) DeepCopyInto(out *CSRApprovalPolicy) {
+	*out = *in
+	if in.AdditionalSANs != nil {
+		in, out := &in.AdditionalSANs, &out.AdditionalSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApprovalWindow != nil {
+		in, out := &in.ApprovalWindow, &out.ApprovalWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRApprovalPolicy.
+func (in *CSRApprovalPolicy) DeepCopy() *CSRApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CaCertificateReference) DeepCopyInto(out *CaCertificateReference) {
 	*out = *in
@@ -339,6 +375,41 @@ func (in *DiskEncryption) DeepCopy() *DiskEncryption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunChange) DeepCopyInto(out *DryRunChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunChange.
+func (in *DryRunChange) DeepCopy() *DryRunChange {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunStatus) DeepCopyInto(out *DryRunStatus) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]DryRunChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunStatus.
+func (in *DryRunStatus) DeepCopy() *DryRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalPlatformSpec) DeepCopyInto(out *ExternalPlatformSpec) {
 	*out = *in
@@ -92,6 +92,42 @@ const (
 
 	CleanupCondition    conditionsv1.ConditionType = "Cleanup"
 	CleanupFailedReason string                     = "CleanupFailed"
+
+	NodeRemovedCondition         conditionsv1.ConditionType = "NodeRemoved"
+	NodeRemovedReason            string                     = "NodeRemoved"
+	NodeRemovedMsg               string                     = "The node was removed from the cluster and the agent was returned to its infraenv"
+	NodeDrainingReason           string                     = "Draining"
+	NodeDrainingMsg              string                     = "The node is cordoned and its pods are being evicted:"
+	NodeDrainTimedOutReason      string                     = "DrainTimedOut"
+	NodeDrainTimedOutMsg         string                     = "The node drain timed out, continuing with the removal of the node"
+	NodeRemovingEtcdMemberReason string                     = "RemovingEtcdMember"
+	NodeRemovingEtcdMemberMsg    string                     = "Removing the etcd member of the control plane node:"
+	NodeEtcdRemovalRefusedReason string                     = "EtcdMemberRemovalRefused"
+	NodeEtcdRemovalRefusedMsg    string                     = "The etcd member of the control plane node can't be safely removed:"
+	NodeReclaimingReason         string                     = "Reclaiming"
+	NodeReclaimingMsg            string                     = "The agent is unbound from the cluster, the node is deleted once the host is back in its infraenv"
+	NodeRemovalFailedReason      string                     = "NodeRemovalFailed"
+	NodeRemovalFailedMsg         string                     = "The removal of the node failed:"
+
+	ControlPlaneReplacedCondition       conditionsv1.ConditionType = "ControlPlaneReplaced"
+	ControlPlaneReplacedReason          string                     = "Replaced"
+	ControlPlaneReplacedMsg             string                     = "The control plane node was replaced and its etcd member is started"
+	RemovingReplacedNodeReason          string                     = "RemovingReplacedNode"
+	RemovingReplacedNodeMsg             string                     = "Removing the etcd member and the node of the replaced control plane node:"
+	ReadyForReplacementReason           string                     = "ReadyForReplacement"
+	ReadyForReplacementMsg              string                     = "The replaced control plane node was removed, the agent can be installed"
+	WaitingForEtcdMemberReason          string                     = "WaitingForEtcdMember"
+	WaitingForEtcdMemberMsg             string                     = "The node joined the cluster, waiting for its etcd member to be started"
+	ControlPlaneReplacementFailedReason string                     = "ReplacementFailed"
+	ControlPlaneReplacementFailedMsg    string                     = "The replacement of the control plane node failed:"
+
+	CSRsApprovedCondition conditionsv1.ConditionType = "CSRsApproved"
+	CSRsApprovedReason    string                     = "CSRsApproved"
+	CSRsApprovedMsg       string                     = "The client and serving certificate signing requests of the node are approved"
+	CSRsPendingReason     string                     = "CSRsPending"
+	CSRsPendingMsg        string                     = "Waiting for the client and serving certificate signing requests of the node"
+	CSRsRefusedReason     string                     = "CSRsRefused"
+	CSRsRefusedMsg        string                     = "The hub refused to approve certificate signing requests of the node, they must be approved by the user:"
 )
 
 type HostMemory struct {
@@ -320,11 +356,26 @@ type CSRInfo struct {
 	ApprovedAt metav1.Time `json:"approvedAt"`
 }
 
+// RefusedCSRInfo tracks information about a pending CSR of the agent that was not approved
+type RefusedCSRInfo struct {
+	Name   string  `json:"name"`
+	Type   CSRType `json:"type"`
+	Reason string  `json:"reason"`
+}
+
 // CSRStatus tracks the status of CSR approvals for the agent
 type CSRStatus struct {
 	// CSRs that have been approved for the agent by the assisted-service
 	ApprovedCSRs []CSRInfo `json:"approvedCSRs,omitempty"`
 
+	// Pending CSRs of the agent that the assisted-service refused to approve, and the reason
+	// +optional
+	RefusedCSRs []RefusedCSRInfo `json:"refusedCSRs,omitempty"`
+
+	// First time we attempted a CSR approval, the start of the approval window
+	// +optional
+	FirstApprovalAttempt metav1.Time `json:"firstApprovalAttempt,omitempty"`
+
 	// Last time we attempted a CSR approval
 	LastApprovalAttempt metav1.Time `json:"lastApprovalAttempt,omitempty"`
 }
@@ -0,0 +1,100 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	AgentPoolScaledCondition  conditionsv1.ConditionType = "Scaled"
+	AgentPoolScaledReason     string                     = "Scaled"
+	AgentPoolScalingReason    string                     = "Scaling"
+	AgentPoolMissingReason    string                     = "NotEnoughAgents"
+	AgentPoolInputErrorReason string                     = "InputError"
+)
+
+// AgentPoolSpec defines the desired state of AgentPool
+type AgentPoolSpec struct {
+	// ClusterDeploymentName is the cluster the Agents of the pool are bound to
+	ClusterDeploymentName ClusterReference `json:"clusterDeploymentName"`
+
+	// AgentSelector selects the Agents, in the namespace of the pool, that can be added to the pool
+	AgentSelector metav1.LabelSelector `json:"agentSelector"`
+
+	// Replicas is the desired number of Agents bound to the cluster by the pool
+	//
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Role is the role given to the Agents of the pool
+	//
+	// +kubebuilder:default=worker
+	// +kubebuilder:validation:Enum=master;worker
+	// +optional
+	Role models.HostRole `json:"role,omitempty"`
+
+	// MachineConfigPool is the machine config pool given to the Agents of the pool
+	// +optional
+	MachineConfigPool string `json:"machineConfigPool,omitempty"`
+}
+
+// AgentPoolStatus defines the observed state of AgentPool
+type AgentPoolStatus struct {
+	// Replicas is the number of Agents currently bound to the cluster by the pool
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// InstalledReplicas is the number of Agents of the pool that are installed
+	InstalledReplicas int32 `json:"installedReplicas,omitempty"`
+
+	// AvailableAgents is the number of Agents matching the selector that can still be added to the pool
+	AvailableAgents int32 `json:"availableAgents,omitempty"`
+
+	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterDeploymentName.name",description="The cluster the Agents are bound to"
+//+kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=".spec.replicas",description="The desired number of Agents"
+//+kubebuilder:printcolumn:name="Current",type="integer",JSONPath=".status.replicas",description="The number of bound Agents"
+//+kubebuilder:printcolumn:name="Installed",type="integer",JSONPath=".status.installedReplicas",description="The number of installed Agents"
+
+// AgentPool is the Schema for the AgentPools API. It approves, binds and installs the Agents matching its selector
+// until the desired number of replicas is reached, and unbinds the extra Agents when scaled down.
+type AgentPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AgentPoolSpec   `json:"spec,omitempty"`
+	Status AgentPoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AgentPoolList contains a list of AgentPool
+type AgentPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AgentPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AgentPool{}, &AgentPoolList{})
+}
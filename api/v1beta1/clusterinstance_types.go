@@ -0,0 +1,227 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ClusterInstanceRenderedCondition conditionsv1.ConditionType = "Rendered"
+	ClusterInstanceRenderedReason    string                     = "Rendered"
+	ClusterInstanceInputErrorReason  string                     = "InputError"
+	ClusterInstanceRenderErrorReason string                     = "RenderError"
+
+	ClusterInstanceProvisionedCondition   conditionsv1.ConditionType = "Provisioned"
+	ClusterInstanceProvisionedReason      string                     = "Completed"
+	ClusterInstanceProvisioningReason     string                     = "InProgress"
+	ClusterInstanceProvisionFailedReason  string                     = "Failed"
+	ClusterInstanceProvisionPendingReason string                     = "Pending"
+)
+
+// ClusterInstanceSpec defines the desired state of ClusterInstance
+type ClusterInstanceSpec struct {
+	// ClusterName is the name of the cluster, used in its domain
+	ClusterName string `json:"clusterName"`
+
+	// BaseDomain is the base domain of the cluster
+	BaseDomain string `json:"baseDomain"`
+
+	// ClusterImageSetName is the name of the ClusterImageSet of the OpenShift release to install
+	ClusterImageSetName string `json:"clusterImageSetName"`
+
+	// PullSecretRef is the reference to the secret holding the pull secret, in the namespace of the ClusterInstance
+	PullSecretRef corev1.LocalObjectReference `json:"pullSecretRef"`
+
+	// SSHPublicKey is installed on the nodes and added to the discovery image
+	// +optional
+	SSHPublicKey string `json:"sshPublicKey,omitempty"`
+
+	// CPUArchitecture is the architecture of the nodes
+	// +kubebuilder:default=x86_64
+	// +kubebuilder:validation:Enum=x86_64;aarch64;arm64;ppc64le;s390x
+	// +optional
+	CPUArchitecture string `json:"cpuArchitecture,omitempty"`
+
+	// Networking is the networking configuration of the cluster
+	// +optional
+	Networking hiveext.Networking `json:"networking,omitempty"`
+
+	// APIVIPs are the virtual IPs of the API, for clusters with more than one control plane node
+	// +optional
+	APIVIPs []string `json:"apiVIPs,omitempty"`
+
+	// IngressVIPs are the virtual IPs of the ingress, for clusters with more than one control plane node
+	// +optional
+	IngressVIPs []string `json:"ingressVIPs,omitempty"`
+
+	// PlatformType is the platform of the cluster
+	// +optional
+	PlatformType hiveext.PlatformType `json:"platformType,omitempty"`
+
+	// Proxy is the proxy used by the discovery image and the cluster
+	// +optional
+	Proxy *Proxy `json:"proxy,omitempty"`
+
+	// AdditionalNTPSources are the NTP sources added to the nodes
+	// +optional
+	AdditionalNTPSources []string `json:"additionalNTPSources,omitempty"`
+
+	// HoldInstallation prevents the installation from starting once the nodes are ready
+	// +optional
+	HoldInstallation bool `json:"holdInstallation,omitempty"`
+
+	// Operators are the OLM operators installed on the cluster, through subscriptions added to its manifests
+	// +optional
+	Operators []ClusterInstanceOperator `json:"operators,omitempty"`
+
+	// ManifestsConfigMapRefs are the references to the ConfigMaps of the manifests added to the installation
+	// +optional
+	ManifestsConfigMapRefs []hiveext.ManifestsConfigMapReference `json:"manifestsConfigMapRefs,omitempty"`
+
+	// Nodes are the hosts of the cluster
+	// +kubebuilder:validation:MinItems=1
+	Nodes []ClusterInstanceNode `json:"nodes"`
+}
+
+// ClusterInstanceOperator is an OLM operator subscribed to on the cluster
+type ClusterInstanceOperator struct {
+	// Name is the name of the package of the operator
+	Name string `json:"name"`
+
+	// Namespace is the namespace the operator is installed in
+	Namespace string `json:"namespace"`
+
+	// Channel is the channel of the subscription, the default channel of the package is used when empty
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// Source is the name of the catalog source of the package
+	// +kubebuilder:default=redhat-operators
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// SourceNamespace is the namespace of the catalog source of the package
+	// +kubebuilder:default=openshift-marketplace
+	// +optional
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+}
+
+// ClusterInstanceNode is a host of the cluster, managed through its BMC
+type ClusterInstanceNode struct {
+	// HostName is the name of the host and of its BareMetalHost
+	HostName string `json:"hostName"`
+
+	// Role is the role of the host in the cluster
+	// +kubebuilder:default=master
+	// +kubebuilder:validation:Enum=master;worker
+	// +optional
+	Role models.HostRole `json:"role,omitempty"`
+
+	// BMC is the address and the credentials of the BMC of the host
+	BMC ClusterInstanceBMC `json:"bmc"`
+
+	// BootMACAddress is the MAC address of the NIC the host boots from
+	BootMACAddress string `json:"bootMACAddress"`
+
+	// NodeNetwork is the static network configuration of the host
+	// +optional
+	NodeNetwork *NMStateConfigSpec `json:"nodeNetwork,omitempty"`
+
+	// InstallerArgs are the additional arguments of coreos-installer, as a JSON array
+	// +optional
+	InstallerArgs string `json:"installerArgs,omitempty"`
+
+	// IgnitionConfigOverride is the ignition config merged into the one of the host
+	// +optional
+	IgnitionConfigOverride string `json:"ignitionConfigOverride,omitempty"`
+}
+
+type ClusterInstanceBMC struct {
+	// Address is the address of the BMC, in the format of the BareMetalHost
+	Address string `json:"address"`
+
+	// CredentialsName is the name of the secret holding the username and the password of the BMC
+	CredentialsName string `json:"credentialsName"`
+
+	// DisableCertificateVerification disables the verification of the certificate of the BMC
+	// +optional
+	DisableCertificateVerification bool `json:"disableCertificateVerification,omitempty"`
+}
+
+// ClusterInstanceStatus defines the observed state of ClusterInstance
+type ClusterInstanceStatus struct {
+	// ClusterDeploymentRef is the reference to the rendered ClusterDeployment
+	// +optional
+	ClusterDeploymentRef *corev1.LocalObjectReference `json:"clusterDeploymentRef,omitempty"`
+
+	// InstallState is the state of the installation of the cluster
+	// +optional
+	InstallState string `json:"installState,omitempty"`
+
+	// Nodes are the states of the hosts of the cluster
+	// +optional
+	Nodes []ClusterInstanceNodeStatus `json:"nodes,omitempty"`
+
+	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+}
+
+type ClusterInstanceNodeStatus struct {
+	// HostName is the name of the host
+	HostName string `json:"hostName"`
+
+	// AgentName is the name of the Agent of the host, once it has registered
+	// +optional
+	AgentName string `json:"agentName,omitempty"`
+
+	// State is the state of the Agent of the host
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName",description="The name of the cluster"
+//+kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.installState",description="The state of the installation"
+
+// ClusterInstance is the Schema for the ClusterInstances API. It renders and owns the ClusterDeployment, the
+// AgentClusterInstall, the InfraEnv, the NMStateConfigs and the BareMetalHosts provisioning a cluster, and reports
+// their aggregated status.
+type ClusterInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterInstanceSpec   `json:"spec,omitempty"`
+	Status ClusterInstanceStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterInstanceList contains a list of ClusterInstance
+type ClusterInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterInstance{}, &ClusterInstanceList{})
+}
@@ -34,6 +34,8 @@ const (
 	MissingClusterDeploymentReference = "ClusterDeployment is missing"
 	InfraEnvAvailableReason           = "InfraEnvAvailable"
 	InfraEnvAvailableMessage          = "InfraEnv is available"
+	ImageUpdatePendingReason          = "ImageUpdatePending"
+	ImageStateUpdatePending           = "The image is held until the pending NMStateConfig changes are applied"
 )
 
 // ClusterReference represents a Cluster Reference. It has enough information to retrieve cluster
@@ -202,6 +204,43 @@ type InfraEnvStatus struct {
 	// BootArtifacts specifies the URLs for each boot artifact
 	// +optional
 	BootArtifacts BootArtifacts `json:"bootArtifacts"`
+	// ImageHistory lists the latest generations of the discovery image, the most recent first.
+	// +optional
+	ImageHistory []ImageGeneration `json:"imageHistory,omitempty"`
+	// ImageInputDigests are the digests of the inputs of the current discovery image, by trigger. They are
+	// compared with the inputs of the next generation of the image to find its triggers.
+	// +optional
+	ImageInputDigests map[ImageGenerationTrigger]string `json:"imageInputDigests,omitempty"`
+}
+
+// ImageGenerationTrigger is a change of the InfraEnv that causes the generation of its discovery image
+type ImageGenerationTrigger string
+
+const (
+	ImageGenerationTriggerCreated                ImageGenerationTrigger = "InfraEnvCreated"
+	ImageGenerationTriggerNMStateConfig          ImageGenerationTrigger = "NMStateConfigChanged"
+	ImageGenerationTriggerProxy                  ImageGenerationTrigger = "ProxyChanged"
+	ImageGenerationTriggerNTPSources             ImageGenerationTrigger = "AdditionalNTPSourcesChanged"
+	ImageGenerationTriggerImageType              ImageGenerationTrigger = "ImageTypeChanged"
+	ImageGenerationTriggerOSImage                ImageGenerationTrigger = "OSImageChanged"
+	ImageGenerationTriggerIgnitionConfigOverride ImageGenerationTrigger = "IgnitionConfigOverrideChanged"
+	ImageGenerationTriggerSSHAuthorizedKey       ImageGenerationTrigger = "SSHAuthorizedKeyChanged"
+	ImageGenerationTriggerAdditionalTrustBundle  ImageGenerationTrigger = "AdditionalTrustBundleChanged"
+	ImageGenerationTriggerKernelArguments        ImageGenerationTrigger = "KernelArgumentsChanged"
+	ImageGenerationTriggerPullSecret             ImageGenerationTrigger = "PullSecretChanged"
+	ImageGenerationTriggerMirrorRegistry         ImageGenerationTrigger = "MirrorRegistryChanged"
+	ImageGenerationTriggerSigningKey             ImageGenerationTrigger = "SigningKeyRegenerated"
+)
+
+// ImageGeneration records a generation of the discovery image of the InfraEnv
+type ImageGeneration struct {
+	// Time is the time the image was generated.
+	Time metav1.Time `json:"time"`
+	// Triggers are the changes of the InfraEnv that caused the generation of the image.
+	// +optional
+	Triggers []ImageGenerationTrigger `json:"triggers,omitempty"`
+	// ImageID identifies the content of the generated image, it changes with the inputs of the image.
+	ImageID string `json:"imageID"`
 }
 
 type InfraEnvDebugInfo struct {
@@ -214,6 +214,104 @@ func (in *AgentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPool) DeepCopyInto(out *AgentPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPool.
+func (in *AgentPool) DeepCopy() *AgentPool {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolList) DeepCopyInto(out *AgentPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AgentPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolList.
+func (in *AgentPoolList) DeepCopy() *AgentPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolSpec) DeepCopyInto(out *AgentPoolSpec) {
+	*out = *in
+	out.ClusterDeploymentName = in.ClusterDeploymentName
+	in.AgentSelector.DeepCopyInto(&out.AgentSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolSpec.
+func (in *AgentPoolSpec) DeepCopy() *AgentPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolStatus) DeepCopyInto(out *AgentPoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolStatus.
+func (in *AgentPoolStatus) DeepCopy() *AgentPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentServiceConfig) DeepCopyInto(out *AgentServiceConfig) {
 	*out = *in
@@ -491,6 +589,12 @@ func (in *CSRStatus) DeepCopyInto(out *CSRStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RefusedCSRs != nil {
+		in, out := &in.RefusedCSRs, &out.RefusedCSRs
+		*out = make([]RefusedCSRInfo, len(*in))
+		copy(*out, *in)
+	}
+	in.FirstApprovalAttempt.DeepCopyInto(&out.FirstApprovalAttempt)
 	in.LastApprovalAttempt.DeepCopyInto(&out.LastApprovalAttempt)
 }
 
@@ -504,6 +608,217 @@ func (in *CSRStatus) DeepCopy() *CSRStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInstance) DeepCopyInto(out *ClusterInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstance.
+func (in *ClusterInstance) DeepCopy() *ClusterInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInstanceBMC) DeepCopyInto(out *ClusterInstanceBMC) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstanceBMC.
+func (in *ClusterInstanceBMC) DeepCopy() *ClusterInstanceBMC {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInstanceBMC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInstanceList) DeepCopyInto(out *ClusterInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstanceList.
+func (in *ClusterInstanceList) DeepCopy() *ClusterInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInstanceNode) DeepCopyInto(out *ClusterInstanceNode) {
+	*out = *in
+	out.BMC = in.BMC
+	if in.NodeNetwork != nil {
+		in, out := &in.NodeNetwork, &out.NodeNetwork
+		*out = new(NMStateConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstanceNode.
+func (in *ClusterInstanceNode) DeepCopy() *ClusterInstanceNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInstanceNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInstanceNodeStatus) DeepCopyInto(out *ClusterInstanceNodeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstanceNodeStatus.
+func (in *ClusterInstanceNodeStatus) DeepCopy() *ClusterInstanceNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInstanceNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInstanceOperator) DeepCopyInto(out *ClusterInstanceOperator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstanceOperator.
+func (in *ClusterInstanceOperator) DeepCopy() *ClusterInstanceOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInstanceOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInstanceSpec) DeepCopyInto(out *ClusterInstanceSpec) {
+	*out = *in
+	out.PullSecretRef = in.PullSecretRef
+	in.Networking.DeepCopyInto(&out.Networking)
+	if in.APIVIPs != nil {
+		in, out := &in.APIVIPs, &out.APIVIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IngressVIPs != nil {
+		in, out := &in.IngressVIPs, &out.IngressVIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(Proxy)
+		**out = **in
+	}
+	if in.AdditionalNTPSources != nil {
+		in, out := &in.AdditionalNTPSources, &out.AdditionalNTPSources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Operators != nil {
+		in, out := &in.Operators, &out.Operators
+		*out = make([]ClusterInstanceOperator, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManifestsConfigMapRefs != nil {
+		in, out := &in.ManifestsConfigMapRefs, &out.ManifestsConfigMapRefs
+		*out = make([]hiveextensionv1beta1.ManifestsConfigMapReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]ClusterInstanceNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstanceSpec.
+func (in *ClusterInstanceSpec) DeepCopy() *ClusterInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInstanceStatus) DeepCopyInto(out *ClusterInstanceStatus) {
+	*out = *in
+	if in.ClusterDeploymentRef != nil {
+		in, out := &in.ClusterDeploymentRef, &out.ClusterDeploymentRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]ClusterInstanceNodeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstanceStatus.
+func (in *ClusterInstanceStatus) DeepCopy() *ClusterInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterReference) DeepCopyInto(out *ClusterReference) {
 	*out = *in
@@ -894,6 +1209,27 @@ func (in *IgnitionEndpointTokenReference) DeepCopy() *IgnitionEndpointTokenRefer
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageGeneration) DeepCopyInto(out *ImageGeneration) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]ImageGenerationTrigger, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageGeneration.
+func (in *ImageGeneration) DeepCopy() *ImageGeneration {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageGeneration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfraEnv) DeepCopyInto(out *InfraEnv) {
 	*out = *in
@@ -1043,6 +1379,20 @@ func (in *InfraEnvStatus) DeepCopyInto(out *InfraEnvStatus) {
 	in.AgentLabelSelector.DeepCopyInto(&out.AgentLabelSelector)
 	out.InfraEnvDebugInfo = in.InfraEnvDebugInfo
 	out.BootArtifacts = in.BootArtifacts
+	if in.ImageHistory != nil {
+		in, out := &in.ImageHistory, &out.ImageHistory
+		*out = make([]ImageGeneration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImageInputDigests != nil {
+		in, out := &in.ImageInputDigests, &out.ImageInputDigests
+		*out = make(map[ImageGenerationTrigger]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraEnvStatus.
@@ -1273,3 +1623,18 @@ func (in RawNetConfig) DeepCopy() RawNetConfig {
 	in.DeepCopyInto(out)
 	return *out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RefusedCSRInfo) DeepCopyInto(out *RefusedCSRInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RefusedCSRInfo.
+func (in *RefusedCSRInfo) DeepCopy() *RefusedCSRInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RefusedCSRInfo)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/openshift/assisted-service/client/events"
+	"github.com/openshift/assisted-service/client/federation"
 	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/managed_domains"
 	"github.com/openshift/assisted-service/client/manifests"
@@ -64,6 +65,7 @@ func New(c Config) *AssistedInstall {
 	cli := new(AssistedInstall)
 	cli.Transport = transport
 	cli.Events = events.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Federation = federation.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Installer = installer.New(transport, strfmt.Default, c.AuthInfo)
 	cli.ManagedDomains = managed_domains.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Manifests = manifests.New(transport, strfmt.Default, c.AuthInfo)
@@ -75,6 +77,7 @@ func New(c Config) *AssistedInstall {
 // AssistedInstall is a client for assisted install
 type AssistedInstall struct {
 	Events         *events.Client
+	Federation     *federation.Client
 	Installer      *installer.Client
 	ManagedDomains *managed_domains.Client
 	Manifests      *manifests.Client
@@ -88,6 +88,12 @@ type V2ListEventsParams struct {
 	*/
 	DeletedHosts *bool
 
+	/* Federated.
+
+	   Include the events of all the member instances of the federation.
+	*/
+	Federated *bool
+
 	/* HostID.
 
 	   A host in the specified cluster to return events for (DEPRECATED. Use `host_ids` instead).
@@ -160,11 +166,14 @@ func (o *V2ListEventsParams) WithDefaults() *V2ListEventsParams {
 // All values with no default are reset to their zero value.
 func (o *V2ListEventsParams) SetDefaults() {
 	var (
+		federatedDefault = bool(false)
+
 		orderDefault = string("ascending")
 	)
 
 	val := V2ListEventsParams{
-		Order: &orderDefault,
+		Federated: &federatedDefault,
+		Order:     &orderDefault,
 	}
 
 	val.timeout = o.timeout
@@ -250,6 +259,17 @@ func (o *V2ListEventsParams) SetDeletedHosts(deletedHosts *bool) {
 	o.DeletedHosts = deletedHosts
 }
 
+// WithFederated adds the federated to the v2 list events params
+func (o *V2ListEventsParams) WithFederated(federated *bool) *V2ListEventsParams {
+	o.SetFederated(federated)
+	return o
+}
+
+// SetFederated adds the federated to the v2 list events params
+func (o *V2ListEventsParams) SetFederated(federated *bool) {
+	o.Federated = federated
+}
+
 // WithHostID adds the hostID to the v2 list events params
 func (o *V2ListEventsParams) WithHostID(hostID *strfmt.UUID) *V2ListEventsParams {
 	o.SetHostID(hostID)
@@ -408,6 +428,23 @@ func (o *V2ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		}
 	}
 
+	if o.Federated != nil {
+
+		// query param federated
+		var qrFederated bool
+
+		if o.Federated != nil {
+			qrFederated = *o.Federated
+		}
+		qFederated := swag.FormatBool(qrFederated)
+		if qFederated != "" {
+
+			if err := r.SetQueryParam("federated", qFederated); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.HostID != nil {
 
 		// query param host_id
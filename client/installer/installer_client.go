@@ -76,6 +76,9 @@ type API interface {
 	/*
 	   V2CancelInstallation Cancels an ongoing installation.*/
 	V2CancelInstallation(ctx context.Context, params *V2CancelInstallationParams) (*V2CancelInstallationAccepted, error)
+	/*
+	   V2DeregisterBmcReference Deregisters the BMC of a host of the infra-env and deletes its credentials.*/
+	V2DeregisterBmcReference(ctx context.Context, params *V2DeregisterBmcReferenceParams) (*V2DeregisterBmcReferenceNoContent, error)
 	/*
 	   V2DownloadClusterCredentials Downloads credentials relating to the installed/installing cluster.*/
 	V2DownloadClusterCredentials(ctx context.Context, params *V2DownloadClusterCredentialsParams, writer io.Writer) (*V2DownloadClusterCredentialsOK, error)
@@ -88,9 +91,18 @@ type API interface {
 	/*
 	   V2GetClusterDefaultConfig Get the default values for various cluster properties.*/
 	V2GetClusterDefaultConfig(ctx context.Context, params *V2GetClusterDefaultConfigParams) (*V2GetClusterDefaultConfigOK, error)
+	/*
+	   V2GetClusterIPStackReadiness Get a report of the readiness of the cluster hosts for each IP address family, including whether the cluster can use IPv6 as its primary IP stack.*/
+	V2GetClusterIPStackReadiness(ctx context.Context, params *V2GetClusterIPStackReadinessParams) (*V2GetClusterIPStackReadinessOK, error)
+	/*
+	   V2GetClusterNetworkTopology Get a graph of the cluster network built from the hosts inventories and connectivity reports.*/
+	V2GetClusterNetworkTopology(ctx context.Context, params *V2GetClusterNetworkTopologyParams) (*V2GetClusterNetworkTopologyOK, error)
 	/*
 	   V2GetClusterUISettings Fetch cluster specific UI settings.*/
 	V2GetClusterUISettings(ctx context.Context, params *V2GetClusterUISettingsParams) (*V2GetClusterUISettingsOK, error)
+	/*
+	   V2GetClusterVipSuggestions Get ranked API and ingress VIP candidates for each machine network of the cluster.*/
+	V2GetClusterVipSuggestions(ctx context.Context, params *V2GetClusterVipSuggestionsParams) (*V2GetClusterVipSuggestionsOK, error)
 	/*
 	   V2GetCredentials Get the cluster admin credentials.*/
 	V2GetCredentials(ctx context.Context, params *V2GetCredentialsParams) (*V2GetCredentialsOK, error)
@@ -100,6 +112,15 @@ type API interface {
 	/*
 	   V2GetPresignedForClusterFiles Retrieves a pre-signed S3 URL for downloading cluster files.*/
 	V2GetPresignedForClusterFiles(ctx context.Context, params *V2GetPresignedForClusterFilesParams) (*V2GetPresignedForClusterFilesOK, error)
+	/*
+	   V2ListBmcReferences Lists the BMCs of the hosts of the infra-env, without their credentials.*/
+	V2ListBmcReferences(ctx context.Context, params *V2ListBmcReferencesParams) (*V2ListBmcReferencesOK, error)
+	/*
+	   V2RegisterBmcReference Registers the BMC of a host of the infra-env.  The credentials of the BMC are kept in the secret store of the service and are never returned.*/
+	V2RegisterBmcReference(ctx context.Context, params *V2RegisterBmcReferenceParams) (*V2RegisterBmcReferenceCreated, error)
+	/*
+	   V2RunBmcAction Runs an action on a host through its registered BMC, for example to boot the host from the discovery image of the infra-env.*/
+	V2RunBmcAction(ctx context.Context, params *V2RunBmcActionParams) (*V2RunBmcActionOK, error)
 	/*
 	   V2UpdateCluster Updates an OpenShift cluster definition.*/
 	V2UpdateCluster(ctx context.Context, params *V2UpdateClusterParams) (*V2UpdateClusterCreated, error)
@@ -714,6 +735,31 @@ func (a *Client) V2CancelInstallation(ctx context.Context, params *V2CancelInsta
 
 }
 
+/*
+V2DeregisterBmcReference Deregisters the BMC of a host of the infra-env and deletes its credentials.
+*/
+func (a *Client) V2DeregisterBmcReference(ctx context.Context, params *V2DeregisterBmcReferenceParams) (*V2DeregisterBmcReferenceNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2DeregisterBmcReference",
+		Method:             "DELETE",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-references/{bmc_reference_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeregisterBmcReferenceReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeregisterBmcReferenceNoContent), nil
+
+}
+
 /*
 V2DownloadClusterCredentials Downloads credentials relating to the installed/installing cluster.
 */
@@ -814,6 +860,56 @@ func (a *Client) V2GetClusterDefaultConfig(ctx context.Context, params *V2GetClu
 
 }
 
+/*
+V2GetClusterIPStackReadiness Get a report of the readiness of the cluster hosts for each IP address family, including whether the cluster can use IPv6 as its primary IP stack.
+*/
+func (a *Client) V2GetClusterIPStackReadiness(ctx context.Context, params *V2GetClusterIPStackReadinessParams) (*V2GetClusterIPStackReadinessOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GetClusterIPStackReadiness",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/ip-stack-readiness",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterIPStackReadinessReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterIPStackReadinessOK), nil
+
+}
+
+/*
+V2GetClusterNetworkTopology Get a graph of the cluster network built from the hosts inventories and connectivity reports.
+*/
+func (a *Client) V2GetClusterNetworkTopology(ctx context.Context, params *V2GetClusterNetworkTopologyParams) (*V2GetClusterNetworkTopologyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GetClusterNetworkTopology",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/network-topology",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterNetworkTopologyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterNetworkTopologyOK), nil
+
+}
+
 /*
 V2GetClusterUISettings Fetch cluster specific UI settings.
 */
@@ -839,6 +935,31 @@ func (a *Client) V2GetClusterUISettings(ctx context.Context, params *V2GetCluste
 
 }
 
+/*
+V2GetClusterVipSuggestions Get ranked API and ingress VIP candidates for each machine network of the cluster.
+*/
+func (a *Client) V2GetClusterVipSuggestions(ctx context.Context, params *V2GetClusterVipSuggestionsParams) (*V2GetClusterVipSuggestionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GetClusterVipSuggestions",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/vip-suggestions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterVipSuggestionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterVipSuggestionsOK), nil
+
+}
+
 /*
 V2GetCredentials Get the cluster admin credentials.
 */
@@ -914,6 +1035,81 @@ func (a *Client) V2GetPresignedForClusterFiles(ctx context.Context, params *V2Ge
 
 }
 
+/*
+V2ListBmcReferences Lists the BMCs of the hosts of the infra-env, without their credentials.
+*/
+func (a *Client) V2ListBmcReferences(ctx context.Context, params *V2ListBmcReferencesParams) (*V2ListBmcReferencesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2ListBmcReferences",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-references",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListBmcReferencesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListBmcReferencesOK), nil
+
+}
+
+/*
+V2RegisterBmcReference Registers the BMC of a host of the infra-env.  The credentials of the BMC are kept in the secret store of the service and are never returned.
+*/
+func (a *Client) V2RegisterBmcReference(ctx context.Context, params *V2RegisterBmcReferenceParams) (*V2RegisterBmcReferenceCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2RegisterBmcReference",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-references",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RegisterBmcReferenceReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RegisterBmcReferenceCreated), nil
+
+}
+
+/*
+V2RunBmcAction Runs an action on a host through its registered BMC, for example to boot the host from the discovery image of the infra-env.
+*/
+func (a *Client) V2RunBmcAction(ctx context.Context, params *V2RunBmcActionParams) (*V2RunBmcActionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2RunBmcAction",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-actions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RunBmcActionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RunBmcActionOK), nil
+
+}
+
 /*
 V2UpdateCluster Updates an OpenShift cluster definition.
 */
@@ -68,6 +68,12 @@ type V2ListClustersParams struct {
 	*/
 	AmsSubscriptionIds []string
 
+	/* Federated.
+
+	   Include the clusters of all the member instances of the federation.
+	*/
+	Federated *bool
+
 	/* GetUnregisteredClusters.
 
 	   Whether to return clusters that have been unregistered.
@@ -112,12 +118,15 @@ func (o *V2ListClustersParams) WithDefaults() *V2ListClustersParams {
 // All values with no default are reset to their zero value.
 func (o *V2ListClustersParams) SetDefaults() {
 	var (
+		federatedDefault = bool(false)
+
 		getUnregisteredClustersDefault = bool(false)
 
 		withHostsDefault = bool(false)
 	)
 
 	val := V2ListClustersParams{
+		Federated:               &federatedDefault,
 		GetUnregisteredClusters: &getUnregisteredClustersDefault,
 		WithHosts:               withHostsDefault,
 	}
@@ -172,6 +181,17 @@ func (o *V2ListClustersParams) SetAmsSubscriptionIds(amsSubscriptionIds []string
 	o.AmsSubscriptionIds = amsSubscriptionIds
 }
 
+// WithFederated adds the federated to the v2 list clusters params
+func (o *V2ListClustersParams) WithFederated(federated *bool) *V2ListClustersParams {
+	o.SetFederated(federated)
+	return o
+}
+
+// SetFederated adds the federated to the v2 list clusters params
+func (o *V2ListClustersParams) SetFederated(federated *bool) {
+	o.Federated = federated
+}
+
 // WithGetUnregisteredClusters adds the getUnregisteredClusters to the v2 list clusters params
 func (o *V2ListClustersParams) WithGetUnregisteredClusters(getUnregisteredClusters *bool) *V2ListClustersParams {
 	o.SetGetUnregisteredClusters(getUnregisteredClusters)
@@ -235,6 +255,23 @@ func (o *V2ListClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.Federated != nil {
+
+		// query param federated
+		var qrFederated bool
+
+		if o.Federated != nil {
+			qrFederated = *o.Federated
+		}
+		qFederated := swag.FormatBool(qrFederated)
+		if qFederated != "" {
+
+			if err := r.SetQueryParam("federated", qFederated); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.GetUnregisteredClusters != nil {
 
 		// header param get_unregistered_clusters
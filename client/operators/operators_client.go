@@ -17,16 +17,36 @@ import (
 
 // API is the interface of the operators client
 type API interface {
+	/*
+	   V2CalculateClusterStorageSizing Calculates the capacity that a storage operator provides with the disks of the hosts of the cluster, and warns
+	   when it is below the target capacity.
+	*/
+	V2CalculateClusterStorageSizing(ctx context.Context, params *V2CalculateClusterStorageSizingParams) (*V2CalculateClusterStorageSizingOK, error)
 	/*
 	   V2GetBundle gets operator properties for a bundle
 
 	   Retrieves an array of operator properties for the specified bundle when some features are activated.*/
 	V2GetBundle(ctx context.Context, params *V2GetBundleParams) (*V2GetBundleOK, error)
+	/*
+	   V2GetOperatorsDependencyGraph gets the dependency graph of a set of operators
+
+	   Retrieves the operators that would be installed when the given operators are requested, why each of them is
+	   pulled in, the conflicts with other features and the aggregated hardware requirements.
+	*/
+	V2GetOperatorsDependencyGraph(ctx context.Context, params *V2GetOperatorsDependencyGraphParams) (*V2GetOperatorsDependencyGraphOK, error)
+	/*
+	   V2InstallClusterOperators Installs additional operators, and the operators they depend on, in an installed cluster.*/
+	V2InstallClusterOperators(ctx context.Context, params *V2InstallClusterOperatorsParams) (*V2InstallClusterOperatorsAccepted, error)
 	/*
 	   V2ListBundles gets list of available bundles
 
 	   Retrieves a list of available bundles filtered by support level.*/
 	V2ListBundles(ctx context.Context, params *V2ListBundlesParams) (*V2ListBundlesOK, error)
+	/*
+	   V2ListClusterOperatorsHealthHistory Lists the changes of the status of the operators of a cluster that were observed after the installation of the
+	   cluster, oldest first.
+	*/
+	V2ListClusterOperatorsHealthHistory(ctx context.Context, params *V2ListClusterOperatorsHealthHistoryParams) (*V2ListClusterOperatorsHealthHistoryOK, error)
 	/*
 	   V2ListOfClusterOperators Lists operators to be monitored for a cluster.*/
 	V2ListOfClusterOperators(ctx context.Context, params *V2ListOfClusterOperatorsParams) (*V2ListOfClusterOperatorsOK, error)
@@ -59,6 +79,32 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+V2CalculateClusterStorageSizing Calculates the capacity that a storage operator provides with the disks of the hosts of the cluster, and warns
+when it is below the target capacity.
+*/
+func (a *Client) V2CalculateClusterStorageSizing(ctx context.Context, params *V2CalculateClusterStorageSizingParams) (*V2CalculateClusterStorageSizingOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2CalculateClusterStorageSizing",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/storage-sizing",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CalculateClusterStorageSizingReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CalculateClusterStorageSizingOK), nil
+
+}
+
 /*
 V2GetBundle gets operator properties for a bundle
 
@@ -86,6 +132,59 @@ func (a *Client) V2GetBundle(ctx context.Context, params *V2GetBundleParams) (*V
 
 }
 
+/*
+V2GetOperatorsDependencyGraph gets the dependency graph of a set of operators
+
+Retrieves the operators that would be installed when the given operators are requested, why each of them is
+pulled in, the conflicts with other features and the aggregated hardware requirements.
+*/
+func (a *Client) V2GetOperatorsDependencyGraph(ctx context.Context, params *V2GetOperatorsDependencyGraphParams) (*V2GetOperatorsDependencyGraphOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GetOperatorsDependencyGraph",
+		Method:             "GET",
+		PathPattern:        "/v2/operators/dependency-graph",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetOperatorsDependencyGraphReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetOperatorsDependencyGraphOK), nil
+
+}
+
+/*
+V2InstallClusterOperators Installs additional operators, and the operators they depend on, in an installed cluster.
+*/
+func (a *Client) V2InstallClusterOperators(ctx context.Context, params *V2InstallClusterOperatorsParams) (*V2InstallClusterOperatorsAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2InstallClusterOperators",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/monitored-operators",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2InstallClusterOperatorsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2InstallClusterOperatorsAccepted), nil
+
+}
+
 /*
 V2ListBundles gets list of available bundles
 
@@ -113,6 +212,32 @@ func (a *Client) V2ListBundles(ctx context.Context, params *V2ListBundlesParams)
 
 }
 
+/*
+V2ListClusterOperatorsHealthHistory Lists the changes of the status of the operators of a cluster that were observed after the installation of the
+cluster, oldest first.
+*/
+func (a *Client) V2ListClusterOperatorsHealthHistory(ctx context.Context, params *V2ListClusterOperatorsHealthHistoryParams) (*V2ListClusterOperatorsHealthHistoryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2ListClusterOperatorsHealthHistory",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/monitored-operators/health-history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterOperatorsHealthHistoryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterOperatorsHealthHistoryOK), nil
+
+}
+
 /*
 V2ListOfClusterOperators Lists operators to be monitored for a cluster.
 */
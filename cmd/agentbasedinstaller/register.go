@@ -447,7 +447,13 @@ func processNMStateConfig(log log.FieldLogger, infraEnv aiv1beta1.InfraEnv, nmSt
 
 func operatorsToArray(entries []models.OperatorCreateParams) []*models.OperatorCreateParams {
 	return funk.Map(entries, func(entry models.OperatorCreateParams) *models.OperatorCreateParams {
-		return &models.OperatorCreateParams{Name: entry.Name, Properties: entry.Properties}
+		return &models.OperatorCreateParams{
+			Name:                entry.Name,
+			Properties:          entry.Properties,
+			Channel:             entry.Channel,
+			StartingCsv:         entry.StartingCsv,
+			InstallPlanApproval: entry.InstallPlanApproval,
+		}
 	}).([]*models.OperatorCreateParams)
 }
 
@@ -32,6 +32,7 @@ import (
 	"github.com/openshift/assisted-service/internal/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/feature"
+	"github.com/openshift/assisted-service/internal/federation"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host"
@@ -48,6 +49,7 @@ import (
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/day2"
 	"github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/releasesources"
@@ -119,6 +121,7 @@ var Options struct {
 	GeneratorConfig                      generator.Config
 	InstructionConfig                    hostcommands.InstructionConfig
 	OperatorsConfig                      operators.Options
+	Day2OperatorsConfig                  day2.Config
 	GCConfig                             garbagecollector.Config
 	ReleaseSourcesConfig                 releasesources.Config
 	StaticNetworkConfig                  staticnetworkconfig.Config
@@ -171,7 +174,9 @@ var Options struct {
 	ForceInsecurePolicyJson              bool          `envconfig:"FORCE_INSECURE_POLICY_JSON" default:"false"`
 	PreprovisioningImageControllerConfig controllers.PreprovisioningImageControllerConfig
 	BMACConfig                           controllers.BMACConfig
+	SpokeClientCacheConfig               controllers.SpokeClientCacheConfig
 	InstallerCacheConfig                 installercache.Config
+	FederationConfig                     federation.Config
 
 	// EnableSoftTimeouts is a boolean flag to enable Soft timeouts by assisted installer
 	EnableSoftTimeouts bool `envconfig:"ENABLE_SOFT_TIMEOUTS" default:"false"`
@@ -279,7 +284,8 @@ func startKubeAPIControllers(
 	hostApi host.API,
 	manifestsApi manifestsapi.ManifestsAPI,
 	generateInsecureIPXEURLs bool,
-	sys system.SystemInfo,
+	spokeClientFactory spoke_k8s_client.SpokeK8sClientFactory,
+	spokeClientMetrics *spoke_k8s_client.Metrics,
 ) {
 	if !Options.EnableKubeAPI {
 		return
@@ -321,8 +327,9 @@ func startKubeAPIControllers(
 		ImageServiceEnabled: Options.EnableImageService,
 	}).SetupWithManager(ctrlMgr), "unable to create controller InfraEnv")
 
-	spokeClientFactory, err := spoke_k8s_client.NewFactory(log, nil, sys)
-	failOnError(err, "unable to create spoke client factory")
+	spokeClientCache := controllers.NewSpokeClientCache(log, spokeClientFactory, Options.SpokeClientCacheConfig,
+		spokeClientMetrics)
+	failOnError(ctrlMgr.Add(spokeClientCache), "unable to add the spoke client cache")
 
 	cluster_client := ctrlMgr.GetClient()
 	cluster_reader := ctrlMgr.GetAPIReader()
@@ -341,6 +348,7 @@ func startKubeAPIControllers(
 		AuthType:                      Options.Auth.AuthType,
 		VersionsHandler:               versionHandler,
 		SpokeK8sClientFactory:         spokeClientFactory,
+		SpokeClients:                  spokeClientCache,
 		MirrorRegistriesConfigBuilder: mirrorregistries.New(Options.ForceInsecurePolicyJson),
 	}).SetupWithManager(ctrlMgr), "unable to create controller ClusterDeployment")
 
@@ -354,10 +362,12 @@ func startKubeAPIControllers(
 		ServiceBaseURL:             Options.BMConfig.ServiceBaseURL,
 		AuthType:                   Options.Auth.AuthType,
 		SpokeK8sClientFactory:      spokeClientFactory,
+		SpokeClients:               spokeClientCache,
 		ApproveCsrsRequeueDuration: Options.ApproveCsrsRequeueDuration,
 		AgentContainerImage:        Options.BMConfig.AgentDockerImg,
 		HostFSMountDir:             hostFSMountDir,
 		ImageServiceEnabled:        Options.EnableImageService,
+		Drainer:                    &controllers.KubectlDrainer{},
 	}).SetupWithManager(ctrlMgr), "unable to create controller Agent")
 
 	if Options.EnableImageService {
@@ -368,6 +378,7 @@ func startKubeAPIControllers(
 			Scheme:                ctrlMgr.GetScheme(),
 			Installer:             bm,
 			SpokeK8sClientFactory: spokeClientFactory,
+			SpokeClients:          spokeClientCache,
 			ConvergedFlowEnabled:  useConvergedFlow,
 			PauseProvisionedBMHs:  Options.PauseProvisionedBMHs,
 			Drainer:               &controllers.KubectlDrainer{},
@@ -385,6 +396,17 @@ func startKubeAPIControllers(
 		Log:    log,
 	}).SetupWithManager(ctrlMgr), "unable to create controller AgentClassification")
 
+	failOnError((&controllers.AgentPoolReconciler{
+		Client: ctrlMgr.GetClient(),
+		Log:    log,
+	}).SetupWithManager(ctrlMgr), "unable to create controller AgentPool")
+
+	failOnError((&controllers.ClusterInstanceReconciler{
+		Client: ctrlMgr.GetClient(),
+		Log:    log,
+		Scheme: ctrlMgr.GetScheme(),
+	}).SetupWithManager(ctrlMgr), "unable to create controller ClusterInstance")
+
 	failOnError((&controllers.AgentLabelReconciler{
 		Client: ctrlMgr.GetClient(),
 		Log:    log,
@@ -729,10 +751,41 @@ func main() {
 		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs, Options.GeneratorConfig.InstallInvoker, disconnectedIgnitionGenerator)
 	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
 
+	federationDirectory := federation.NewDirectory(Options.FederationConfig, log.WithField("pkg", "federation-directory"))
+	var installerAPI restapi.InstallerAPI = bm
+	var eventsAPI restapi.EventsAPI = events
+	var federationMember *federation.Federation
+	if Options.FederationConfig.Enabled {
+		if Options.FederationConfig.InstanceURL == "" {
+			Options.FederationConfig.InstanceURL = Options.BMConfig.ServiceBaseURL
+		}
+		federationMember, err = federation.New(Options.FederationConfig, federationDirectory, log.WithField("pkg", "federation"))
+		failOnError(err, "failed to join the federation")
+		federationMember.Sync()
+		federationSync := thread.New(
+			log.WithField("pkg", "federation-sync"), "Federation Sync", Options.FederationConfig.HeartbeatInterval, federationMember.Sync)
+		federationSync.Start()
+		defer federationSync.Stop()
+		installerAPI = federation.NewInstallerAPI(bm, federationMember)
+		eventsAPI = federation.NewEventsAPI(events, federationMember)
+	}
+
+	apiKeyAuthenticator := authHandler.CreateAuthenticator()
+	if federationMember != nil {
+		// Authenticates the users of the requests forwarded by the other members with their delegated identity
+		apiKeyAuthenticator = federationMember.Authenticator(apiKeyAuthenticator)
+	}
+
 	//Set inner handler chain. Inner handlers requires access to the Route
 	innerHandler := func() func(http.Handler) http.Handler {
 		return func(h http.Handler) http.Handler {
-			wrapped := metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry)(h)
+			wrapped := h
+			if federationMember != nil {
+				// Routes the writes of the resources owned by other members once the route, and therefore the
+				// authenticators of the caller, are known
+				wrapped = federationMember.Router(wrapped, federation.NewDBResources(db))
+			}
+			wrapped = metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry)(wrapped)
 
 			wrapped = paramctx.ContextHandler()(wrapped)
 			return wrapped
@@ -744,7 +797,24 @@ func main() {
 		jsonConsumer = internaljson.UnknownFieldsRejectingConsumer()
 	}
 
-	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, clusterApi)
+	spokeClientMetrics := spoke_k8s_client.NewMetrics(prometheusRegistry)
+	spokeClientFactory, err := spoke_k8s_client.NewFactory(log, nil, sys, spokeClientMetrics)
+	failOnError(err, "unable to create spoke client factory")
+	day2OperatorsInstaller := day2.NewInstaller(Options.Day2OperatorsConfig, log.WithField("pkg", "day2-operators"), db, operatorsManager, hwValidator, objectHandler,
+		spokeClientFactory, eventsHandler, lead)
+	day2OperatorsMonitor := thread.New(
+		log.WithField("pkg", "day2-operators-monitor"), "Day2 Operators Monitor", Options.Day2OperatorsConfig.MonitorInterval, day2OperatorsInstaller.MonitorOperators)
+	day2OperatorsMonitor.Start()
+	defer day2OperatorsMonitor.Stop()
+	if Options.Day2OperatorsConfig.HealthMonitorDuration > 0 {
+		operatorsHealthMonitor := thread.New(
+			log.WithField("pkg", "operators-health-monitor"), "Operators Health Monitor", Options.Day2OperatorsConfig.HealthMonitorInterval, day2OperatorsInstaller.MonitorOperatorsHealth)
+		operatorsHealthMonitor.Start()
+		defer operatorsHealthMonitor.Stop()
+	}
+
+	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, clusterApi, day2OperatorsInstaller,
+		hwValidator)
 	h, api, err := restapi.HandlerAPI(restapi.Config{
 		AuthAgentAuth:       authHandler.AuthAgentAuth,
 		AuthUserAuth:        authHandler.AuthUserAuth,
@@ -752,10 +822,11 @@ func main() {
 		AuthURLAuth:         authHandler.AuthURLAuth,
 		AuthImageAuth:       authHandler.AuthImageAuth,
 		AuthImageURLAuth:    authHandler.AuthImageAuth,
-		APIKeyAuthenticator: authHandler.CreateAuthenticator(),
+		APIKeyAuthenticator: apiKeyAuthenticator,
 		Authorizer:          authzHandler.CreateAuthorizer(),
-		InstallerAPI:        bm,
-		EventsAPI:           events,
+		InstallerAPI:        installerAPI,
+		EventsAPI:           eventsAPI,
+		FederationAPI:       federationDirectory,
 		Logger:              log.Printf,
 		VersionsAPI:         versionsAPIHandler,
 		ManagedDomainsAPI:   domainHandler,
@@ -784,7 +855,7 @@ func main() {
 		go startPPROF(log)
 	}
 
-	go startKubeAPIControllers(ctrlMgr, log, bm, crdEventsHandler, osImages, versionHandler, releaseHandler, clusterApi, hostApi, manifestsApi, generateInsecureIPXEURLs, sys)
+	go startKubeAPIControllers(ctrlMgr, log, bm, crdEventsHandler, osImages, versionHandler, releaseHandler, clusterApi, hostApi, manifestsApi, generateInsecureIPXEURLs, spokeClientFactory, spokeClientMetrics)
 
 	// Interrupt servers on SIGINT/SIGTERM
 	stop := make(chan os.Signal, 1)
@@ -51,6 +51,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
@@ -188,12 +189,18 @@ func main() {
 	}
 
 	log := logrus.New()
-	spokeClientFactory, err := spoke_k8s_client.NewFactory(log, nil, system.NewLocalSystemInfo())
+	spokeClientMetrics := spoke_k8s_client.NewMetrics(ctrlmetrics.Registry)
+	spokeClientFactory, err := spoke_k8s_client.NewFactory(log, nil, system.NewLocalSystemInfo(), spokeClientMetrics)
 	if err != nil {
 		log.WithError(err).Error("failed to create spoke client factory")
 		os.Exit(1)
 	}
-	spokeClientCache := controllers.NewSpokeClientCache(spokeClientFactory)
+	spokeClientCache := controllers.NewSpokeClientCache(log, spokeClientFactory, controllers.SpokeClientCacheConfig{},
+		spokeClientMetrics)
+	if err = mgr.Add(spokeClientCache); err != nil {
+		log.WithError(err).Error("failed to add the spoke client cache to the manager")
+		os.Exit(1)
+	}
 
 	c, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
 	if err != nil {
@@ -0,0 +1,172 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/executer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	redfishScheme             = "redfish"
+	redfishVirtualMediaScheme = "redfish-virtualmedia"
+	ipmiScheme                = "ipmi"
+
+	defaultIPMIPort = "623"
+
+	// The power states reported in the inventory use the Redfish values
+	powerStateOn  = "On"
+	powerStateOff = "Off"
+)
+
+var (
+	// ErrVirtualMediaNotSupported is returned when the BMC of the host can't boot it from virtual media
+	ErrVirtualMediaNotSupported = errors.New("the BMC doesn't support virtual media")
+	// ErrAddressNotAllowed is returned when the BMC address isn't in the networks the BMCs are allowed in
+	ErrAddressNotAllowed = errors.New("the BMC address isn't in the allowed BMC networks")
+)
+
+// Credentials are the address and the credentials of the BMC of a host. The address scheme selects the driver:
+//   - redfish://, redfish-virtualmedia://, optionally followed by +http or +https, e.g.
+//     redfish-virtualmedia+https://bmc.example.com/redfish/v1/Systems/1. When the path is empty the first system of
+//     the BMC is used.
+//   - ipmi://bmc.example.com:623
+type Credentials struct {
+	Address                        string
+	Username                       string
+	Password                       string
+	DisableCertificateVerification bool
+}
+
+// Driver manages the power and the boot device of a host through its BMC
+//
+//go:generate mockgen --build_flags=--mod=mod -package bmc -destination mock_bmc.go . Driver,Factory
+type Driver interface {
+	// PowerCycle restarts the host, or powers it on when it is off
+	PowerCycle(ctx context.Context) error
+	// SetOneTimeBootVirtualMedia inserts the ISO at the given URL as virtual media and boots the host from it once
+	SetOneTimeBootVirtualMedia(ctx context.Context, isoURL string) error
+	// GetInventory reads the inventory of the host from its BMC
+	GetInventory(ctx context.Context) (*models.BmcInventory, error)
+}
+
+// Factory creates the driver matching the address of a BMC
+type Factory interface {
+	NewDriver(credentials Credentials) (Driver, error)
+}
+
+type factory struct {
+	log             logrus.FieldLogger
+	executer        executer.Executer
+	allowedNetworks []*net.IPNet
+}
+
+// NewFactory creates a factory of drivers that only reach the BMCs whose addresses are in the allowed networks, a comma
+// separated list of CIDRs. The invalid networks are ignored, no BMC can be reached when no network is allowed.
+func NewFactory(log logrus.FieldLogger, exec executer.Executer, allowedNetworks string) Factory {
+	f := &factory{log: log, executer: exec}
+	for _, cidr := range strings.Split(allowedNetworks, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.WithError(err).Warnf("Ignoring invalid BMC network %s", cidr)
+			continue
+		}
+		f.allowedNetworks = append(f.allowedNetworks, network)
+	}
+	return f
+}
+
+func (f *factory) NewDriver(credentials Credentials) (Driver, error) {
+	u, err := url.Parse(credentials.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid BMC address %s", credentials.Address)
+	}
+	if u.Host == "" {
+		return nil, errors.Errorf("invalid BMC address %s: missing host", credentials.Address)
+	}
+	scheme, transport, _ := strings.Cut(u.Scheme, "+")
+	log := f.log.WithField("bmc", u.Host)
+	switch scheme {
+	case redfishScheme, redfishVirtualMediaScheme:
+		switch transport {
+		case "":
+			transport = "https"
+		case "http", "https":
+		default:
+			return nil, errors.Errorf("unsupported transport %s in BMC address %s", transport, credentials.Address)
+		}
+		// The addresses the host name resolves to are checked when connecting, see checkDialAddress
+		if ip := net.ParseIP(u.Hostname()); ip != nil && !f.isAllowed(ip) {
+			return nil, errors.Wrapf(ErrAddressNotAllowed, "BMC address %s", credentials.Address)
+		}
+		endpoint := url.URL{Scheme: transport, Host: u.Host}
+		return newRedfishDriver(log, endpoint.String(), strings.TrimSuffix(u.Path, "/"), credentials, f.checkDialAddress), nil
+	case ipmiScheme:
+		host, port := u.Hostname(), u.Port()
+		if port == "" {
+			port = defaultIPMIPort
+		}
+		if _, err = net.LookupPort("udp", port); err != nil {
+			return nil, errors.Wrapf(err, "invalid port in BMC address %s", credentials.Address)
+		}
+		// ipmitool is given the checked address, so that the host name can't resolve to another address later
+		ip, err := f.resolveAllowed(host)
+		if err != nil {
+			return nil, errors.Wrapf(err, "BMC address %s", credentials.Address)
+		}
+		return newIPMIDriver(log, f.executer, ip.String(), port, credentials), nil
+	default:
+		return nil, fmt.Errorf("unsupported BMC address %s, the supported schemes are %s, %s and %s",
+			credentials.Address, redfishScheme, redfishVirtualMediaScheme, ipmiScheme)
+	}
+}
+
+func (f *factory) isAllowed(ip net.IP) bool {
+	for _, network := range f.allowedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAllowed returns the first address of the host, which must be in the allowed networks like all its addresses
+func (f *factory) resolveAllowed(host string) (net.IP, error) {
+	ips := []net.IP{net.ParseIP(host)}
+	if ips[0] == nil {
+		var err error
+		if ips, err = net.LookupIP(host); err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve %s", host)
+		}
+	}
+	for _, ip := range ips {
+		if !f.isAllowed(ip) {
+			return nil, ErrAddressNotAllowed
+		}
+	}
+	return ips[0], nil
+}
+
+// checkDialAddress is the control function of the connections to the BMCs, it rejects the connections to the
+// addresses that aren't in the allowed networks, whatever the host name resolved to
+func (f *factory) checkDialAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !f.isAllowed(ip) {
+		return errors.Wrapf(ErrAddressNotAllowed, "address %s", host)
+	}
+	return nil
+}
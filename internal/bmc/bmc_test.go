@@ -0,0 +1,262 @@
+package bmc
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/bmc/redfishmock"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/executer"
+)
+
+var _ = Describe("NewDriver", func() {
+	factory := NewFactory(common.GetTestLog(), &executer.CommonExecuter{}, "192.0.2.0/24")
+
+	DescribeTable("selects the driver from the address",
+		func(address, endpoint, systemPath string) {
+			driver, err := factory.NewDriver(Credentials{Address: address})
+			Expect(err).ToNot(HaveOccurred())
+			redfish, ok := driver.(*redfishDriver)
+			Expect(ok).To(BeTrue())
+			Expect(redfish.endpoint).To(Equal(endpoint))
+			Expect(redfish.systemPath).To(Equal(systemPath))
+		},
+		Entry("redfish", "redfish://bmc.example.com/redfish/v1/Systems/1", "https://bmc.example.com", "/redfish/v1/Systems/1"),
+		Entry("redfish virtual media over http", "redfish-virtualmedia+http://bmc.example.com:8000/redfish/v1/Systems/1/",
+			"http://bmc.example.com:8000", "/redfish/v1/Systems/1"),
+		Entry("redfish without system", "redfish+https://bmc.example.com", "https://bmc.example.com", ""),
+	)
+
+	It("creates an IPMI driver with the default port", func() {
+		driver, err := factory.NewDriver(Credentials{Address: "ipmi://192.0.2.10"})
+		Expect(err).ToNot(HaveOccurred())
+		ipmi, ok := driver.(*ipmiDriver)
+		Expect(ok).To(BeTrue())
+		Expect(ipmi.host).To(Equal("192.0.2.10"))
+		Expect(ipmi.port).To(Equal(defaultIPMIPort))
+	})
+
+	DescribeTable("rejects invalid addresses",
+		func(address string) {
+			_, err := factory.NewDriver(Credentials{Address: address})
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("unsupported scheme", "idrac://bmc.example.com"),
+		Entry("unsupported transport", "redfish+ftp://bmc.example.com"),
+		Entry("missing host", "redfish:///redfish/v1/Systems/1"),
+		Entry("not a URL", "bmc.example.com"),
+		Entry("redfish outside of the allowed networks", "redfish://198.51.100.10/redfish/v1/Systems/1"),
+		Entry("IPMI outside of the allowed networks", "ipmi://198.51.100.10"),
+	)
+
+	It("rejects all the addresses when no network is allowed", func() {
+		_, err := NewFactory(common.GetTestLog(), nil, "").NewDriver(Credentials{Address: "ipmi://192.0.2.10"})
+		Expect(err).To(MatchError(ErrAddressNotAllowed))
+	})
+})
+
+// testAllowedNetworks allows the Redfish mock, which listens on the loopback address
+const testAllowedNetworks = "127.0.0.0/8,::1/128"
+
+var _ = Describe("Redfish driver", func() {
+	var (
+		ctx    = context.Background()
+		server *redfishmock.Server
+		driver Driver
+	)
+
+	BeforeEach(func() {
+		server = redfishmock.NewServer("admin", "password")
+		var err error
+		driver, err = NewFactory(common.GetTestLog(), nil, testAllowedNetworks).NewDriver(Credentials{
+			Address:                        server.Address(),
+			Username:                       "admin",
+			Password:                       "password",
+			DisableCertificateVerification: true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("reads the inventory", func() {
+		inventory, err := driver.GetInventory(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inventory).To(Equal(&models.BmcInventory{
+			Driver:                models.BmcInventoryDriverRedfish,
+			Manufacturer:          "Mock",
+			Model:                 "Mock Server",
+			SerialNumber:          "MOCK-0001",
+			BiosVersion:           "1.0.0",
+			PowerState:            "Off",
+			CPUCount:              2,
+			MemoryGib:             64,
+			VirtualMediaSupported: true,
+		}))
+	})
+
+	It("powers on a host that is off and restarts a host that is on", func() {
+		Expect(driver.PowerCycle(ctx)).To(Succeed())
+		Expect(driver.PowerCycle(ctx)).To(Succeed())
+		Expect(server.State().Resets).To(Equal([]string{"On", "ForceRestart"}))
+		Expect(server.State().PowerState).To(Equal("On"))
+	})
+
+	It("boots the host once from the ISO", func() {
+		Expect(driver.SetOneTimeBootVirtualMedia(ctx, "https://images.example.com/discovery.iso")).To(Succeed())
+		// The previous image is ejected when the media is already inserted
+		Expect(driver.SetOneTimeBootVirtualMedia(ctx, "https://images.example.com/other.iso")).To(Succeed())
+		state := server.State()
+		Expect(state.Image).To(Equal("https://images.example.com/other.iso"))
+		Expect(state.BootSourceOverrideTarget).To(Equal("Cd"))
+		Expect(state.BootSourceOverrideEnabled).To(Equal("Once"))
+	})
+
+	It("fails to boot from virtual media when the BMC has none", func() {
+		server.DisableVirtualMedia()
+		Expect(driver.SetOneTimeBootVirtualMedia(ctx, "https://images.example.com/discovery.iso")).To(MatchError(ErrVirtualMediaNotSupported))
+		inventory, err := driver.GetInventory(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inventory.VirtualMediaSupported).To(BeFalse())
+	})
+
+	It("uses the first system when the address doesn't include it", func() {
+		driver, err := NewFactory(common.GetTestLog(), nil, testAllowedNetworks).NewDriver(Credentials{
+			Address:                        "redfish://" + server.Listener.Addr().String(),
+			Username:                       "admin",
+			Password:                       "password",
+			DisableCertificateVerification: true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(driver.PowerCycle(ctx)).To(Succeed())
+		Expect(server.State().Resets).To(HaveLen(1))
+	})
+
+	It("fails with invalid credentials", func() {
+		driver, err := NewFactory(common.GetTestLog(), nil, testAllowedNetworks).NewDriver(Credentials{
+			Address:                        server.Address(),
+			Username:                       "admin",
+			Password:                       "wrong",
+			DisableCertificateVerification: true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = driver.GetInventory(ctx)
+		// The body of the response is controlled by the BMC, it isn't part of the error
+		Expect(err).To(MatchError(HaveSuffix("returned 401 Unauthorized")))
+	})
+
+	It("doesn't connect to a host name that resolves outside of the allowed networks", func() {
+		_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		driver, err := NewFactory(common.GetTestLog(), nil, "192.0.2.0/24").NewDriver(Credentials{
+			Address:                        "redfish://localhost:" + port + redfishmock.SystemPath,
+			Username:                       "admin",
+			Password:                       "password",
+			DisableCertificateVerification: true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(driver.PowerCycle(ctx)).To(MatchError(ErrAddressNotAllowed))
+		Expect(server.State().Resets).To(BeEmpty())
+	})
+
+	It("verifies the certificate of the BMC by default", func() {
+		driver, err := NewFactory(common.GetTestLog(), nil, testAllowedNetworks).NewDriver(Credentials{
+			Address:  server.Address(),
+			Username: "admin",
+			Password: "password",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = driver.GetInventory(ctx)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("IPMI driver", func() {
+	var (
+		ctx          = context.Background()
+		ctrl         *gomock.Controller
+		mockExecuter *executer.MockExecuter
+		driver       Driver
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockExecuter = executer.NewMockExecuter(ctrl)
+		mockExecuter.EXPECT().TempFile(gomock.Any(), gomock.Any()).DoAndReturn(os.CreateTemp).AnyTimes()
+		var err error
+		driver, err = NewFactory(common.GetTestLog(), mockExecuter, "192.0.2.0/24").NewDriver(Credentials{
+			Address:  "ipmi://192.0.2.10:6230",
+			Username: "admin",
+			Password: "password",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectIPMITool := func(command, stdout string, exitCode int) {
+		mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), "ipmitool", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, args ...string) (string, string, int) {
+				Expect(args[:8]).To(Equal([]string{"-I", "lanplus", "-H", "192.0.2.10", "-p", "6230", "-U", "admin"}))
+				Expect(args[8]).To(Equal("-f"))
+				password, err := os.ReadFile(args[9])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(password)).To(Equal("password"))
+				Expect(strings.Join(args[10:], " ")).To(Equal(command))
+				if exitCode != 0 {
+					return "", "Error: Unable to establish IPMI v2 / RMCP+ session", exitCode
+				}
+				return stdout, "", 0
+			})
+	}
+
+	It("power cycles a host that is on", func() {
+		expectIPMITool("chassis power status", "Chassis Power is on\n", 0)
+		expectIPMITool("chassis power cycle", "Chassis Power Control: Cycle\n", 0)
+		Expect(driver.PowerCycle(ctx)).To(Succeed())
+	})
+
+	It("powers on a host that is off", func() {
+		expectIPMITool("chassis power status", "Chassis Power is off\n", 0)
+		expectIPMITool("chassis power on", "Chassis Power Control: Up/On\n", 0)
+		Expect(driver.PowerCycle(ctx)).To(Succeed())
+	})
+
+	It("reads the inventory from the FRU", func() {
+		expectIPMITool("chassis power status", "Chassis Power is on\n", 0)
+		expectIPMITool("fru print 0", ` Chassis Type          : Rack Mount Chassis
+ Product Manufacturer  : Example
+ Product Name          : Example Server
+ Product Serial        : SN1234
+`, 0)
+		inventory, err := driver.GetInventory(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inventory).To(Equal(&models.BmcInventory{
+			Driver:       models.BmcInventoryDriverIpmi,
+			Manufacturer: "Example",
+			Model:        "Example Server",
+			SerialNumber: "SN1234",
+			PowerState:   "On",
+		}))
+	})
+
+	It("fails when the BMC is unreachable", func() {
+		expectIPMITool("chassis power status", "", 1)
+		Expect(driver.PowerCycle(ctx)).To(MatchError(ContainSubstring("Unable to establish IPMI v2")))
+	})
+
+	It("doesn't support virtual media", func() {
+		Expect(driver.SetOneTimeBootVirtualMedia(ctx, "https://images.example.com/discovery.iso")).To(MatchError(ErrVirtualMediaNotSupported))
+	})
+})
@@ -0,0 +1,124 @@
+package bmc
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/executer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const ipmitool = "ipmitool"
+
+// ipmiDriver runs ipmitool over the lanplus interface. IPMI can't attach virtual media, so it can only power cycle the
+// host and read its inventory.
+type ipmiDriver struct {
+	log         logrus.FieldLogger
+	executer    executer.Executer
+	host        string
+	port        string
+	credentials Credentials
+}
+
+func newIPMIDriver(log logrus.FieldLogger, exec executer.Executer, host, port string, credentials Credentials) *ipmiDriver {
+	return &ipmiDriver{log: log, executer: exec, host: host, port: port, credentials: credentials}
+}
+
+func (d *ipmiDriver) PowerCycle(ctx context.Context) error {
+	powerState, err := d.getPowerState(ctx)
+	if err != nil {
+		return err
+	}
+	command := "cycle"
+	if powerState == powerStateOff {
+		command = "on"
+	}
+	d.log.Infof("Running chassis power %s", command)
+	_, err = d.run(ctx, "chassis", "power", command)
+	return err
+}
+
+func (d *ipmiDriver) SetOneTimeBootVirtualMedia(_ context.Context, _ string) error {
+	return ErrVirtualMediaNotSupported
+}
+
+func (d *ipmiDriver) GetInventory(ctx context.Context) (*models.BmcInventory, error) {
+	powerState, err := d.getPowerState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inventory := &models.BmcInventory{
+		Driver:     models.BmcInventoryDriverIpmi,
+		PowerState: powerState,
+	}
+	out, err := d.run(ctx, "fru", "print", "0")
+	if err != nil {
+		// Not all the BMCs expose the FRU of the host, the power state is still reported
+		d.log.WithError(err).Warn("failed to read the FRU of the host")
+		return inventory, nil
+	}
+	fru := parseIPMIFields(out)
+	inventory.Manufacturer = fru["Product Manufacturer"]
+	inventory.Model = fru["Product Name"]
+	inventory.SerialNumber = fru["Product Serial"]
+	return inventory, nil
+}
+
+// getPowerState returns the power state of the chassis in the Redfish format, On or Off
+func (d *ipmiDriver) getPowerState(ctx context.Context) (string, error) {
+	out, err := d.run(ctx, "chassis", "power", "status")
+	if err != nil {
+		return "", err
+	}
+	// The output is "Chassis Power is on" or "Chassis Power is off"
+	switch {
+	case strings.HasSuffix(strings.TrimSpace(out), " on"):
+		return powerStateOn, nil
+	case strings.HasSuffix(strings.TrimSpace(out), " off"):
+		return powerStateOff, nil
+	default:
+		return "", errors.Errorf("unexpected chassis power status %q", strings.TrimSpace(out))
+	}
+}
+
+// run runs ipmitool with the given arguments, the password is passed in a file so that it isn't visible in the
+// process list
+func (d *ipmiDriver) run(ctx context.Context, args ...string) (string, error) {
+	passwordFile, err := d.executer.TempFile("", "ipmi")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create the IPMI password file")
+	}
+	defer os.Remove(passwordFile.Name())
+	_, err = passwordFile.WriteString(d.credentials.Password)
+	if closeErr := passwordFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to write the IPMI password file")
+	}
+
+	baseArgs := []string{"-I", "lanplus", "-H", d.host, "-p", d.port, "-U", d.credentials.Username, "-f", passwordFile.Name()}
+	stdout, stderr, exitCode := d.executer.ExecuteWithContext(ctx, ipmitool, append(baseArgs, args...)...)
+	if exitCode != 0 {
+		return "", errors.Errorf("%s %s failed with exit code %d: %s", ipmitool, strings.Join(args, " "), exitCode, strings.TrimSpace(stderr))
+	}
+	return stdout, nil
+}
+
+// parseIPMIFields parses the "key : value" lines printed by ipmitool
+func parseIPMIFields(out string) map[string]string {
+	fields := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		fields[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return fields
+}
@@ -0,0 +1,246 @@
+package bmc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	redfishSystemsPath = "/redfish/v1/Systems"
+	redfishTimeout     = 30 * time.Second
+)
+
+// redfishVirtualMediaTypes are the media types of the virtual media devices that can boot an ISO
+var redfishVirtualMediaTypes = []string{"CD", "DVD"}
+
+type redfishLink struct {
+	ODataID string `json:"@odata.id"`
+}
+
+type redfishCollection struct {
+	Members []redfishLink `json:"Members"`
+}
+
+type redfishSystem struct {
+	Manufacturer     string `json:"Manufacturer"`
+	Model            string `json:"Model"`
+	SerialNumber     string `json:"SerialNumber"`
+	BiosVersion      string `json:"BiosVersion"`
+	PowerState       string `json:"PowerState"`
+	ProcessorSummary struct {
+		Count int64 `json:"Count"`
+	} `json:"ProcessorSummary"`
+	MemorySummary struct {
+		TotalSystemMemoryGiB float64 `json:"TotalSystemMemoryGiB"`
+	} `json:"MemorySummary"`
+	Links struct {
+		ManagedBy []redfishLink `json:"ManagedBy"`
+	} `json:"Links"`
+}
+
+type redfishManager struct {
+	VirtualMedia *redfishLink `json:"VirtualMedia"`
+}
+
+type redfishVirtualMedia struct {
+	ODataID    string   `json:"@odata.id"`
+	MediaTypes []string `json:"MediaTypes"`
+	Inserted   bool     `json:"Inserted"`
+}
+
+type redfishDriver struct {
+	log         logrus.FieldLogger
+	client      *http.Client
+	endpoint    string
+	systemPath  string
+	credentials Credentials
+}
+
+// newRedfishDriver creates a driver whose connections are checked by dialControl. The BMC is reached without proxy
+// and the redirects aren't followed, so that the driver only connects to the checked addresses.
+func newRedfishDriver(log logrus.FieldLogger, endpoint, systemPath string, credentials Credentials,
+	dialControl func(network, address string, c syscall.RawConn) error) *redfishDriver {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{Timeout: redfishTimeout, KeepAlive: 30 * time.Second, Control: dialControl}).DialContext
+	if credentials.DisableCertificateVerification {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+	}
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   redfishTimeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return &redfishDriver{
+		log:         log,
+		client:      client,
+		endpoint:    endpoint,
+		systemPath:  systemPath,
+		credentials: credentials,
+	}
+}
+
+func (d *redfishDriver) PowerCycle(ctx context.Context) error {
+	systemPath, system, err := d.getSystem(ctx)
+	if err != nil {
+		return err
+	}
+	resetType := "ForceRestart"
+	if system.PowerState == powerStateOff {
+		resetType = "On"
+	}
+	d.log.Infof("Resetting system %s with reset type %s", systemPath, resetType)
+	return d.do(ctx, http.MethodPost, systemPath+"/Actions/ComputerSystem.Reset", map[string]string{"ResetType": resetType}, nil)
+}
+
+func (d *redfishDriver) SetOneTimeBootVirtualMedia(ctx context.Context, isoURL string) error {
+	systemPath, system, err := d.getSystem(ctx)
+	if err != nil {
+		return err
+	}
+	media, err := d.getVirtualMedia(ctx, system)
+	if err != nil {
+		return err
+	}
+	if media == nil {
+		return ErrVirtualMediaNotSupported
+	}
+	if media.Inserted {
+		d.log.Infof("Ejecting the media inserted in %s", media.ODataID)
+		if err = d.do(ctx, http.MethodPost, media.ODataID+"/Actions/VirtualMedia.EjectMedia", map[string]interface{}{}, nil); err != nil {
+			return errors.Wrapf(err, "failed to eject the media inserted in %s", media.ODataID)
+		}
+	}
+	d.log.Infof("Inserting the discovery image in %s", media.ODataID)
+	insert := map[string]interface{}{"Image": isoURL, "Inserted": true, "WriteProtected": true}
+	if err = d.do(ctx, http.MethodPost, media.ODataID+"/Actions/VirtualMedia.InsertMedia", insert, nil); err != nil {
+		return errors.Wrapf(err, "failed to insert the discovery image in %s", media.ODataID)
+	}
+	boot := map[string]interface{}{
+		"Boot": map[string]string{"BootSourceOverrideTarget": "Cd", "BootSourceOverrideEnabled": "Once"},
+	}
+	if err = d.do(ctx, http.MethodPatch, systemPath, boot, nil); err != nil {
+		return errors.Wrapf(err, "failed to set the one-time boot device of %s", systemPath)
+	}
+	return nil
+}
+
+func (d *redfishDriver) GetInventory(ctx context.Context) (*models.BmcInventory, error) {
+	_, system, err := d.getSystem(ctx)
+	if err != nil {
+		return nil, err
+	}
+	media, err := d.getVirtualMedia(ctx, system)
+	if err != nil {
+		d.log.WithError(err).Warn("failed to get the virtual media of the BMC")
+	}
+	return &models.BmcInventory{
+		Driver:                models.BmcInventoryDriverRedfish,
+		Manufacturer:          system.Manufacturer,
+		Model:                 system.Model,
+		SerialNumber:          system.SerialNumber,
+		BiosVersion:           system.BiosVersion,
+		PowerState:            system.PowerState,
+		CPUCount:              system.ProcessorSummary.Count,
+		MemoryGib:             system.MemorySummary.TotalSystemMemoryGiB,
+		VirtualMediaSupported: media != nil,
+	}, nil
+}
+
+// getSystem returns the path and the resource of the system of the host, the first system of the BMC is used when the
+// address doesn't include it
+func (d *redfishDriver) getSystem(ctx context.Context) (string, *redfishSystem, error) {
+	systemPath := d.systemPath
+	if systemPath == "" {
+		var systems redfishCollection
+		if err := d.do(ctx, http.MethodGet, redfishSystemsPath, nil, &systems); err != nil {
+			return "", nil, errors.Wrap(err, "failed to list the systems of the BMC")
+		}
+		if len(systems.Members) == 0 {
+			return "", nil, errors.New("the BMC doesn't have any system")
+		}
+		systemPath = systems.Members[0].ODataID
+	}
+	var system redfishSystem
+	if err := d.do(ctx, http.MethodGet, systemPath, nil, &system); err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get system %s", systemPath)
+	}
+	return systemPath, &system, nil
+}
+
+// getVirtualMedia returns the first virtual media device of the managers of the system that can boot an ISO, or nil
+// when there is none
+func (d *redfishDriver) getVirtualMedia(ctx context.Context, system *redfishSystem) (*redfishVirtualMedia, error) {
+	for _, managerLink := range system.Links.ManagedBy {
+		var manager redfishManager
+		if err := d.do(ctx, http.MethodGet, managerLink.ODataID, nil, &manager); err != nil {
+			return nil, errors.Wrapf(err, "failed to get manager %s", managerLink.ODataID)
+		}
+		if manager.VirtualMedia == nil {
+			continue
+		}
+		var collection redfishCollection
+		if err := d.do(ctx, http.MethodGet, manager.VirtualMedia.ODataID, nil, &collection); err != nil {
+			return nil, errors.Wrapf(err, "failed to list the virtual media of manager %s", managerLink.ODataID)
+		}
+		for _, mediaLink := range collection.Members {
+			var media redfishVirtualMedia
+			if err := d.do(ctx, http.MethodGet, mediaLink.ODataID, nil, &media); err != nil {
+				return nil, errors.Wrapf(err, "failed to get virtual media %s", mediaLink.ODataID)
+			}
+			if len(funk.IntersectString(media.MediaTypes, redfishVirtualMediaTypes)) > 0 {
+				media.ODataID = mediaLink.ODataID
+				return &media, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (d *redfishDriver) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, d.endpoint+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(d.credentials.Username, d.credentials.Password)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Only the status code is reported, the errors are returned to the user and the body is controlled by the BMC
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s %s returned %d %s", method, path, resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,186 @@
+// Package redfishmock provides a minimal Redfish BMC served over HTTPS, with one system and one virtual media device,
+// to test the BMC drivers and their users without real hardware.
+package redfishmock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	SystemPath       = "/redfish/v1/Systems/1"
+	managerPath      = "/redfish/v1/Managers/1"
+	virtualMediaPath = managerPath + "/VirtualMedia"
+	cdPath           = virtualMediaPath + "/Cd"
+)
+
+// State is the state of the mocked host, updated by the requests sent to the server
+type State struct {
+	PowerState                string
+	Image                     string
+	BootSourceOverrideTarget  string
+	BootSourceOverrideEnabled string
+	// Resets are the reset types of the ComputerSystem.Reset actions, in order
+	Resets []string
+}
+
+type Server struct {
+	*httptest.Server
+	username     string
+	password     string
+	mutex        sync.Mutex
+	state        State
+	virtualMedia bool
+}
+
+// NewServer starts a Redfish server that accepts the given credentials, the host is powered off and has a CD virtual
+// media device. The caller must Close the server.
+func NewServer(username, password string) *Server {
+	s := &Server{
+		username:     username,
+		password:     password,
+		state:        State{PowerState: "Off"},
+		virtualMedia: true,
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Address returns the BMC address of the system of the server, the server uses a self-signed certificate
+func (s *Server) Address() string {
+	return "redfish-virtualmedia://" + s.Listener.Addr().String() + SystemPath
+}
+
+// DisableVirtualMedia removes the virtual media devices of the server
+func (s *Server) DisableVirtualMedia() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.virtualMedia = false
+}
+
+// State returns a copy of the state of the mocked host
+func (s *Server) State() State {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	state := s.state
+	state.Resets = append([]string{}, s.state.Resets...)
+	return state
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if username, password, ok := r.BasicAuth(); !ok || username != s.username || password != s.password {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var body map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/redfish/v1/Systems"):
+		s.handleSystem(w, r, body)
+	case r.URL.Path == managerPath && r.Method == http.MethodGet:
+		manager := map[string]interface{}{"@odata.id": managerPath}
+		if s.virtualMedia {
+			manager["VirtualMedia"] = map[string]string{"@odata.id": virtualMediaPath}
+		}
+		writeJSON(w, manager)
+	case strings.HasPrefix(r.URL.Path, virtualMediaPath) && s.virtualMedia:
+		s.handleVirtualMedia(w, r, body)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) handleSystem(w http.ResponseWriter, r *http.Request, body map[string]interface{}) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/redfish/v1/Systems":
+		writeJSON(w, collection(SystemPath))
+	case r.Method == http.MethodGet && r.URL.Path == SystemPath:
+		writeJSON(w, s.system())
+	case r.Method == http.MethodPatch && r.URL.Path == SystemPath:
+		boot, _ := body["Boot"].(map[string]interface{})
+		s.state.BootSourceOverrideTarget, _ = boot["BootSourceOverrideTarget"].(string)
+		s.state.BootSourceOverrideEnabled, _ = boot["BootSourceOverrideEnabled"].(string)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && r.URL.Path == SystemPath+"/Actions/ComputerSystem.Reset":
+		resetType, _ := body["ResetType"].(string)
+		s.state.Resets = append(s.state.Resets, resetType)
+		s.state.PowerState = "On"
+		if strings.HasSuffix(resetType, "Off") {
+			s.state.PowerState = "Off"
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) handleVirtualMedia(w http.ResponseWriter, r *http.Request, body map[string]interface{}) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == virtualMediaPath:
+		writeJSON(w, collection(cdPath))
+	case r.Method == http.MethodGet && r.URL.Path == cdPath:
+		writeJSON(w, map[string]interface{}{
+			"@odata.id":  cdPath,
+			"MediaTypes": []string{"CD", "DVD"},
+			"Image":      s.state.Image,
+			"Inserted":   s.state.Image != "",
+		})
+	case r.Method == http.MethodPost && r.URL.Path == cdPath+"/Actions/VirtualMedia.InsertMedia":
+		if s.state.Image != "" {
+			http.Error(w, "media already inserted", http.StatusConflict)
+			return
+		}
+		s.state.Image, _ = body["Image"].(string)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && r.URL.Path == cdPath+"/Actions/VirtualMedia.EjectMedia":
+		s.state.Image = ""
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) system() map[string]interface{} {
+	return map[string]interface{}{
+		"@odata.id":        SystemPath,
+		"Manufacturer":     "Mock",
+		"Model":            "Mock Server",
+		"SerialNumber":     "MOCK-0001",
+		"BiosVersion":      "1.0.0",
+		"PowerState":       s.state.PowerState,
+		"ProcessorSummary": map[string]interface{}{"Count": 2},
+		"MemorySummary":    map[string]interface{}{"TotalSystemMemoryGiB": 64},
+		"Boot": map[string]string{
+			"BootSourceOverrideTarget":  s.state.BootSourceOverrideTarget,
+			"BootSourceOverrideEnabled": s.state.BootSourceOverrideEnabled,
+		},
+		"Links": map[string]interface{}{
+			"ManagedBy": []map[string]string{{"@odata.id": managerPath}},
+		},
+	}
+}
+
+func collection(members ...string) map[string]interface{} {
+	links := make([]map[string]string, 0, len(members))
+	for _, member := range members {
+		links = append(links, map[string]string{"@odata.id": member})
+	}
+	return map[string]interface{}{"Members": links, "Members@odata.count": len(links)}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/go-version"
 	"github.com/kennygrant/sanitize"
 	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/bmc"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
@@ -55,6 +56,7 @@ import (
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	ctxparams "github.com/openshift/assisted-service/pkg/context"
+	"github.com/openshift/assisted-service/pkg/executer"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	"github.com/openshift/assisted-service/pkg/generator"
 	"github.com/openshift/assisted-service/pkg/k8sclient"
@@ -126,6 +128,8 @@ type Config struct {
 	TNFClustersSupport                  bool              `envconfig:"TNF_CLUSTERS_SUPPORT" default:"false"`
 	ForceInsecurePolicyJson             bool              `envconfig:"FORCE_INSECURE_POLICY_JSON" default:"false"`
 	EnableImageService                  bool              `envconfig:"ENABLE_IMAGE_SERVICE" default:"true"`
+	EnableBMCDrivers                    bool              `envconfig:"ENABLE_BMC_DRIVERS" default:"false"`
+	BMCAllowedNetworks                  string            `envconfig:"BMC_ALLOWED_NETWORKS" default:""`
 
 	// InfraEnv ID for the ephemeral installer. Should not be set explicitly.Ephemeral (agent) installer sets this env var
 	InfraEnvID strfmt.UUID `envconfig:"INFRA_ENV_ID" default:""`
@@ -155,6 +159,7 @@ type InstallerInternals interface {
 	RegisterClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, params installer.V2RegisterClusterParams) (*common.Cluster, error)
 	GetClusterInternal(ctx context.Context, params installer.V2GetClusterParams) (*common.Cluster, error)
 	UpdateClusterNonInteractive(ctx context.Context, params installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, error)
+	ValidateClusterUpdateInternal(ctx context.Context, params installer.V2UpdateClusterParams) error
 	GetClusterByKubeKey(key types.NamespacedName) (*common.Cluster, error)
 	GetHostByKubeKey(key types.NamespacedName) (*common.Host, error)
 	InstallClusterInternal(ctx context.Context, params installer.V2InstallClusterParams) (*common.Cluster, error)
@@ -228,6 +233,7 @@ type bareMetalInventory struct {
 	insecureIPXEURLs              bool
 	installerInvoker              string
 	disconnectedIgnitionGenerator *ignition.DisconnectedIgnitionGenerator
+	bmcFactory                    bmc.Factory
 }
 
 func NewBareMetalInventory(
@@ -297,6 +303,7 @@ func NewBareMetalInventory(
 		insecureIPXEURLs:              insecureIPXEURLs,
 		installerInvoker:              installerInvoker,
 		disconnectedIgnitionGenerator: oveIgnitionGenerator,
+		bmcFactory:                    bmc.NewFactory(log, &executer.CommonExecuter{}, cfg.BMCAllowedNetworks),
 	}
 }
 
@@ -746,6 +753,7 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 			OrgSoftTimeoutsEnabled:       orgSoftTimeoutsEnabled,
 			ControlPlaneCount:            swag.Int64Value(params.NewClusterParams.ControlPlaneCount),
 			LoadBalancer:                 params.NewClusterParams.LoadBalancer,
+			OperatorsCatalogSource:       params.NewClusterParams.OperatorsCatalogSource,
 		},
 		KubeKeyName:                 kubeKey.Name,
 		KubeKeyNamespace:            kubeKey.Namespace,
@@ -772,6 +780,11 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 	}
 	cluster.MonitoredOperators = append(monitoredOperators, newOLMOperators...)
 
+	// The packages of the OLM operators are verified against the catalog source together with their other prerequisites
+	if err = operators.ValidateCatalogSource(cluster.OperatorsCatalogSource); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	if err = b.validateFeatureSupportLevel(ctx, cluster, params.NewClusterParams); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
@@ -2177,6 +2190,26 @@ func (b *bareMetalInventory) UpdateClusterNonInteractive(ctx context.Context, pa
 	return b.v2UpdateClusterInternal(ctx, params, NonInteractive, mirrorRegistryConfiguration)
 }
 
+// ValidateClusterUpdateInternal runs the validations of a cluster update, including the feature support levels,
+// without updating the cluster
+func (b *bareMetalInventory) ValidateClusterUpdateInternal(ctx context.Context, params installer.V2UpdateClusterParams) error {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID)
+		return common.NewApiError(http.StatusNotFound, err)
+	}
+	// the validations set the defaults of the params, they must not leak to the caller
+	updateParams := *params.ClusterUpdateParams
+	params.ClusterUpdateParams = &updateParams
+	_, primaryIPStack, err := b.updatePrimaryIPStack(params, cluster)
+	if err != nil {
+		return err
+	}
+	_, err = b.validateUpdateCluster(ctx, log, cluster, params, primaryIPStack)
+	return err
+}
+
 func getPlatformType(platform *models.Platform) string {
 	if platform != nil && platform.Type != nil {
 		return string(*platform.Type)
@@ -2271,6 +2304,7 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 	var err error
 	var primaryIPStackUpdated bool
 	var primaryIPStack *common.PrimaryIPStack
+	var removedOperators []*models.MonitoredOperator
 	log.Infof("update cluster %s with params: %+v", params.ClusterID, params.ClusterUpdateParams)
 
 	err = b.db.Transaction(func(tx *gorm.DB) error {
@@ -2305,7 +2339,7 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 			return err
 		}
 
-		err = b.updateOperatorsData(ctx, cluster, params, usages, tx, log)
+		removedOperators, err = b.updateOperatorsData(ctx, cluster, params, usages, tx, log)
 		if err != nil {
 			return err
 		}
@@ -2342,6 +2376,10 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 		eventgen.SendProxySettingsChangedEvent(ctx, b.eventsHandler, params.ClusterID)
 	}
 
+	if len(removedOperators) > 0 {
+		operators.CleanupRemovedOperators(ctx, log, b.operatorManagerApi, b.eventsHandler, cluster, removedOperators)
+	}
+
 	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
 		log.WithError(err).Errorf("failed to get cluster %s after update", params.ClusterID)
 		return nil, err
@@ -2689,6 +2727,10 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		b.setDiskEncryptionUsage(&cluster.Cluster, params.ClusterUpdateParams.DiskEncryption, usages)
 	}
 
+	if err = b.updateOperatorsCatalogSource(cluster, params, updates); err != nil {
+		return err
+	}
+
 	if params.ClusterUpdateParams.IgnitionEndpoint != nil {
 		if params.ClusterUpdateParams.IgnitionEndpoint.URL != nil {
 			optionalParam(params.ClusterUpdateParams.IgnitionEndpoint.URL, "ignition_endpoint_url", updates)
@@ -2732,6 +2774,31 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 	return nil
 }
 
+// updateOperatorsCatalogSource replaces the catalog source of the cluster. The operators are verified against the new
+// catalog source when they aren't updated in the same request, otherwise they are verified when they are updated.
+func (b *bareMetalInventory) updateOperatorsCatalogSource(cluster *common.Cluster, params installer.V2UpdateClusterParams, updates map[string]interface{}) error {
+	catalogSource := params.ClusterUpdateParams.OperatorsCatalogSource
+	if catalogSource == nil {
+		return nil
+	}
+	if catalogSource.IndexImage == "" {
+		catalogSource = &models.OperatorsCatalogSource{}
+	}
+	if params.ClusterUpdateParams.OlmOperators == nil {
+		if err := b.operatorManagerApi.EnsureOperatorsCatalogSource(catalogSource, cluster.MonitoredOperators); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	} else if err := operators.ValidateCatalogSource(catalogSource); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	cluster.OperatorsCatalogSource = catalogSource
+	updates["operators_catalog_source_name"] = catalogSource.Name
+	updates["operators_catalog_source_index_image"] = catalogSource.IndexImage
+	updates["operators_catalog_source_display_name"] = catalogSource.DisplayName
+	updates["operators_catalog_source_packages"] = catalogSource.Packages
+	return nil
+}
+
 func wereClusterVipsUpdated(clusterVips []string, paramVips []string) bool {
 	if paramVips == nil {
 		return false
@@ -3271,19 +3338,20 @@ func (b *bareMetalInventory) updateClusterCPUFeatureUsage(cpuArchitecture string
 
 // This code is very similar to internal/cluster/refresh_status_preprocessor.go:recalculateOperatorDependencies
 // TODO: Refactor this to a common place if possible
-func (b *bareMetalInventory) updateOperatorsData(ctx context.Context, cluster *common.Cluster, params installer.V2UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) error {
+// Returns the OLM operators removed from the cluster
+func (b *bareMetalInventory) updateOperatorsData(ctx context.Context, cluster *common.Cluster, params installer.V2UpdateClusterParams, usages map[string]models.Usage, db *gorm.DB, log logrus.FieldLogger) ([]*models.MonitoredOperator, error) {
 	if params.ClusterUpdateParams.OlmOperators == nil {
-		return nil
+		return nil, nil
 	}
 
 	updateOLMOperators, err := b.getOLMOperators(cluster, params.ClusterUpdateParams.OlmOperators, log)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	infraEnvs, err := b.ListInfraEnvsInternal(ctx, cluster.ID, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Validate with infra-envs CPU architecture
@@ -3291,7 +3359,7 @@ func (b *bareMetalInventory) updateOperatorsData(ctx context.Context, cluster *c
 		err = b.operatorManagerApi.EnsureOperatorPrerequisite(cluster, cluster.OpenshiftVersion, infraEnv.CPUArchitecture, updateOLMOperators)
 		if err != nil {
 			log.Error(err)
-			return common.NewApiError(http.StatusBadRequest, err)
+			return nil, common.NewApiError(http.StatusBadRequest, err)
 		}
 	}
 
@@ -3299,7 +3367,7 @@ func (b *bareMetalInventory) updateOperatorsData(ctx context.Context, cluster *c
 	err = b.operatorManagerApi.EnsureOperatorPrerequisite(cluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, updateOLMOperators)
 	if err != nil {
 		log.Error(err)
-		return common.NewApiError(http.StatusBadRequest, err)
+		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
 	for _, updatedOperator := range updateOLMOperators {
@@ -3307,7 +3375,7 @@ func (b *bareMetalInventory) updateOperatorsData(ctx context.Context, cluster *c
 		if err = db.Save(updatedOperator).Error; err != nil {
 			err = errors.Wrapf(err, "failed to update operator %s of cluster %s", updatedOperator.Name, params.ClusterID)
 			log.Error(err)
-			return common.NewApiError(http.StatusInternalServerError, err)
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
 		}
 	}
 
@@ -3323,7 +3391,7 @@ func (b *bareMetalInventory) updateOperatorsData(ctx context.Context, cluster *c
 			if err = db.Where("name = ? and cluster_id = ?", clusterOperator.Name, params.ClusterID).Delete(&models.MonitoredOperator{}).Error; err != nil {
 				err = errors.Wrapf(err, "failed to delete operator %s of cluster %s", clusterOperator.Name, params.ClusterID)
 				log.Error(err)
-				return common.NewApiError(http.StatusInternalServerError, err)
+				return nil, common.NewApiError(http.StatusInternalServerError, err)
 			}
 		}
 	}
@@ -3336,13 +3404,13 @@ func (b *bareMetalInventory) updateOperatorsData(ctx context.Context, cluster *c
 	if len(updateOLMOperators) > 0 || len(removedOLMOperators) > 0 {
 		if count, reset_err := common.ResetAutoAssignRoles(db, params.ClusterID.String()); reset_err != nil {
 			log.WithError(err).Errorf("fail to reset auto-assign role in cluster %s", params.ClusterID.String())
-			return common.NewApiError(http.StatusInternalServerError, reset_err)
+			return nil, common.NewApiError(http.StatusInternalServerError, reset_err)
 		} else {
 			log.Infof("resetting auto-assing roles on cluster %s after operator setup has changed: %d hosts affected", params.ClusterID.String(), count)
 		}
 	}
 
-	return nil
+	return removedOLMOperators, nil
 }
 
 func (b *bareMetalInventory) getOLMOperators(cluster *common.Cluster, newOperators []*models.OperatorCreateParams, log logrus.FieldLogger) ([]*models.MonitoredOperator, error) {
@@ -3358,6 +3426,9 @@ func (b *bareMetalInventory) getOLMOperators(cluster *common.Cluster, newOperato
 		}
 
 		operator.Properties = newOperator.Properties
+		operator.Channel = newOperator.Channel
+		operator.StartingCsv = newOperator.StartingCsv
+		operator.InstallPlanApproval = newOperator.InstallPlanApproval
 		monitoredOperators = append(monitoredOperators, operator)
 	}
 
@@ -4845,6 +4916,9 @@ func (b *bareMetalInventory) DeregisterInfraEnvInternal(ctx context.Context, par
 		log.WithError(err).Errorf("failed to deregister infraEnv %s", params.InfraEnvID)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	if bmcErr := b.deleteInfraEnvBmcReferences(ctx, params.InfraEnvID); bmcErr != nil {
+		log.WithError(bmcErr).Warnf("failed to delete the BMC references of infraEnv %s", params.InfraEnvID)
+	}
 	success = true
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -38,6 +39,8 @@ import (
 	gomega_format "github.com/onsi/gomega/format"
 	amgmtv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/bmc"
+	"github.com/openshift/assisted-service/internal/bmc/redfishmock"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
@@ -1794,6 +1797,29 @@ var _ = Describe("cluster", func() {
 			addHost(masterHostId3, models.HostRoleMaster, "known", models.HostKindHost, infraEnvID, clusterID, getInventoryStr("hostname2", "bootMode", "1.2.3.6/24", "7.8.9.10/24"), db)
 		})
 
+		Context("V2GetClusterNetworkTopology", func() {
+			It("returns the topology of the cluster hosts", func() {
+				reply := bm.V2GetClusterNetworkTopology(ctx, installer.V2GetClusterNetworkTopologyParams{
+					ClusterID: clusterID,
+				})
+				Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetClusterNetworkTopologyOK()))
+				topology := reply.(*installer.V2GetClusterNetworkTopologyOK).Payload
+				kinds := make(map[string]int)
+				for _, node := range topology.Nodes {
+					kinds[swag.StringValue(node.Kind)]++
+				}
+				Expect(kinds[models.NetworkTopologyNodeKindHost]).To(Equal(3))
+				Expect(kinds[models.NetworkTopologyNodeKindVip]).To(Equal(2))
+				Expect(topology.Dot).To(ContainSubstring("hostname0"))
+			})
+			It("returns not found when the cluster doesn't exist", func() {
+				reply := bm.V2GetClusterNetworkTopology(ctx, installer.V2GetClusterNetworkTopologyParams{
+					ClusterID: strfmt.UUID(uuid.New().String()),
+				})
+				Expect(reply).To(BeAssignableToTypeOf(common.NewApiError(http.StatusNotFound, errors.Errorf(""))))
+			})
+		})
+
 		Context("ListClusterHosts", func() {
 			workerHostId1 := strfmt.UUID(uuid.New().String())
 			workerHostId2 := strfmt.UUID(uuid.New().String())
@@ -2915,6 +2941,7 @@ var _ = Describe("cluster", func() {
 					originalOperators []*models.MonitoredOperator
 					updateOperators   []*models.OperatorCreateParams
 					expectedOperators []*models.MonitoredOperator
+					removedOperators  []string
 				}{
 					{
 						name:              "No operators",
@@ -2927,6 +2954,7 @@ var _ = Describe("cluster", func() {
 						originalOperators: []*models.MonitoredOperator{&common.TestDefaultConfig.MonitoredOperator, testOLMOperators[0]},
 						updateOperators:   []*models.OperatorCreateParams{},
 						expectedOperators: []*models.MonitoredOperator{&common.TestDefaultConfig.MonitoredOperator},
+						removedOperators:  []string{testOLMOperators[0].Name},
 					},
 					{
 						name:              "No change",
@@ -2994,6 +3022,7 @@ var _ = Describe("cluster", func() {
 						originalOperators: []*models.MonitoredOperator{&common.TestDefaultConfig.MonitoredOperator, testOLMOperators[0], testOLMOperators[1]},
 						updateOperators:   []*models.OperatorCreateParams{{Name: testOLMOperators[1].Name}},
 						expectedOperators: []*models.MonitoredOperator{&common.TestDefaultConfig.MonitoredOperator, testOLMOperators[1]},
+						removedOperators:  []string{testOLMOperators[0].Name},
 					},
 				}
 
@@ -3038,6 +3067,15 @@ var _ = Describe("cluster", func() {
 						if test.updateOperators != nil {
 							mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 						}
+						if len(test.removedOperators) > 0 {
+							mockOperatorManager.EXPECT().RemoveOperatorsManifests(gomock.Any(), gomock.Any(), gomock.Any()).
+								Return([]string{"50_operator_subscription.yaml"}, nil).Times(1)
+							mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+								eventstest.WithNameMatcher(eventgen.ClusterOperatorsRemovedEventName),
+								eventstest.WithClusterIdMatcher(clusterID.String()),
+								eventstest.WithMessageContainsMatcher(strings.Join(test.removedOperators, ", ")),
+								eventstest.WithMessageContainsMatcher("50_operator_subscription.yaml"))).Times(1)
+						}
 
 						reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
 							ClusterID: clusterID,
@@ -21106,6 +21144,228 @@ func containsMonitoredOperator(l []*models.MonitoredOperator, m *models.Monitore
 	return false
 }
 
+var _ = Describe("V2RunBmcAction", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		dbName     string
+		infraEnvID strfmt.UUID
+		server     *redfishmock.Server
+		isoURL     = "https://images.example.com/images/discovery.iso"
+		objects    map[string][]byte
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		cfg.EnableBMCDrivers = true
+		cfg.BMCAllowedNetworks = "127.0.0.0/8, ::1/128"
+		bm = createInventory(db, cfg)
+		server = redfishmock.NewServer("admin", "password")
+
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.InfraEnv{
+			InfraEnv: models.InfraEnv{ID: &infraEnvID, DownloadURL: isoURL},
+		}).Error).To(Succeed())
+
+		// The object store keeps the BMC passwords
+		objects = make(map[string][]byte)
+		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, data []byte, objectName string) error {
+				objects[objectName] = data
+				return nil
+			}).AnyTimes()
+		mockS3Client.EXPECT().Download(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, objectName string) (io.ReadCloser, int64, error) {
+				data, ok := objects[objectName]
+				if !ok {
+					return nil, 0, errors.Errorf("object %s not found", objectName)
+				}
+				return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+			}).AnyTimes()
+		mockS3Client.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, objectName string) (bool, error) {
+				_, ok := objects[objectName]
+				delete(objects, objectName)
+				return ok, nil
+			}).AnyTimes()
+	})
+
+	AfterEach(func() {
+		server.Close()
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	registerBmc := func(address string) middleware.Responder {
+		return bm.V2RegisterBmcReference(ctx, installer.V2RegisterBmcReferenceParams{
+			InfraEnvID: infraEnvID,
+			BmcReferenceCreateParams: &models.BmcReferenceCreateParams{
+				Name:                    "master-0",
+				Address:                 swag.String(address),
+				Username:                swag.String("admin"),
+				Password:                (*strfmt.Password)(swag.String("password")),
+				CertificateVerification: swag.String(models.BmcReferenceCreateParamsCertificateVerificationDisabled),
+			},
+		})
+	}
+
+	registeredBmc := func(address string) strfmt.UUID {
+		resp := registerBmc(address)
+		Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2RegisterBmcReferenceCreated()))
+		return *resp.(*installer.V2RegisterBmcReferenceCreated).Payload.ID
+	}
+
+	runAction := func(action models.BmcAction, bmcReferenceID strfmt.UUID) middleware.Responder {
+		return bm.V2RunBmcAction(ctx, installer.V2RunBmcActionParams{
+			InfraEnvID: infraEnvID,
+			BmcActionParams: &models.BmcActionParams{
+				Action:         &action,
+				BmcReferenceID: &bmcReferenceID,
+			},
+		})
+	}
+
+	Context("BMC references", func() {
+		It("keeps the password in the object store only", func() {
+			id := registeredBmc(server.Address())
+			Expect(objects).To(HaveKeyWithValue(bmcReferencePasswordObject(infraEnvID, id), []byte("password")))
+
+			resp := bm.V2ListBmcReferences(ctx, installer.V2ListBmcReferencesParams{InfraEnvID: infraEnvID})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2ListBmcReferencesOK()))
+			references := resp.(*installer.V2ListBmcReferencesOK).Payload
+			Expect(references).To(HaveLen(1))
+			Expect(*references[0].ID).To(Equal(id))
+			Expect(references[0].Name).To(Equal("master-0"))
+			Expect(swag.StringValue(references[0].Address)).To(Equal(server.Address()))
+			Expect(references[0].CertificateVerification).To(Equal(models.BmcReferenceCertificateVerificationDisabled))
+			payload, err := json.Marshal(references)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(payload)).ToNot(ContainSubstring("password"))
+		})
+
+		It("deletes the password with the reference", func() {
+			id := registeredBmc(server.Address())
+			resp := bm.V2DeregisterBmcReference(ctx, installer.V2DeregisterBmcReferenceParams{InfraEnvID: infraEnvID, BmcReferenceID: id})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2DeregisterBmcReferenceNoContent()))
+			Expect(objects).To(BeEmpty())
+			verifyApiError(runAction(models.BmcActionPowerCycle, id), http.StatusNotFound)
+		})
+
+		It("deletes the references of a deregistered infra-env", func() {
+			registeredBmc(server.Address())
+			Expect(bm.deleteInfraEnvBmcReferences(ctx, infraEnvID)).To(Succeed())
+			Expect(objects).To(BeEmpty())
+			var count int64
+			Expect(db.Model(&models.BmcReference{}).Where("infra_env_id = ?", infraEnvID.String()).Count(&count).Error).To(Succeed())
+			Expect(count).To(BeZero())
+		})
+
+		It("rejects an unsupported BMC address", func() {
+			verifyApiError(registerBmc("idrac://bmc.example.com"), http.StatusBadRequest)
+			Expect(objects).To(BeEmpty())
+		})
+
+		It("rejects a BMC address outside of the allowed networks", func() {
+			verifyApiError(registerBmc("redfish://192.0.2.10/redfish/v1/Systems/1"), http.StatusBadRequest)
+			Expect(objects).To(BeEmpty())
+		})
+
+		It("returns NotFound for the reference of another infra-env", func() {
+			id := registeredBmc(server.Address())
+			resp := bm.V2DeregisterBmcReference(ctx, installer.V2DeregisterBmcReferenceParams{
+				InfraEnvID:     strfmt.UUID(uuid.New().String()),
+				BmcReferenceID: id,
+			})
+			verifyApiError(resp, http.StatusNotFound)
+			Expect(objects).To(HaveLen(1))
+		})
+	})
+
+	It("boots the host from the discovery image of the infra-env", func() {
+		id := registeredBmc(server.Address())
+		mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.BmcActionPerformedEventName),
+			eventstest.WithInfraEnvIdMatcher(infraEnvID.String()))).Times(1)
+
+		resp := runAction(models.BmcActionBootDiscoveryImage, id)
+		Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2RunBmcActionOK()))
+		inventory := resp.(*installer.V2RunBmcActionOK).Payload
+		Expect(inventory.Driver).To(Equal(models.BmcInventoryDriverRedfish))
+		Expect(inventory.PowerState).To(Equal("On"))
+
+		state := server.State()
+		Expect(state.Image).To(Equal(isoURL))
+		Expect(state.BootSourceOverrideEnabled).To(Equal("Once"))
+		Expect(state.Resets).To(Equal([]string{"On"}))
+	})
+
+	It("only reads the inventory", func() {
+		resp := runAction(models.BmcActionGetInventory, registeredBmc(server.Address()))
+		Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2RunBmcActionOK()))
+		Expect(resp.(*installer.V2RunBmcActionOK).Payload.SerialNumber).To(Equal("MOCK-0001"))
+		Expect(server.State().Resets).To(BeEmpty())
+	})
+
+	It("fails when the BMC doesn't support virtual media", func() {
+		id := registeredBmc(server.Address())
+		server.DisableVirtualMedia()
+		mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.BmcActionFailedEventName),
+			eventstest.WithInfraEnvIdMatcher(infraEnvID.String()))).Times(1)
+
+		verifyApiError(runAction(models.BmcActionBootDiscoveryImage, id), http.StatusBadRequest)
+		Expect(server.State().Resets).To(BeEmpty())
+	})
+
+	It("fails when the discovery image isn't available", func() {
+		id := registeredBmc(server.Address())
+		Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID.String()).Update("download_url", "").Error).To(Succeed())
+		mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.BmcActionFailedEventName))).Times(1)
+
+		verifyApiError(runAction(models.BmcActionBootDiscoveryImage, id), http.StatusConflict)
+	})
+
+	It("fails when the credentials of the reference are missing", func() {
+		id := registeredBmc(server.Address())
+		delete(objects, bmcReferencePasswordObject(infraEnvID, id))
+		verifyApiError(runAction(models.BmcActionPowerCycle, id), http.StatusInternalServerError)
+		Expect(server.State().Resets).To(BeEmpty())
+	})
+
+	It("doesn't connect to a BMC whose host name resolves outside of the allowed networks", func() {
+		_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		id := registeredBmc("redfish://localhost:" + port + redfishmock.SystemPath)
+		bm.bmcFactory = bmc.NewFactory(common.GetTestLog(), nil, "192.0.2.0/24")
+		mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.BmcActionFailedEventName))).Times(1)
+
+		verifyApiError(runAction(models.BmcActionPowerCycle, id), http.StatusBadRequest)
+		Expect(server.State().Resets).To(BeEmpty())
+	})
+
+	It("fails when the BMC drivers are disabled", func() {
+		id := registeredBmc(server.Address())
+		bm.EnableBMCDrivers = false
+		verifyApiError(runAction(models.BmcActionPowerCycle, id), http.StatusBadRequest)
+		verifyApiError(registerBmc(server.Address()), http.StatusBadRequest)
+	})
+
+	It("returns NotFound for a missing BMC reference", func() {
+		verifyApiError(runAction(models.BmcActionPowerCycle, strfmt.UUID(uuid.New().String())), http.StatusNotFound)
+	})
+
+	It("returns NotFound for a missing infraEnv", func() {
+		id := registeredBmc(server.Address())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		verifyApiError(runAction(models.BmcActionPowerCycle, id), http.StatusNotFound)
+		verifyApiError(registerBmc(server.Address()), http.StatusNotFound)
+	})
+})
+
 var _ = Describe("RegenerateInfraEnvSigningKey", func() {
 	var (
 		bm         *bareMetalInventory
@@ -16,6 +16,7 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/bmc"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	"github.com/openshift/assisted-service/internal/constants"
@@ -23,7 +24,9 @@ import (
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/imageservice"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	ctxparams "github.com/openshift/assisted-service/pkg/context"
@@ -32,6 +35,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
 	"gorm.io/gorm"
 )
@@ -147,24 +151,25 @@ func (b *bareMetalInventory) GetDetailedSupportedFeatures(ctx context.Context, p
 	features := make([]*models.Feature, 0)
 	operators := make([]*models.Operator, 0)
 
+	// The features of the declarative operators are reported with an ID of the API enum, see APIFeatureSupportLevelID
 	for _, feature := range featureSupportList {
 		operator, isOperator := operatorMap[feature.FeatureSupportLevelID]
 
 		if isOperator {
 			operators = append(operators, &models.Operator{
-				FeatureSupportLevelID: feature.FeatureSupportLevelID,
+				FeatureSupportLevelID: featuresupport.APIFeatureSupportLevelID(feature.FeatureSupportLevelID),
 				SupportLevel:          feature.SupportLevel,
 				Reason:                feature.Reason,
-				Incompatibilities:     feature.Incompatibilities,
+				Incompatibilities:     featuresupport.APIFeatureSupportLevelIDs(feature.Incompatibilities),
 				Name:                  &operator.OperatorName,
-				Dependencies:          operator.Dependencies,
+				Dependencies:          featuresupport.APIFeatureSupportLevelIDs(operator.Dependencies),
 			})
 		} else {
 			features = append(features, &models.Feature{
 				FeatureSupportLevelID: feature.FeatureSupportLevelID,
 				SupportLevel:          feature.SupportLevel,
 				Reason:                feature.Reason,
-				Incompatibilities:     feature.Incompatibilities,
+				Incompatibilities:     featuresupport.APIFeatureSupportLevelIDs(feature.Incompatibilities),
 			})
 		}
 	}
@@ -366,6 +371,60 @@ func (b *bareMetalInventory) V2GetPreflightRequirements(ctx context.Context, par
 	return installer.NewV2GetPreflightRequirementsOK().WithPayload(requirements)
 }
 
+func (b *bareMetalInventory) V2GetClusterNetworkTopology(ctx context.Context, params installer.V2GetClusterNetworkTopologyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	topology, err := network.BuildNetworkTopology(cluster)
+	if err != nil {
+		log.WithError(err).Errorf("failed to build network topology for cluster %s", params.ClusterID)
+		return common.GenerateErrorResponderWithDefault(err, http.StatusInternalServerError)
+	}
+	return installer.NewV2GetClusterNetworkTopologyOK().WithPayload(topology)
+}
+
+// getReservedVipAddresses returns the addresses of the other clusters of the organization that share a network with
+// the cluster, so that they are not suggested as VIPs of the cluster
+func (b *bareMetalInventory) getReservedVipAddresses(cluster *common.Cluster, log logrus.FieldLogger) ([]string, error) {
+	others, err := common.GetClustersFromDBWhere(b.db, common.UseEagerLoading, common.SkipDeletedRecords,
+		"id <> ? and org_id = ?", cluster.ID.String(), cluster.OrgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the other clusters of the organization")
+	}
+	return network.ReservedVipAddresses(cluster, others, log), nil
+}
+
+func (b *bareMetalInventory) V2GetClusterVipSuggestions(ctx context.Context, params installer.V2GetClusterVipSuggestionsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	reserved, err := b.getReservedVipAddresses(cluster, log)
+	if err != nil {
+		log.WithError(err).Errorf("failed to suggest VIPs for cluster %s", params.ClusterID)
+		return common.GenerateErrorResponderWithDefault(err, http.StatusInternalServerError)
+	}
+	suggestions := network.SuggestVips(cluster, reserved, int(swag.Int64Value(params.MaxCandidates)), log)
+	return installer.NewV2GetClusterVipSuggestionsOK().WithPayload(suggestions)
+}
+
+func (b *bareMetalInventory) V2GetClusterIPStackReadiness(ctx context.Context, params installer.V2GetClusterIPStackReadinessParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	// The registry checks are reported as unknown when the release image of the cluster cannot be determined
+	releaseImageHost, err := versions.GetReleaseImageHost(cluster, b.versionsHandler)
+	if err != nil {
+		log.WithError(err).Warnf("failed to get release image host of cluster %s", params.ClusterID)
+	}
+	return installer.NewV2GetClusterIPStackReadinessOK().WithPayload(network.BuildIPStackReadiness(cluster, releaseImageHost, log))
+}
+
 func (b *bareMetalInventory) V2UploadClusterIngressCert(ctx context.Context, params installer.V2UploadClusterIngressCertParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("UploadClusterIngressCert for cluster %s with params %s", params.ClusterID, params.IngressCertParams)
@@ -885,6 +944,209 @@ func (b *bareMetalInventory) GetInfraEnvDownloadURL(ctx context.Context, params
 	return installer.NewGetInfraEnvDownloadURLOK().WithPayload(&models.PresignedURL{URL: &newURL, ExpiresAt: *expiresAt})
 }
 
+// bmcReferencePasswordObject returns the name of the object that keeps the password of a BMC reference.  The
+// passwords are kept in the object store with the other credentials of the service, never in the database.
+func bmcReferencePasswordObject(infraEnvID, bmcReferenceID strfmt.UUID) string {
+	return fmt.Sprintf("%s/bmc-references/%s/password", infraEnvID, bmcReferenceID)
+}
+
+func (b *bareMetalInventory) V2RegisterBmcReference(ctx context.Context, params installer.V2RegisterBmcReferenceParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if !b.EnableBMCDrivers {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusBadRequest, errors.New("BMC drivers are disabled")))
+	}
+	if _, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	createParams := params.BmcReferenceCreateParams
+	id := strfmt.UUID(uuid.New().String())
+	reference := &models.BmcReference{
+		ID:                      &id,
+		InfraEnvID:              &params.InfraEnvID,
+		Name:                    createParams.Name,
+		Address:                 createParams.Address,
+		Username:                createParams.Username,
+		CertificateVerification: swag.StringValue(createParams.CertificateVerification),
+		CreatedAt:               time.Now(),
+	}
+	// The address is checked by the drivers factory, so that no BMC outside of the allowed networks is stored
+	if _, err := b.bmcFactory.NewDriver(bmcCredentials(reference, createParams.Password.String())); err != nil {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusBadRequest, err))
+	}
+
+	passwordObject := bmcReferencePasswordObject(params.InfraEnvID, id)
+	if err := b.objectHandler.Upload(ctx, []byte(createParams.Password.String()), passwordObject); err != nil {
+		log.WithError(err).Errorf("failed to store the credentials of BMC %s of infra-env %s", swag.StringValue(reference.Address), params.InfraEnvID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, errors.New("failed to store the BMC credentials")))
+	}
+	if err := b.db.Create(reference).Error; err != nil {
+		log.WithError(err).Errorf("failed to register BMC %s of infra-env %s", swag.StringValue(reference.Address), params.InfraEnvID)
+		if _, deleteErr := b.objectHandler.DeleteObject(ctx, passwordObject); deleteErr != nil {
+			log.WithError(deleteErr).Errorf("failed to delete the credentials of BMC reference %s", id)
+		}
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	log.Infof("Registered BMC %s as BMC reference %s of infra-env %s", swag.StringValue(reference.Address), id, params.InfraEnvID)
+	return installer.NewV2RegisterBmcReferenceCreated().WithPayload(reference)
+}
+
+func (b *bareMetalInventory) V2ListBmcReferences(ctx context.Context, params installer.V2ListBmcReferencesParams) middleware.Responder {
+	if _, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	references := models.BmcReferenceList{}
+	if err := b.db.Where("infra_env_id = ?", params.InfraEnvID.String()).Order("created_at").Find(&references).Error; err != nil {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	return installer.NewV2ListBmcReferencesOK().WithPayload(references)
+}
+
+func (b *bareMetalInventory) V2DeregisterBmcReference(ctx context.Context, params installer.V2DeregisterBmcReferenceParams) middleware.Responder {
+	reference, err := b.getBmcReference(params.InfraEnvID, params.BmcReferenceID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = b.deleteBmcReference(ctx, reference); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2DeregisterBmcReferenceNoContent()
+}
+
+// getBmcReference returns the BMC reference of the infra-env
+func (b *bareMetalInventory) getBmcReference(infraEnvID, bmcReferenceID strfmt.UUID) (*models.BmcReference, error) {
+	var reference models.BmcReference
+	err := b.db.Take(&reference, "id = ? and infra_env_id = ?", bmcReferenceID.String(), infraEnvID.String()).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("BMC reference %s of infra-env %s not found", bmcReferenceID, infraEnvID))
+	}
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &reference, nil
+}
+
+// deleteBmcReference deletes the credentials of the BMC reference before the reference itself, so that no credentials
+// are left behind without a reference
+func (b *bareMetalInventory) deleteBmcReference(ctx context.Context, reference *models.BmcReference) error {
+	if _, err := b.objectHandler.DeleteObject(ctx, bmcReferencePasswordObject(*reference.InfraEnvID, *reference.ID)); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to delete the credentials of BMC reference %s", *reference.ID))
+	}
+	if err := b.db.Delete(&models.BmcReference{}, "id = ?", reference.ID.String()).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// deleteInfraEnvBmcReferences deletes the BMC references of a deregistered infra-env
+func (b *bareMetalInventory) deleteInfraEnvBmcReferences(ctx context.Context, infraEnvID strfmt.UUID) error {
+	var references []*models.BmcReference
+	if err := b.db.Where("infra_env_id = ?", infraEnvID.String()).Find(&references).Error; err != nil {
+		return err
+	}
+	for _, reference := range references {
+		if err := b.deleteBmcReference(ctx, reference); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bmcCredentials returns the credentials of the BMC reference
+func bmcCredentials(reference *models.BmcReference, password string) bmc.Credentials {
+	return bmc.Credentials{
+		Address:                        swag.StringValue(reference.Address),
+		Username:                       swag.StringValue(reference.Username),
+		Password:                       password,
+		DisableCertificateVerification: reference.CertificateVerification == models.BmcReferenceCertificateVerificationDisabled,
+	}
+}
+
+func (b *bareMetalInventory) V2RunBmcAction(ctx context.Context, params installer.V2RunBmcActionParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if !b.EnableBMCDrivers {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusBadRequest, errors.New("BMC drivers are disabled")))
+	}
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	reference, err := b.getBmcReference(params.InfraEnvID, *params.BmcActionParams.BmcReferenceID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	password, err := b.getBmcReferencePassword(ctx, reference)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the credentials of BMC reference %s", *reference.ID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, errors.New("failed to get the BMC credentials")))
+	}
+
+	action := *params.BmcActionParams.Action
+	address := swag.StringValue(reference.Address)
+	driver, err := b.bmcFactory.NewDriver(bmcCredentials(reference, password))
+	if err != nil {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusBadRequest, err))
+	}
+
+	log.Infof("Running BMC action %s on the host with BMC %s for infra-env %s", action, address, params.InfraEnvID)
+	if err = b.runBmcAction(ctx, driver, action, infraEnv); err != nil {
+		log.WithError(err).Errorf("BMC action %s failed on the host with BMC %s", action, address)
+		eventgen.SendBmcActionFailedEvent(ctx, b.eventsHandler, params.InfraEnvID, string(action), address, err.Error())
+		return common.GenerateErrorResponder(err)
+	}
+	if action != models.BmcActionGetInventory {
+		eventgen.SendBmcActionPerformedEvent(ctx, b.eventsHandler, params.InfraEnvID, string(action), address)
+	}
+
+	inventory, err := driver.GetInventory(ctx)
+	if err != nil {
+		return common.GenerateErrorResponder(bmcActionError(errors.Wrapf(err, "failed to get the inventory of the host with BMC %s", address)))
+	}
+	return installer.NewV2RunBmcActionOK().WithPayload(inventory)
+}
+
+// getBmcReferencePassword reads the password of the BMC reference from the object store
+func (b *bareMetalInventory) getBmcReferencePassword(ctx context.Context, reference *models.BmcReference) (string, error) {
+	reader, _, err := b.objectHandler.Download(ctx, bmcReferencePasswordObject(*reference.InfraEnvID, *reference.ID))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	password, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(password), nil
+}
+
+// runBmcAction runs the action on the host, the boot-discovery-image action boots the host once from the discovery
+// image of the infra-env
+func (b *bareMetalInventory) runBmcAction(ctx context.Context, driver bmc.Driver, action models.BmcAction, infraEnv *common.InfraEnv) error {
+	switch action {
+	case models.BmcActionBootDiscoveryImage:
+		if infraEnv.DownloadURL == "" {
+			return common.NewApiError(http.StatusConflict, errors.Errorf("the discovery image of infra-env %s is not available", infraEnv.ID))
+		}
+		if err := driver.SetOneTimeBootVirtualMedia(ctx, infraEnv.DownloadURL); err != nil {
+			return bmcActionError(errors.Wrap(err, "failed to boot the host from the discovery image"))
+		}
+		fallthrough
+	case models.BmcActionPowerCycle:
+		if err := driver.PowerCycle(ctx); err != nil {
+			return bmcActionError(errors.Wrap(err, "failed to power cycle the host"))
+		}
+	}
+	return nil
+}
+
+// bmcActionError returns a bad request error when the BMC can't be used for the action, an internal error otherwise
+func bmcActionError(err error) error {
+	if errors.Is(err, bmc.ErrVirtualMediaNotSupported) || errors.Is(err, bmc.ErrAddressNotAllowed) {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	return common.NewApiError(http.StatusInternalServerError, err)
+}
+
 func (b *bareMetalInventory) generateShortImageDownloadURL(infraEnvID, imageType, version, arch, imageTokenKey string) (string, *strfmt.DateTime, error) {
 	switch b.authHandler.AuthType() {
 	case auth.TypeLocal:
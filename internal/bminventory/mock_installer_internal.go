@@ -576,6 +576,20 @@ func (mr *MockInstallerInternalsMockRecorder) V2UpdateHostInternal(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UpdateHostInternal", reflect.TypeOf((*MockInstallerInternals)(nil).V2UpdateHostInternal), arg0, arg1, arg2)
 }
 
+// ValidateClusterUpdateInternal mocks base method.
+func (m *MockInstallerInternals) ValidateClusterUpdateInternal(arg0 context.Context, arg1 installer.V2UpdateClusterParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateClusterUpdateInternal", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateClusterUpdateInternal indicates an expected call of ValidateClusterUpdateInternal.
+func (mr *MockInstallerInternalsMockRecorder) ValidateClusterUpdateInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateClusterUpdateInternal", reflect.TypeOf((*MockInstallerInternals)(nil).ValidateClusterUpdateInternal), arg0, arg1)
+}
+
 // ValidatePullSecret mocks base method.
 func (m *MockInstallerInternals) ValidatePullSecret(arg0 []string, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -1730,6 +1730,7 @@ func (m *Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfm
 		modelsToDelete := []interface{}{
 			&models.Event{},
 			&models.MonitoredOperator{},
+			&models.MonitoredOperatorHealthRecord{},
 			&models.ClusterNetwork{},
 			&models.ServiceNetwork{},
 			&models.MachineNetwork{},
@@ -1895,10 +1896,75 @@ func (m *Manager) updateSchedulableMastersForcedTrue(ctx context.Context, cluste
 	return nil
 }
 
+// vipVerificationStatuses are the cluster statuses in which the verification result of the VIPs is updated.  The VIPs
+// keep being checked for conflicts later on, but once the installation starts the cluster hosts answer for them.
+var vipVerificationStatuses = []string{
+	models.ClusterStatusInsufficient,
+	models.ClusterStatusReady,
+	models.ClusterStatusPendingForInput,
+	models.ClusterStatusPreparingForInstallation,
+}
+
+type vipConflictChange struct {
+	vipType    models.VipType
+	vip        string
+	macAddress string
+}
+
+// clusterMacAddresses returns the MAC addresses of all the interfaces of the cluster hosts
+func clusterMacAddresses(cluster *common.Cluster) map[string]bool {
+	ret := make(map[string]bool)
+	for _, h := range cluster.Hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			continue
+		}
+		for _, intf := range inventory.Interfaces {
+			if intf.MacAddress != "" {
+				ret[strings.ToLower(intf.MacAddress)] = true
+			}
+		}
+	}
+	return ret
+}
+
+// unknownConflictingMacAddress is recorded as the conflicting MAC address of a VIP when the agent doesn't report who
+// answered for it
+const unknownConflictingMacAddress = "unknown"
+
+// conflictingMacAddress returns the MAC address of the device outside the cluster that answers for the VIP.  When
+// the response does not tell who answered, the conflict is recorded with an unknown MAC address before the
+// installation. Once the installation starts the cluster hosts answer for the VIPs, so the currently known conflict
+// is kept.
+func conflictingMacAddress(response *models.VerifiedVip, current string, macAddresses map[string]bool, beforeInstallation bool) string {
+	switch *response.Verification {
+	case models.VipVerificationSucceeded:
+		return ""
+	case models.VipVerificationFailed:
+		mac := strings.ToLower(response.ConflictingMacAddress.String())
+		if mac == "" {
+			if current == "" && beforeInstallation {
+				return unknownConflictingMacAddress
+			}
+			return current
+		}
+		if macAddresses[mac] {
+			return ""
+		}
+		return mac
+	default:
+		return current
+	}
+}
+
 func (m *Manager) HandleVerifyVipsResponse(ctx context.Context, clusterID strfmt.UUID, stepReply string) error {
 	log := logutil.FromContext(ctx, m.log)
-	return m.db.Transaction(func(tx *gorm.DB) error {
-		cluster, err := common.GetClusterFromDBWithVips(tx, clusterID)
+	var conflictChanges []vipConflictChange
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		cluster, err := common.GetClusterFromDBWithHosts(tx, clusterID)
 		if err != nil {
 			log.WithError(err).Errorf("HandleVerifyVipsResponse: getting cluster %s", clusterID.String())
 			return err
@@ -1907,6 +1973,8 @@ func (m *Manager) HandleVerifyVipsResponse(ctx context.Context, clusterID strfmt
 		if err = json.Unmarshal([]byte(stepReply), &response); err != nil {
 			log.WithError(err).Error("HandleVerifyVipsResponse: unmarshal")
 		}
+		updateVerification := funk.ContainsString(vipVerificationStatuses, swag.StringValue(cluster.Status))
+		macAddresses := clusterMacAddresses(cluster)
 		updated := false
 		for _, v := range response {
 			vipResponse := v
@@ -1917,8 +1985,17 @@ func (m *Manager) HandleVerifyVipsResponse(ctx context.Context, clusterID strfmt
 			case models.VipTypeAPI:
 				apiVip, _ := funk.Find(cluster.APIVips, func(apiVip *models.APIVip) bool { return apiVip.IP == vipResponse.Vip }).(*models.APIVip)
 				if apiVip != nil {
-					if apiVip.Verification == nil || *apiVip.Verification != *vipResponse.Verification {
+					changed := false
+					if updateVerification && (apiVip.Verification == nil || *apiVip.Verification != *vipResponse.Verification) {
 						apiVip.Verification = vipResponse.Verification
+						changed = true
+					}
+					if mac := conflictingMacAddress(vipResponse, apiVip.ConflictingMacAddress, macAddresses, updateVerification); mac != apiVip.ConflictingMacAddress {
+						apiVip.ConflictingMacAddress = mac
+						conflictChanges = append(conflictChanges, vipConflictChange{vipType: models.VipTypeAPI, vip: string(apiVip.IP), macAddress: mac})
+						changed = true
+					}
+					if changed {
 						if err = tx.Save(apiVip).Error; err != nil {
 							log.WithError(err).Errorf("saving verification for api vip %s of cluster %s", apiVip.IP, clusterID.String())
 							return err
@@ -1929,8 +2006,17 @@ func (m *Manager) HandleVerifyVipsResponse(ctx context.Context, clusterID strfmt
 			case models.VipTypeIngress:
 				ingressVip, _ := funk.Find(cluster.IngressVips, func(ingressVip *models.IngressVip) bool { return ingressVip.IP == vipResponse.Vip }).(*models.IngressVip)
 				if ingressVip != nil {
-					if ingressVip.Verification == nil || *ingressVip.Verification != *vipResponse.Verification {
+					changed := false
+					if updateVerification && (ingressVip.Verification == nil || *ingressVip.Verification != *vipResponse.Verification) {
 						ingressVip.Verification = vipResponse.Verification
+						changed = true
+					}
+					if mac := conflictingMacAddress(vipResponse, ingressVip.ConflictingMacAddress, macAddresses, updateVerification); mac != ingressVip.ConflictingMacAddress {
+						ingressVip.ConflictingMacAddress = mac
+						conflictChanges = append(conflictChanges, vipConflictChange{vipType: models.VipTypeIngress, vip: string(ingressVip.IP), macAddress: mac})
+						changed = true
+					}
+					if changed {
 						if err = tx.Save(ingressVip).Error; err != nil {
 							log.WithError(err).Errorf("saving verification for ingress vip %s of cluster %s", ingressVip.IP, clusterID.String())
 							return err
@@ -1948,6 +2034,17 @@ func (m *Manager) HandleVerifyVipsResponse(ctx context.Context, clusterID strfmt
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	for _, c := range conflictChanges {
+		if c.macAddress != "" {
+			eventgen.SendVipConflictDetectedEvent(ctx, m.eventsHandler, clusterID, string(c.vipType), c.vip, c.macAddress)
+		} else {
+			eventgen.SendVipConflictResolvedEvent(ctx, m.eventsHandler, clusterID, string(c.vipType), c.vip)
+		}
+	}
+	return nil
 }
 
 func (m *Manager) UpdateFinalizingStage(ctx context.Context, clusterID strfmt.UUID, finalizingStage models.FinalizingStage) error {
@@ -2663,6 +2663,108 @@ var _ = Describe("validate vips response", func() {
 		Expect(err).ToNot(HaveOccurred())
 		expect(response, true, timestamp)
 	})
+	Context("conflicts", func() {
+		const (
+			hostMac    = "52:54:00:aa:bb:cc"
+			foreignMac = "52:54:00:11:22:33"
+		)
+
+		createHost := func() {
+			inventory := models.Inventory{
+				Interfaces: []*models.Interface{{Name: "eth0", MacAddress: hostMac, IPV4Addresses: []string{"1.2.3.10/24"}}},
+			}
+			b, err := json.Marshal(&inventory)
+			Expect(err).ToNot(HaveOccurred())
+			hostID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&models.Host{ID: &hostID, InfraEnvID: id, ClusterID: &id, Inventory: string(b)}).Error).ToNot(HaveOccurred())
+		}
+
+		setStatus := func(status string) {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", id.String()).Update("status", status).Error).ToNot(HaveOccurred())
+		}
+
+		getApiVip := func() *models.APIVip {
+			cls, err := common.GetClusterFromDB(db, id, common.UseEagerLoading)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cls.APIVips).To(HaveLen(1))
+			return cls.APIVips[0]
+		}
+
+		failedResponse := func(mac string) string {
+			return createPayload(models.VerifyVipsResponse{
+				{
+					Verification:          common.VipVerificationPtr(models.VipVerificationFailed),
+					Vip:                   models.IP(apiV4Vip),
+					VipType:               models.VipTypeAPI,
+					ConflictingMacAddress: strfmt.MAC(mac),
+				},
+			})
+		}
+
+		BeforeEach(func() {
+			createClusterAndHosts([]string{apiV4Vip}, []string{})
+			createHost()
+		})
+
+		It("records a MAC address outside the cluster and sends an event", func() {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.VipConflictDetectedEventName),
+				eventstest.WithClusterIdMatcher(id.String()),
+				eventstest.WithMessageContainsMatcher(foreignMac))).Times(1)
+			Expect(clusterApi.HandleVerifyVipsResponse(ctx, id, failedResponse(foreignMac))).ToNot(HaveOccurred())
+			apiVip := getApiVip()
+			Expect(apiVip.ConflictingMacAddress).To(Equal(foreignMac))
+			Expect(apiVip.Verification).To(Equal(common.VipVerificationPtr(models.VipVerificationFailed)))
+		})
+
+		It("keeps monitoring during the installation without changing the verification", func() {
+			setStatus(models.ClusterStatusInstalling)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.VipConflictDetectedEventName))).Times(1)
+			Expect(clusterApi.HandleVerifyVipsResponse(ctx, id, failedResponse(foreignMac))).ToNot(HaveOccurred())
+			apiVip := getApiVip()
+			Expect(apiVip.ConflictingMacAddress).To(Equal(foreignMac))
+			Expect(apiVip.Verification).To(BeNil())
+		})
+
+		It("records an unknown MAC address when the agent doesn't report it", func() {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.VipConflictDetectedEventName),
+				eventstest.WithClusterIdMatcher(id.String()))).Times(1)
+			Expect(clusterApi.HandleVerifyVipsResponse(ctx, id, failedResponse(""))).ToNot(HaveOccurred())
+			apiVip := getApiVip()
+			Expect(apiVip.ConflictingMacAddress).To(Equal(unknownConflictingMacAddress))
+			Expect(apiVip.Verification).To(Equal(common.VipVerificationPtr(models.VipVerificationFailed)))
+		})
+
+		It("doesn't record a conflict without MAC address during the installation", func() {
+			setStatus(models.ClusterStatusInstalling)
+			Expect(clusterApi.HandleVerifyVipsResponse(ctx, id, failedResponse(""))).ToNot(HaveOccurred())
+			Expect(getApiVip().ConflictingMacAddress).To(BeEmpty())
+		})
+
+		It("ignores VIPs answered by the cluster hosts", func() {
+			setStatus(models.ClusterStatusInstalling)
+			Expect(clusterApi.HandleVerifyVipsResponse(ctx, id, failedResponse(hostMac))).ToNot(HaveOccurred())
+			Expect(getApiVip().ConflictingMacAddress).To(BeEmpty())
+		})
+
+		It("clears a resolved conflict", func() {
+			Expect(db.Model(&models.APIVip{}).Where("cluster_id = ?", id.String()).Update("conflicting_mac_address", foreignMac).Error).ToNot(HaveOccurred())
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.VipConflictResolvedEventName),
+				eventstest.WithClusterIdMatcher(id.String()))).Times(1)
+			payload := createPayload(models.VerifyVipsResponse{
+				{
+					Verification: common.VipVerificationPtr(models.VipVerificationSucceeded),
+					Vip:          models.IP(apiV4Vip),
+					VipType:      models.VipTypeAPI,
+				},
+			})
+			Expect(clusterApi.HandleVerifyVipsResponse(ctx, id, payload)).ToNot(HaveOccurred())
+			Expect(getApiVip().ConflictingMacAddress).To(BeEmpty())
+		})
+	})
 })
 
 var _ = Describe("ready_state", func() {
@@ -67,6 +67,9 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 	checkValidationsInStatuses := []string{
 		models.ClusterStatusInsufficient, models.ClusterStatusReady, models.ClusterStatusPendingForInput, models.ClusterStatusPreparingForInstallation,
 	}
+	monitorValidationsInStatuses := []string{
+		models.ClusterStatusInstalling, models.ClusterStatusInstallingPendingUserAction, models.ClusterStatusFinalizing,
+	}
 	var ignoredValidations []string
 	var err error
 	if c.cluster != nil {
@@ -76,6 +79,10 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 		}
 	}
 
+	if funk.ContainsString(monitorValidationsInStatuses, swag.StringValue(c.cluster.Status)) {
+		return r.preprocessMonitoredValidations(c, ignoredValidations)
+	}
+
 	//if the cluster is not on discovery stages - skip the validations check
 	if !funk.ContainsString(checkValidationsInStatuses, swag.StringValue(c.cluster.Status)) {
 		return stateMachineInput, validationsOutput, nil
@@ -146,6 +153,53 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 	return stateMachineInput, validationsOutput, nil
 }
 
+// preprocessMonitoredValidations evaluates only the validations that are monitored during the installation. Their
+// results replace the matching entries of the validations stored in the cluster, so the results of the rest of the
+// validations are kept as they were when the installation started. The ignored validations pass as they do before the
+// installation.
+func (r *refreshPreprocessor) preprocessMonitoredValidations(c *clusterPreprocessContext, ignoredValidations []string) (map[string]bool, map[string][]ValidationResult, error) {
+	stateMachineInput := make(map[string]bool)
+	validationsOutput, err := GetValidations(c.cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	if validationsOutput == nil {
+		validationsOutput = make(ValidationsStatus)
+	}
+	for _, v := range r.validations {
+		if !v.monitored {
+			continue
+		}
+		st, message := v.condition(c)
+		// Set the condition to true to force an ignored validation to pass
+		stateMachineInput[v.id.String()] = st == ValidationSuccess ||
+			common.ShouldIgnoreValidation(ignoredValidations, v.id.String(), common.NonIgnorableClusterValidations)
+		var category string
+		category, err = v.id.Category()
+		if err != nil {
+			r.log.WithError(err).Warn("id.category()")
+			return nil, nil, err
+		}
+		result := ValidationResult{
+			ID:      v.id,
+			Status:  st,
+			Message: message,
+		}
+		found := false
+		for i := range validationsOutput[category] {
+			if validationsOutput[category][i].ID == v.id {
+				validationsOutput[category][i] = result
+				found = true
+			}
+		}
+		if !found {
+			validationsOutput[category] = append(validationsOutput[category], result)
+			sortByValidationResultID(validationsOutput[category])
+		}
+	}
+	return stateMachineInput, validationsOutput, nil
+}
+
 // recalculateOperatorDependencies calculates the operator dependencies and updates the database and the passed cluster
 // accordingly.
 func (r *refreshPreprocessor) recalculateOperatorDependencies(ctx context.Context, c *clusterPreprocessContext) error {
@@ -238,6 +292,11 @@ func (r *refreshPreprocessor) recalculateOperatorDependencies(ctx context.Contex
 		}
 	}
 
+	// The operators that aren't required anymore may have left manifests behind
+	if len(deletedOperators) > 0 {
+		operators.CleanupRemovedOperators(ctx, r.log, r.operatorsAPI, r.eventsHandler, c.cluster, deletedOperators)
+	}
+
 	return nil
 }
 
@@ -357,6 +416,11 @@ func newValidations(v *clusterValidator) []validation {
 			id:        AreIngressVipsValid,
 			condition: v.areIngressVipsValid,
 		},
+		{
+			id:        AreVipsConflictFree,
+			condition: v.areVipsConflictFree,
+			monitored: true,
+		},
 		{
 			id:        AllHostsAreReadyToInstall,
 			condition: v.allHostsAreReadyToInstall,
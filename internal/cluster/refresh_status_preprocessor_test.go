@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
@@ -13,6 +14,7 @@ import (
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/operators"
+	operatorcommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
@@ -122,6 +124,44 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 		mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	}
 
+	Context("Monitored validations", func() {
+		BeforeEach(func() {
+			createCluster()
+		})
+
+		AfterEach(func() {
+			deleteCluster()
+		})
+
+		It("evaluates only the monitored validations while installing and keeps the others", func() {
+			cluster.Status = swag.String(models.ClusterStatusInstalling)
+			cluster.ControlPlaneCount = 3
+			cluster.APIVips = []*models.APIVip{{IP: "1.2.3.5", ClusterID: clusterID, ConflictingMacAddress: "52:54:00:11:22:33"}}
+			cluster.ValidationsInfo = `{"network":[{"id":"api-vips-valid","status":"success","message":"ok"}]}`
+			conditions, validations, err := preprocessor.preprocess(ctx, newClusterValidationContext(cluster, db))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conditions).To(Equal(map[string]bool{string(AreVipsConflictFree): false}))
+			Expect(validations["network"]).To(HaveLen(2))
+			Expect(validations["network"][0].ID).To(Equal(AreApiVipsValid))
+			Expect(validations["network"][0].Status).To(Equal(ValidationSuccess))
+			Expect(validations["network"][1].ID).To(Equal(AreVipsConflictFree))
+			Expect(validations["network"][1].Status).To(Equal(ValidationFailure))
+			Expect(validations["network"][1].Message).To(ContainSubstring("52:54:00:11:22:33"))
+		})
+
+		It("passes the ignored monitored validations", func() {
+			cluster.Status = swag.String(models.ClusterStatusInstalling)
+			cluster.ControlPlaneCount = 3
+			cluster.APIVips = []*models.APIVip{{IP: "1.2.3.5", ClusterID: clusterID, ConflictingMacAddress: "52:54:00:11:22:33"}}
+			cluster.IgnoredClusterValidations = `["vips-conflict-free"]`
+			conditions, validations, err := preprocessor.preprocess(ctx, newClusterValidationContext(cluster, db))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conditions).To(Equal(map[string]bool{string(AreVipsConflictFree): true}))
+			Expect(validations["network"]).To(HaveLen(1))
+			Expect(validations["network"][0].Status).To(Equal(ValidationFailure))
+		})
+	})
+
 	Context("Skipping Validations", func() {
 
 		cantBeIgnored := common.NonIgnorableClusterValidations
@@ -240,5 +280,41 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 			}).First(&models.MonitoredOperator{}).Error
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("Deletes a dependency that isn't required anymore with its manifests", func() {
+			operator := &models.MonitoredOperator{
+				ClusterID:    clusterID,
+				Name:         "myoperator",
+				OperatorType: models.OperatorTypeOlm,
+			}
+			Expect(db.Create(operator).Error).ToNot(HaveOccurred())
+			cluster.MonitoredOperators = append(cluster.MonitoredOperators, operator)
+
+			// Prepare the operators API so that it will delete the operator dependency:
+			mockOperatorManager.EXPECT().ResolveDependencies(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *common.Cluster, previousOperators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
+					return funk.Filter(previousOperators, func(current *models.MonitoredOperator) bool {
+						return current.Name != "myoperator"
+					}).([]*models.MonitoredOperator), nil
+				},
+			).Times(1)
+			mockUsageApi.EXPECT().Remove(gomock.Any(), "MYOPERATOR").Times(1)
+			mockUsageApi.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+			mockOperatorManager.EXPECT().RemoveOperatorsManifests(gomock.Any(), gomock.Any(), []*models.MonitoredOperator{operator}).
+				Return([]string{"50_myoperator_subscription.yaml"}, nil).Times(1)
+			mockOperatorValidationsSuccess()
+			mockOperatorEnsureOperatorPrerequisiteSuccess()
+
+			_, _, err := preprocessor.preprocess(ctx, validationContext)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operatorcommon.HasOperator(cluster.MonitoredOperators, "myoperator")).To(BeFalse())
+
+			// Check that the dependency has been deleted from the database:
+			err = db.Where(&models.MonitoredOperator{
+				ClusterID: clusterID,
+				Name:      "myoperator",
+			}).First(&models.MonitoredOperator{}).Error
+			Expect(err).To(MatchError(gorm.ErrRecordNotFound))
+		})
 	})
 })
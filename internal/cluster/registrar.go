@@ -104,6 +104,7 @@ func (r *registrar) DeregisterCluster(ctx context.Context, cluster *common.Clust
 	err = r.db.Transaction(func(tx *gorm.DB) error {
 		if err = common.DeleteRecordsByClusterID(tx, *cluster.ID, []interface{}{
 			&models.MonitoredOperator{},
+			&models.MonitoredOperatorHealthRecord{},
 			&models.ClusterNetwork{},
 			&models.ServiceNetwork{},
 			&models.MachineNetwork{},
@@ -113,6 +113,7 @@ func NewClusterStateMachine(th TransitionHandler) stateswitch.StateMachine {
 		If(IsMachineCidrEqualsToCalculatedCidr),
 		If(AreApiVipsValid),
 		If(AreIngressVipsValid),
+		If(AreVipsConflictFree),
 		If(AllHostsAreReadyToInstall),
 		If(SufficientMastersCount),
 		If(networkPrefixValid),
@@ -148,6 +149,7 @@ func NewClusterStateMachine(th TransitionHandler) stateswitch.StateMachine {
 		If(AreMetallbRequirementsSatisfied),
 		If(IsLokiRequirementsSatisfied),
 		If(IsOpenShiftLoggingRequirementsSatisfied),
+		If(AreDeclarativeOperatorsRequirementsSatisfied),
 	)
 
 	// Refresh cluster status conditions - Non DHCP
@@ -23,6 +23,7 @@ const (
 	isNetworkTypeValid                             = ValidationID(models.ClusterValidationIDNetworkTypeValid)
 	AreIngressVipsDefined                          = ValidationID(models.ClusterValidationIDIngressVipsDefined)
 	AreIngressVipsValid                            = ValidationID(models.ClusterValidationIDIngressVipsValid)
+	AreVipsConflictFree                            = ValidationID(models.ClusterValidationIDVipsConflictFree)
 	AllHostsAreReadyToInstall                      = ValidationID(models.ClusterValidationIDAllHostsAreReadyToInstall)
 	SufficientMastersCount                         = ValidationID(models.ClusterValidationIDSufficientMastersCount)
 	IsDNSDomainDefined                             = ValidationID(models.ClusterValidationIDDNSDomainDefined)
@@ -58,13 +59,14 @@ const (
 	AreMetallbRequirementsSatisfied                = ValidationID(models.ClusterValidationIDMetallbRequirementsSatisfied)
 	IsLokiRequirementsSatisfied                    = ValidationID(models.ClusterValidationIDLokiRequirementsSatisfied)
 	IsOpenShiftLoggingRequirementsSatisfied        = ValidationID(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)
+	AreDeclarativeOperatorsRequirementsSatisfied   = ValidationID(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)
 )
 
 func (v ValidationID) Category() (string, error) {
 	switch v {
 	case IsMachineCidrDefined, IsMachineCidrEqualsToCalculatedCidr, AreApiVipsDefined, AreApiVipsValid, AreIngressVipsDefined,
-		AreIngressVipsValid, isClusterCidrDefined, isServiceCidrDefined, noCidrOverlapping, networkPrefixValid,
-		IsDNSDomainDefined, IsNtpServerConfigured, isNetworkTypeValid, NetworksSameAddressFamilies:
+		AreIngressVipsValid, AreVipsConflictFree, isClusterCidrDefined, isServiceCidrDefined, noCidrOverlapping,
+		networkPrefixValid, IsDNSDomainDefined, IsNtpServerConfigured, isNetworkTypeValid, NetworksSameAddressFamilies:
 		return "network", nil
 	case AllHostsAreReadyToInstall, SufficientMastersCount:
 		return "hosts-data", nil
@@ -98,7 +100,8 @@ func (v ValidationID) Category() (string, error) {
 		AreOADPRequirementsSatisfied,
 		AreMetallbRequirementsSatisfied,
 		IsLokiRequirementsSatisfied,
-		IsOpenShiftLoggingRequirementsSatisfied:
+		IsOpenShiftLoggingRequirementsSatisfied,
+		AreDeclarativeOperatorsRequirementsSatisfied:
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected cluster validation id %s", string(v)))
@@ -50,6 +50,8 @@ type validationConditon func(context *clusterPreprocessContext) (ValidationStatu
 type validation struct {
 	id        ValidationID
 	condition validationConditon
+	// monitored validations keep being evaluated while the cluster is being installed
+	monitored bool
 }
 
 func hasHostsWithInventories(c *common.Cluster) bool {
@@ -361,6 +363,39 @@ func (v *clusterValidator) areIngressVipsValid(c *clusterPreprocessContext) (Val
 	return v.areVipsValid(c, &IngressVipsWrapper{c: c})
 }
 
+// areVipsConflictFree fails when a device that is not part of the cluster answers the ARP or NDP requests sent by the
+// hosts for one of the VIPs. It is also evaluated during the installation, as a VIP that is taken over mid-install
+// causes failures that are otherwise hard to diagnose.
+func (v *clusterValidator) areVipsConflictFree(c *clusterPreprocessContext) (ValidationStatus, string) {
+	if swag.BoolValue(c.cluster.UserManagedNetworking) {
+		return ValidationSuccess, "Virtual IPs are not required: User Managed Networking"
+	}
+	if c.cluster.ControlPlaneCount == 1 {
+		return ValidationSuccess, "Virtual IPs are not required: SNO"
+	}
+	conflict := func(vipType string, vip models.IP, mac string) string {
+		if mac == unknownConflictingMacAddress {
+			return fmt.Sprintf("%s vip %s is answered by a device with an unknown MAC address", vipType, vip)
+		}
+		return fmt.Sprintf("%s vip %s is answered by MAC address %s", vipType, vip, mac)
+	}
+	var conflicts []string
+	for _, vip := range c.cluster.APIVips {
+		if vip.ConflictingMacAddress != "" {
+			conflicts = append(conflicts, conflict("api", vip.IP, vip.ConflictingMacAddress))
+		}
+	}
+	for _, vip := range c.cluster.IngressVips {
+		if vip.ConflictingMacAddress != "" {
+			conflicts = append(conflicts, conflict("ingress", vip.IP, vip.ConflictingMacAddress))
+		}
+	}
+	if len(conflicts) > 0 {
+		return ValidationFailure, fmt.Sprintf("Virtual IPs are in use by devices outside the cluster: %s", strings.Join(conflicts, "; "))
+	}
+	return ValidationSuccess, "Virtual IPs are not in use by devices outside the cluster"
+}
+
 // SufficientMastersCount validates that there is a sufficient amount of hosts to satisfy requirements of both masters and workers.
 // The requirements are -
 //   - For none-high-availablity cluster (SNO), exactly 1 master and 0 workers are required.
@@ -263,6 +263,60 @@ var _ = Describe("isNetworksSameAddressFamilies", func() {
 	})
 })
 
+var _ = Describe("areVipsConflictFree", func() {
+	var (
+		validator         clusterValidator
+		preprocessContext *clusterPreprocessContext
+	)
+
+	BeforeEach(func() {
+		validator = clusterValidator{log: logrus.New()}
+		preprocessContext = &clusterPreprocessContext{}
+	})
+
+	It("succeeds when no VIP is answered by a device outside the cluster", func() {
+		preprocessContext.cluster = &common.Cluster{Cluster: models.Cluster{
+			ControlPlaneCount: 3,
+			APIVips:           []*models.APIVip{{IP: "1.2.3.5"}},
+			IngressVips:       []*models.IngressVip{{IP: "1.2.3.6"}},
+		}}
+		status, _ := validator.areVipsConflictFree(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+	})
+
+	It("fails with the offending MAC address", func() {
+		preprocessContext.cluster = &common.Cluster{Cluster: models.Cluster{
+			ControlPlaneCount: 3,
+			APIVips:           []*models.APIVip{{IP: "1.2.3.5"}},
+			IngressVips:       []*models.IngressVip{{IP: "1.2.3.6", ConflictingMacAddress: "52:54:00:11:22:33"}},
+		}}
+		status, message := validator.areVipsConflictFree(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(ContainSubstring("ingress vip 1.2.3.6 is answered by MAC address 52:54:00:11:22:33"))
+		Expect(message).ToNot(ContainSubstring("1.2.3.5"))
+	})
+
+	It("fails when the MAC address of the conflicting device is unknown", func() {
+		preprocessContext.cluster = &common.Cluster{Cluster: models.Cluster{
+			ControlPlaneCount: 3,
+			APIVips:           []*models.APIVip{{IP: "1.2.3.5", ConflictingMacAddress: unknownConflictingMacAddress}},
+		}}
+		status, message := validator.areVipsConflictFree(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(ContainSubstring("api vip 1.2.3.5 is answered by a device with an unknown MAC address"))
+	})
+
+	It("is not required with user managed networking", func() {
+		preprocessContext.cluster = &common.Cluster{Cluster: models.Cluster{
+			ControlPlaneCount:     3,
+			UserManagedNetworking: swag.Bool(true),
+			APIVips:               []*models.APIVip{{IP: "1.2.3.5", ConflictingMacAddress: "52:54:00:11:22:33"}},
+		}}
+		status, _ := validator.areVipsConflictFree(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+	})
+})
+
 var _ = Describe("areVipsValid", func() {
 
 	var (
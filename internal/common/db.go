@@ -328,6 +328,8 @@ func AutoMigrate(db *gorm.DB) error {
 		&models.MachineNetwork{},
 		&models.APIVip{},
 		&models.IngressVip{},
+		&models.MonitoredOperatorHealthRecord{},
+		&models.BmcReference{},
 	)
 }
 
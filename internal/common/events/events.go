@@ -1483,6 +1483,176 @@ func (e *ApiIngressVipTimedOutEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event vip_conflict_detected
+//
+type VipConflictDetectedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    VipType string
+    Vip string
+    MacAddress string
+}
+
+var VipConflictDetectedEventName string = "vip_conflict_detected"
+
+func NewVipConflictDetectedEvent(
+    clusterId strfmt.UUID,
+    vipType string,
+    vip string,
+    macAddress string,
+) *VipConflictDetectedEvent {
+    return &VipConflictDetectedEvent{
+        eventName: VipConflictDetectedEventName,
+        ClusterId: clusterId,
+        VipType: vipType,
+        Vip: vip,
+        MacAddress: macAddress,
+    }
+}
+
+func SendVipConflictDetectedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    vipType string,
+    vip string,
+    macAddress string,) {
+    ev := NewVipConflictDetectedEvent(
+        clusterId,
+        vipType,
+        vip,
+        macAddress,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendVipConflictDetectedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    vipType string,
+    vip string,
+    macAddress string,
+    eventTime time.Time) {
+    ev := NewVipConflictDetectedEvent(
+        clusterId,
+        vipType,
+        vip,
+        macAddress,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *VipConflictDetectedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *VipConflictDetectedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *VipConflictDetectedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *VipConflictDetectedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{vip_type}", fmt.Sprint(e.VipType),
+        "{vip}", fmt.Sprint(e.Vip),
+        "{mac_address}", fmt.Sprint(e.MacAddress),
+    )
+    return r.Replace(*message)
+}
+
+func (e *VipConflictDetectedEvent) FormatMessage() string {
+    s := "The {vip_type} VIP {vip} is answered by MAC address {mac_address} which does not belong to any host of the cluster"
+    return e.format(&s)
+}
+
+//
+// Event vip_conflict_resolved
+//
+type VipConflictResolvedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    VipType string
+    Vip string
+}
+
+var VipConflictResolvedEventName string = "vip_conflict_resolved"
+
+func NewVipConflictResolvedEvent(
+    clusterId strfmt.UUID,
+    vipType string,
+    vip string,
+) *VipConflictResolvedEvent {
+    return &VipConflictResolvedEvent{
+        eventName: VipConflictResolvedEventName,
+        ClusterId: clusterId,
+        VipType: vipType,
+        Vip: vip,
+    }
+}
+
+func SendVipConflictResolvedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    vipType string,
+    vip string,) {
+    ev := NewVipConflictResolvedEvent(
+        clusterId,
+        vipType,
+        vip,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendVipConflictResolvedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    vipType string,
+    vip string,
+    eventTime time.Time) {
+    ev := NewVipConflictResolvedEvent(
+        clusterId,
+        vipType,
+        vip,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *VipConflictResolvedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *VipConflictResolvedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *VipConflictResolvedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *VipConflictResolvedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{vip_type}", fmt.Sprint(e.VipType),
+        "{vip}", fmt.Sprint(e.Vip),
+    )
+    return r.Replace(*message)
+}
+
+func (e *VipConflictResolvedEvent) FormatMessage() string {
+    s := "The {vip_type} VIP {vip} is no longer answered by a device outside the cluster"
+    return e.format(&s)
+}
+
 //
 // Event prepare_installation_failed
 //
@@ -1994,6 +2164,249 @@ func (e *ClusterOperatorStatusEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event cluster_operator_degraded
+//
+type ClusterOperatorDegradedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    OperatorName string
+    Status string
+    StatusInfo string
+}
+
+var ClusterOperatorDegradedEventName string = "cluster_operator_degraded"
+
+func NewClusterOperatorDegradedEvent(
+    clusterId strfmt.UUID,
+    operatorName string,
+    status string,
+    statusInfo string,
+) *ClusterOperatorDegradedEvent {
+    return &ClusterOperatorDegradedEvent{
+        eventName: ClusterOperatorDegradedEventName,
+        ClusterId: clusterId,
+        OperatorName: operatorName,
+        Status: status,
+        StatusInfo: statusInfo,
+    }
+}
+
+func SendClusterOperatorDegradedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operatorName string,
+    status string,
+    statusInfo string,) {
+    ev := NewClusterOperatorDegradedEvent(
+        clusterId,
+        operatorName,
+        status,
+        statusInfo,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterOperatorDegradedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operatorName string,
+    status string,
+    statusInfo string,
+    eventTime time.Time) {
+    ev := NewClusterOperatorDegradedEvent(
+        clusterId,
+        operatorName,
+        status,
+        statusInfo,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterOperatorDegradedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterOperatorDegradedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *ClusterOperatorDegradedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterOperatorDegradedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{operator_name}", fmt.Sprint(e.OperatorName),
+        "{status}", fmt.Sprint(e.Status),
+        "{status_info}", fmt.Sprint(e.StatusInfo),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterOperatorDegradedEvent) FormatMessage() string {
+    s := "Operator {operator_name} became {status} after the installation of the cluster: {status_info}"
+    return e.format(&s)
+}
+
+//
+// Event cluster_day2_operators_installation_started
+//
+type ClusterDay2OperatorsInstallationStartedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Operators string
+}
+
+var ClusterDay2OperatorsInstallationStartedEventName string = "cluster_day2_operators_installation_started"
+
+func NewClusterDay2OperatorsInstallationStartedEvent(
+    clusterId strfmt.UUID,
+    operators string,
+) *ClusterDay2OperatorsInstallationStartedEvent {
+    return &ClusterDay2OperatorsInstallationStartedEvent{
+        eventName: ClusterDay2OperatorsInstallationStartedEventName,
+        ClusterId: clusterId,
+        Operators: operators,
+    }
+}
+
+func SendClusterDay2OperatorsInstallationStartedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operators string,) {
+    ev := NewClusterDay2OperatorsInstallationStartedEvent(
+        clusterId,
+        operators,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterDay2OperatorsInstallationStartedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operators string,
+    eventTime time.Time) {
+    ev := NewClusterDay2OperatorsInstallationStartedEvent(
+        clusterId,
+        operators,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterDay2OperatorsInstallationStartedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterDay2OperatorsInstallationStartedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterDay2OperatorsInstallationStartedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterDay2OperatorsInstallationStartedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{operators}", fmt.Sprint(e.Operators),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterDay2OperatorsInstallationStartedEvent) FormatMessage() string {
+    s := "Started the installation of operators {operators} in the installed cluster"
+    return e.format(&s)
+}
+
+//
+// Event cluster_operators_removed
+//
+type ClusterOperatorsRemovedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Operators string
+    Manifests string
+}
+
+var ClusterOperatorsRemovedEventName string = "cluster_operators_removed"
+
+func NewClusterOperatorsRemovedEvent(
+    clusterId strfmt.UUID,
+    operators string,
+    manifests string,
+) *ClusterOperatorsRemovedEvent {
+    return &ClusterOperatorsRemovedEvent{
+        eventName: ClusterOperatorsRemovedEventName,
+        ClusterId: clusterId,
+        Operators: operators,
+        Manifests: manifests,
+    }
+}
+
+func SendClusterOperatorsRemovedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operators string,
+    manifests string,) {
+    ev := NewClusterOperatorsRemovedEvent(
+        clusterId,
+        operators,
+        manifests,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterOperatorsRemovedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operators string,
+    manifests string,
+    eventTime time.Time) {
+    ev := NewClusterOperatorsRemovedEvent(
+        clusterId,
+        operators,
+        manifests,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterOperatorsRemovedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterOperatorsRemovedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterOperatorsRemovedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterOperatorsRemovedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{operators}", fmt.Sprint(e.Operators),
+        "{manifests}", fmt.Sprint(e.Manifests),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterOperatorsRemovedEvent) FormatMessage() string {
+    s := "Removed operators {operators} from the cluster with their feature usage, deleted generated manifests: {manifests}"
+    return e.format(&s)
+}
+
 //
 // Event finalizing_stage_timed_out
 //
@@ -4237,6 +4650,182 @@ func (e *InfraEnvDeregisteredEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event bmc_action_performed
+//
+type BmcActionPerformedEvent struct {
+    eventName string
+    InfraEnvId strfmt.UUID
+    Action string
+    BmcAddress string
+}
+
+var BmcActionPerformedEventName string = "bmc_action_performed"
+
+func NewBmcActionPerformedEvent(
+    infraEnvId strfmt.UUID,
+    action string,
+    bmcAddress string,
+) *BmcActionPerformedEvent {
+    return &BmcActionPerformedEvent{
+        eventName: BmcActionPerformedEventName,
+        InfraEnvId: infraEnvId,
+        Action: action,
+        BmcAddress: bmcAddress,
+    }
+}
+
+func SendBmcActionPerformedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    action string,
+    bmcAddress string,) {
+    ev := NewBmcActionPerformedEvent(
+        infraEnvId,
+        action,
+        bmcAddress,
+    )
+    eventsHandler.SendInfraEnvEvent(ctx, ev)
+}
+
+func SendBmcActionPerformedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    action string,
+    bmcAddress string,
+    eventTime time.Time) {
+    ev := NewBmcActionPerformedEvent(
+        infraEnvId,
+        action,
+        bmcAddress,
+    )
+    eventsHandler.SendInfraEnvEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *BmcActionPerformedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *BmcActionPerformedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *BmcActionPerformedEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *BmcActionPerformedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *BmcActionPerformedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{action}", fmt.Sprint(e.Action),
+        "{bmc_address}", fmt.Sprint(e.BmcAddress),
+    )
+    return r.Replace(*message)
+}
+
+func (e *BmcActionPerformedEvent) FormatMessage() string {
+    s := "BMC action {action} was performed on the host with BMC {bmc_address}"
+    return e.format(&s)
+}
+
+//
+// Event bmc_action_failed
+//
+type BmcActionFailedEvent struct {
+    eventName string
+    InfraEnvId strfmt.UUID
+    Action string
+    BmcAddress string
+    Error string
+}
+
+var BmcActionFailedEventName string = "bmc_action_failed"
+
+func NewBmcActionFailedEvent(
+    infraEnvId strfmt.UUID,
+    action string,
+    bmcAddress string,
+    error string,
+) *BmcActionFailedEvent {
+    return &BmcActionFailedEvent{
+        eventName: BmcActionFailedEventName,
+        InfraEnvId: infraEnvId,
+        Action: action,
+        BmcAddress: bmcAddress,
+        Error: error,
+    }
+}
+
+func SendBmcActionFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    action string,
+    bmcAddress string,
+    error string,) {
+    ev := NewBmcActionFailedEvent(
+        infraEnvId,
+        action,
+        bmcAddress,
+        error,
+    )
+    eventsHandler.SendInfraEnvEvent(ctx, ev)
+}
+
+func SendBmcActionFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    action string,
+    bmcAddress string,
+    error string,
+    eventTime time.Time) {
+    ev := NewBmcActionFailedEvent(
+        infraEnvId,
+        action,
+        bmcAddress,
+        error,
+    )
+    eventsHandler.SendInfraEnvEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *BmcActionFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *BmcActionFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *BmcActionFailedEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *BmcActionFailedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *BmcActionFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{action}", fmt.Sprint(e.Action),
+        "{bmc_address}", fmt.Sprint(e.BmcAddress),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *BmcActionFailedEvent) FormatMessage() string {
+    s := "BMC action {action} failed on the host with BMC {bmc_address}: {error}"
+    return e.format(&s)
+}
+
 //
 // Event generate_image_fetch_failed
 //
@@ -57,6 +57,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -98,11 +99,13 @@ type AgentReconciler struct {
 	ServiceBaseURL             string
 	AuthType                   auth.AuthType
 	SpokeK8sClientFactory      spoke_k8s_client.SpokeK8sClientFactory
+	SpokeClients               SpokeClientCache
 	ApproveCsrsRequeueDuration time.Duration
 	AgentContainerImage        string
 	HostFSMountDir             string
 	reclaimer                  *agentReclaimer
 	ImageServiceEnabled        bool
+	Drainer                    Drainer
 }
 
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch;create;update;patch;delete
@@ -173,12 +176,8 @@ func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (
 		}
 	}
 
-	if shouldCleanUnboundSpokeNode(agent, h) {
-		log.Infof("Cleaning spoke node for host in status %s", *h.Status)
-		if cleanErr := r.cleanUnboundSpokeNode(ctx, log, agent); cleanErr != nil {
-			log.WithError(cleanErr).Errorf("failed to clean spoke node resources")
-			return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, cleanErr
-		}
+	if res, spokeErr := r.handleSpokeNode(ctx, log, agent, h); res != nil {
+		return *res, spokeErr
 	}
 
 	if err = r.setOwnerAndLabel(ctx, log, h, agent); err != nil {
@@ -312,6 +311,23 @@ func shouldCleanUnboundSpokeNode(agent *aiv1beta1.Agent, host *common.Host) bool
 	return agent.Status.DeprovisionInfo != nil && !funk.Contains(unbindingInProgressStatuses, *host.Status)
 }
 
+// handleSpokeNode cleans the spoke resources of an unbound agent, removes the node of an agent when requested and
+// removes the control plane node replaced by an agent.
+// Returns a nil result when the reconcile should continue.
+func (r *AgentReconciler) handleSpokeNode(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, h *common.Host) (*ctrl.Result, error) {
+	if shouldCleanUnboundSpokeNode(agent, h) {
+		log.Infof("Cleaning spoke node for host in status %s", *h.Status)
+		if cleanErr := r.cleanUnboundSpokeNode(ctx, log, agent); cleanErr != nil {
+			log.WithError(cleanErr).Errorf("failed to clean spoke node resources")
+			return &ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, cleanErr
+		}
+	}
+	if isNodeRemovalRequested(agent) {
+		return r.removeAgentNode(ctx, log, agent, h)
+	}
+	return r.prepareControlPlaneReplacement(ctx, log, agent, h)
+}
+
 func (r *AgentReconciler) cleanUnboundSpokeNode(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent) error {
 	removeDeprovisionInfo := func() error {
 		patch := client.MergeFrom(agent.DeepCopy())
@@ -576,7 +592,10 @@ func (r *AgentReconciler) shouldApproveCSR(csr *certificatesv1.CertificateSignin
 	return validateNodeCsr(agent, csr, x509CSR)
 }
 
-func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_k8s_client.SpokeK8sClient, agent *aiv1beta1.Agent, validateNodeCsr nodeCsrValidator, csrType aiv1beta1.CSRType) {
+// approveAIHostsCSRs approves the CSRs of the agent that pass the validation. The pending CSRs of the agent that aren't
+// approved are reported in the agent status with the reason, refuseReason refuses all of them and only records the CSRs
+// of the agent approved by the user.
+func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_k8s_client.SpokeK8sClient, agent *aiv1beta1.Agent, validateNodeCsr nodeCsrValidator, csrType aiv1beta1.CSRType, refuseReason string) {
 	csrList, err := clients.ListCsrs(ctx)
 	if err != nil {
 		r.Log.WithError(err).Errorf("Failed to get CSRs for agent %s/%s", agent.Namespace, agent.Name)
@@ -596,9 +615,25 @@ func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_
 			continue
 		}
 
+		if refuseReason != "" {
+			x509CSR, parseErr := getX509ParsedRequest(csr)
+			if parseErr != nil || !isCsrAssociatedWithAgent(x509CSR, agent) || !isCsrOfType(csr, csrType) {
+				continue
+			}
+			if isApproved {
+				r.trackCSR(agent, csr.Name, csrType, getCSRApprovalTime(csr))
+			} else {
+				r.refuseCSR(agent, csr.Name, csrType, refuseReason)
+			}
+			continue
+		}
+
 		shouldProcess, err := r.shouldApproveCSR(csr, agent, validateNodeCsr)
 		if err != nil {
 			r.Log.WithError(err).Debugf("Failed validating CSR %s for agent %s", csr.Name, agent.Name)
+			if !isApproved && isCsrOfType(csr, csrType) {
+				r.refuseCSR(agent, csr.Name, csrType, err.Error())
+			}
 			continue
 		}
 		if !shouldProcess {
@@ -630,6 +665,15 @@ func getCSRApprovalTime(csr *certificatesv1.CertificateSigningRequest) metav1.Ti
 	return metav1.Time{}
 }
 
+func (r *AgentReconciler) refuseCSR(agent *aiv1beta1.Agent, csrName string, csrType aiv1beta1.CSRType, reason string) {
+	r.Log.Infof("Refused to approve %s CSR %s for agent %s/%s: %s", csrType, csrName, agent.Namespace, agent.Name, reason)
+	agent.Status.CSRStatus.RefusedCSRs = append(agent.Status.CSRStatus.RefusedCSRs, aiv1beta1.RefusedCSRInfo{
+		Name:   csrName,
+		Type:   csrType,
+		Reason: reason,
+	})
+}
+
 func (r *AgentReconciler) trackCSR(agent *aiv1beta1.Agent, csrName string, csrType aiv1beta1.CSRType, approvedAt metav1.Time) {
 	csrInfo := aiv1beta1.CSRInfo{
 		Name:       csrName,
@@ -640,10 +684,29 @@ func (r *AgentReconciler) trackCSR(agent *aiv1beta1.Agent, csrName string, csrTy
 }
 
 func (r *AgentReconciler) spokeKubeClient(ctx context.Context, clusterRef *aiv1beta1.ClusterReference) (spoke_k8s_client.SpokeK8sClient, error) {
+	clusterDeployment, secret, err := r.spokeClusterDeploymentAndSecret(ctx, clusterRef)
+	if err != nil {
+		return nil, err
+	}
+	return r.SpokeClients.Get(clusterDeployment, secret)
+}
+
+// spokeKubeClientAndSet creates a client and a clientset for the spoke cluster, the caller closes the client once done
+func (r *AgentReconciler) spokeKubeClientAndSet(ctx context.Context, clusterRef *aiv1beta1.ClusterReference) (spoke_k8s_client.SpokeK8sClient, *kubernetes.Clientset, error) {
+	clusterDeployment, secret, err := r.spokeClusterDeploymentAndSecret(ctx, clusterRef)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r.SpokeK8sClientFactory.ClientAndSetFromSecret(clusterDeployment, secret)
+}
+
+// spokeClusterDeploymentAndSecret returns the kubeconfig secret of the spoke cluster, and its cluster deployment when
+// it still exists
+func (r *AgentReconciler) spokeClusterDeploymentAndSecret(ctx context.Context, clusterRef *aiv1beta1.ClusterReference) (*hivev1.ClusterDeployment, *corev1.Secret, error) {
 	secret, err := spokeKubeconfigSecret(ctx, r.Log, r.Client, r.APIReader, clusterRef)
 	if err != nil {
 		r.Log.WithError(err).Errorf("failed to get spoke secret for cluster %s/%s", clusterRef.Namespace, clusterRef.Name)
-		return nil, err
+		return nil, nil, err
 	}
 	clusterDeploymentKey := types.NamespacedName{
 		Namespace: clusterRef.Namespace,
@@ -662,9 +725,9 @@ func (r *AgentReconciler) spokeKubeClient(ctx context.Context, clusterRef *aiv1b
 			"failed to get cluster deployment for cluster %s/%s",
 			clusterRef.Namespace, clusterRef.Name,
 		)
-		return nil, err
+		return nil, nil, err
 	}
-	return r.SpokeK8sClientFactory.CreateFromSecret(clusterDeployment, secret)
+	return clusterDeployment, secret, nil
 }
 
 // Attempt to approve CSRs for agent. If already approved then the node will be marked as done
@@ -672,13 +735,74 @@ func (r *AgentReconciler) spokeKubeClient(ctx context.Context, clusterRef *aiv1b
 func (r *AgentReconciler) tryApproveDay2CSRs(ctx context.Context, agent *aiv1beta1.Agent, node *corev1.Node, client spoke_k8s_client.SpokeK8sClient) {
 	r.Log.Infof("Approving CSRs for agent %s/%s", agent.Namespace, agent.Name)
 
+	policy, err := r.getCSRApprovalPolicy(ctx, agent)
+	if err != nil {
+		r.Log.WithError(err).Errorf("Failed to get the CSR approval policy of agent %s/%s", agent.Namespace, agent.Name)
+		return
+	}
+	if agent.Status.CSRStatus.FirstApprovalAttempt.IsZero() {
+		agent.Status.CSRStatus.FirstApprovalAttempt = metav1.Now()
+	}
+	var refuseReason string
+	var additionalSANs []string
+	if policy != nil {
+		additionalSANs = policy.AdditionalSANs
+		if policy.Mode == hiveext.CSRApprovalModeManual {
+			refuseReason = "manual approval is required by the CSR approval policy"
+		} else if policy.ApprovalWindow != nil && time.Since(agent.Status.CSRStatus.FirstApprovalAttempt.Time) > policy.ApprovalWindow.Duration {
+			refuseReason = fmt.Sprintf("the CSR approval window of %s expired", policy.ApprovalWindow.Duration)
+		}
+	}
+	agent.Status.CSRStatus.RefusedCSRs = nil
+
 	// Try to approve client CSRs
-	r.approveAIHostsCSRs(ctx, client, agent, validateNodeClientCSR, aiv1beta1.CSRTypeClient)
+	r.approveAIHostsCSRs(ctx, client, agent, validateNodeClientCSR, aiv1beta1.CSRTypeClient, refuseReason)
 
 	// Also try serving CSRs if node exists
 	if node != nil {
-		r.approveAIHostsCSRs(ctx, client, agent, createNodeServerCsrValidator(node), aiv1beta1.CSRTypeServing)
+		r.approveAIHostsCSRs(ctx, client, agent, createNodeServerCsrValidator(node, additionalSANs), aiv1beta1.CSRTypeServing, refuseReason)
 	}
+	setCSRsApprovedCondition(agent)
+}
+
+// setCSRsApprovedCondition reports the CSRs of the agent that were refused, or whether its client and serving CSRs are
+// approved
+func setCSRsApprovedCondition(agent *aiv1beta1.Agent) {
+	condition := conditionsv1.Condition{
+		Type:    aiv1beta1.CSRsApprovedCondition,
+		Status:  corev1.ConditionFalse,
+		Reason:  aiv1beta1.CSRsPendingReason,
+		Message: aiv1beta1.CSRsPendingMsg,
+	}
+	if refused := agent.Status.CSRStatus.RefusedCSRs; len(refused) > 0 {
+		reasons := make([]string, 0, len(refused))
+		for _, csr := range refused {
+			reasons = append(reasons, fmt.Sprintf("%s CSR %s: %s", csr.Type, csr.Name, csr.Reason))
+		}
+		condition.Reason = aiv1beta1.CSRsRefusedReason
+		condition.Message = fmt.Sprintf("%s %s", aiv1beta1.CSRsRefusedMsg, strings.Join(reasons, "; "))
+	} else if areCSRsHandled(true, agent) {
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = aiv1beta1.CSRsApprovedReason
+		condition.Message = aiv1beta1.CSRsApprovedMsg
+	}
+	conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, condition)
+}
+
+// getCSRApprovalPolicy returns the CSR approval policy of the cluster of the agent, nil when the default policy is used
+func (r *AgentReconciler) getCSRApprovalPolicy(ctx context.Context, agent *aiv1beta1.Agent) (*hiveext.CSRApprovalPolicy, error) {
+	cd, err := getClusterDeploymentFromAgent(ctx, r.Client, agent)
+	if err != nil {
+		return nil, err
+	}
+	if cd.Spec.ClusterInstallRef == nil {
+		return nil, nil
+	}
+	aci := &hiveext.AgentClusterInstall{}
+	if err = r.Client.Get(ctx, types.NamespacedName{Namespace: cd.Namespace, Name: cd.Spec.ClusterInstallRef.Name}, aci); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return aci.Spec.CSRApprovalPolicy, nil
 }
 
 func (r *AgentReconciler) getBMH(ctx context.Context, agent *aiv1beta1.Agent) (*bmh_v1alpha1.BareMetalHost, error) {
@@ -1106,8 +1230,15 @@ func (r *AgentReconciler) UpdateDay2InstallPogress(ctx context.Context, h *model
 		return nil
 	}
 	var err error
-	allCSRsHandled := areCSRsHandled(shouldAutoApproveCSRs, agent)
-	if isNodeReady(node) && allCSRsHandled {
+	done := isNodeReady(node) && areCSRsHandled(shouldAutoApproveCSRs, agent)
+	if done {
+		// The node of a replaced control plane node is done once its etcd member is started
+		if done, err = r.isControlPlaneReplacementDone(ctx, agent, node); err != nil {
+			r.Log.WithError(err).Errorf("Failed checking the control plane replacement of host %s", h.ID)
+			return err
+		}
+	}
+	if done {
 		err = r.updateHostInstallProgress(ctx, h, models.HostStageDone)
 		agent.Status.Progress.CurrentStage = models.HostStageDone
 		// now that the node is done there is no need to requeue
@@ -110,6 +110,7 @@ var _ = Describe("agent reconcile", func() {
 			Log:                   common.GetTestLog(),
 			Installer:             mockInstallerInternal,
 			SpokeK8sClientFactory: mockClientFactory,
+			SpokeClients:          NewSpokeClientCache(common.GetTestLog(), mockClientFactory, SpokeClientCacheConfig{}, nil),
 			AgentContainerImage:   agentImage,
 		}
 		sId = strfmt.UUID(uuid.New().String())
@@ -2699,34 +2700,34 @@ func (n *notFoundError) Error() string {
 	return "Stam"
 }
 
-var _ = Describe("Approve CSRs", func() {
-	/* Decoded output of PEM formatted client CSR
-
-	Certificate Request:
-	    Data:
-	        Version: 1 (0x0)
-	        Subject: O = system:nodes, CN = system:node:ostest-extraworker-3
-	        Subject Public Key Info:
-	            Public Key Algorithm: id-ecPublicKey
-	                Public-Key: (256 bit)
-	                pub:
-	                    04:f3:d3:02:4d:a3:b4:33:47:94:54:20:36:e4:e0:
-	                    60:53:46:50:33:71:3d:17:2d:a8:d0:c9:c9:22:5d:
-	                    08:f1:a3:02:08:06:ec:a6:05:44:57:40:d0:96:18:
-	                    b1:d6:08:51:30:00:2f:79:c0:36:47:65:02:6f:c4:
-	                    67:52:14:bf:60
-	                ASN1 OID: prime256v1
-	                NIST CURVE: P-256
-	        Attributes:
-	            a0:00
-	    Signature Algorithm: ecdsa-with-SHA256
-	         30:44:02:20:13:06:4d:20:bf:21:d6:e0:9f:e7:fd:5b:e6:58:
-	         06:cf:32:2f:3b:63:82:fb:89:d2:f0:99:6a:2b:c2:84:87:84:
-	         02:20:59:76:4d:c4:d8:c5:8a:15:22:ee:f7:33:f1:54:4a:3e:
-	         72:51:53:6f:c2:17:d2:1c:64:77:30:48:87:58:19:f6
-	*/
-
-	x509ClientCsr := `-----BEGIN CERTIFICATE REQUEST-----
+/* Decoded output of PEM formatted client CSR
+
+Certificate Request:
+    Data:
+        Version: 1 (0x0)
+        Subject: O = system:nodes, CN = system:node:ostest-extraworker-3
+        Subject Public Key Info:
+            Public Key Algorithm: id-ecPublicKey
+                Public-Key: (256 bit)
+                pub:
+                    04:f3:d3:02:4d:a3:b4:33:47:94:54:20:36:e4:e0:
+                    60:53:46:50:33:71:3d:17:2d:a8:d0:c9:c9:22:5d:
+                    08:f1:a3:02:08:06:ec:a6:05:44:57:40:d0:96:18:
+                    b1:d6:08:51:30:00:2f:79:c0:36:47:65:02:6f:c4:
+                    67:52:14:bf:60
+                ASN1 OID: prime256v1
+                NIST CURVE: P-256
+        Attributes:
+            a0:00
+    Signature Algorithm: ecdsa-with-SHA256
+         30:44:02:20:13:06:4d:20:bf:21:d6:e0:9f:e7:fd:5b:e6:58:
+         06:cf:32:2f:3b:63:82:fb:89:d2:f0:99:6a:2b:c2:84:87:84:
+         02:20:59:76:4d:c4:d8:c5:8a:15:22:ee:f7:33:f1:54:4a:3e:
+         72:51:53:6f:c2:17:d2:1c:64:77:30:48:87:58:19:f6
+*/
+
+// x509ClientCsr is the client CSR decoded above
+const x509ClientCsr = `-----BEGIN CERTIFICATE REQUEST-----
 MIH8MIGkAgEAMEIxFTATBgNVBAoTDHN5c3RlbTpub2RlczEpMCcGA1UEAxMgc3lz
 dGVtOm5vZGU6b3N0ZXN0LWV4dHJhd29ya2VyLTMwWTATBgcqhkjOPQIBBggqhkjO
 PQMBBwNCAATz0wJNo7QzR5RUIDbk4GBTRlAzcT0XLajQyckiXQjxowIIBuymBURX
@@ -2735,35 +2736,38 @@ IL8h1uCf5/1b5lgGzzIvO2OC+4nS8JlqK8KEh4QCIFl2TcTYxYoVIu73M/FUSj5y
 UVNvwhfSHGR3MEiHWBn2
 -----END CERTIFICATE REQUEST-----
 `
-	/* Decoded output of PEM formatted server CSR
-
-	Certificate Request:
-	    Data:
-	        Version: 1 (0x0)
-	        Subject: O = system:nodes, CN = system:node:ostest-extraworker-3
-	        Subject Public Key Info:
-	            Public Key Algorithm: id-ecPublicKey
-	                Public-Key: (256 bit)
-	                pub:
-	                    04:04:dc:cd:e4:ae:6f:5c:62:e3:bd:da:89:5e:4c:
-	                    20:81:e2:16:ea:31:2b:23:5a:94:22:54:9d:d2:65:
-	                    db:aa:1e:17:82:29:1a:53:84:3d:03:13:ae:ca:e3:
-	                    c9:7d:13:83:b4:23:84:a3:ac:18:4b:99:38:42:43:
-	                    c7:97:6d:37:0c
-	                ASN1 OID: prime256v1
-	                NIST CURVE: P-256
-	        Attributes:
-	        Requested Extensions:
-	            X509v3 Subject Alternative Name:
-	                DNS:ostest-extraworker-3, IP Address:192.168.111.28
-	    Signature Algorithm: ecdsa-with-SHA256
-	         30:46:02:21:00:c1:fa:af:ae:e3:7e:b6:d8:2d:11:ce:a7:07:
-	         e6:9c:52:46:4d:34:f2:ab:ae:bd:bc:ae:49:5e:d3:91:b5:42:
-	         aa:02:21:00:a8:a0:3a:01:af:5e:55:4d:5e:4b:44:62:4b:f2:
-	         f3:e8:7c:11:b3:69:80:4c:d6:39:16:ba:59:3a:07:4c:dd:c2
-
-	*/
-	x509ServerCSR := `-----BEGIN CERTIFICATE REQUEST-----
+
+/* Decoded output of PEM formatted server CSR
+
+Certificate Request:
+    Data:
+        Version: 1 (0x0)
+        Subject: O = system:nodes, CN = system:node:ostest-extraworker-3
+        Subject Public Key Info:
+            Public Key Algorithm: id-ecPublicKey
+                Public-Key: (256 bit)
+                pub:
+                    04:04:dc:cd:e4:ae:6f:5c:62:e3:bd:da:89:5e:4c:
+                    20:81:e2:16:ea:31:2b:23:5a:94:22:54:9d:d2:65:
+                    db:aa:1e:17:82:29:1a:53:84:3d:03:13:ae:ca:e3:
+                    c9:7d:13:83:b4:23:84:a3:ac:18:4b:99:38:42:43:
+                    c7:97:6d:37:0c
+                ASN1 OID: prime256v1
+                NIST CURVE: P-256
+        Attributes:
+        Requested Extensions:
+            X509v3 Subject Alternative Name:
+                DNS:ostest-extraworker-3, IP Address:192.168.111.28
+    Signature Algorithm: ecdsa-with-SHA256
+         30:46:02:21:00:c1:fa:af:ae:e3:7e:b6:d8:2d:11:ce:a7:07:
+         e6:9c:52:46:4d:34:f2:ab:ae:bd:bc:ae:49:5e:d3:91:b5:42:
+         aa:02:21:00:a8:a0:3a:01:af:5e:55:4d:5e:4b:44:62:4b:f2:
+         f3:e8:7c:11:b3:69:80:4c:d6:39:16:ba:59:3a:07:4c:dd:c2
+
+*/
+
+// x509ServerCSR is the server CSR decoded above
+const x509ServerCSR = `-----BEGIN CERTIFICATE REQUEST-----
 MIIBNjCB3AIBADBCMRUwEwYDVQQKEwxzeXN0ZW06bm9kZXMxKTAnBgNVBAMTIHN5
 c3RlbTpub2RlOm9zdGVzdC1leHRyYXdvcmtlci0zMFkwEwYHKoZIzj0CAQYIKoZI
 zj0DAQcDQgAEBNzN5K5vXGLjvdqJXkwggeIW6jErI1qUIlSd0mXbqh4XgikaU4Q9
@@ -2773,6 +2777,8 @@ SQAwRgIhAMH6r67jfrbYLRHOpwfmnFJGTTTyq669vK5JXtORtUKqAiEAqKA6Aa9e
 VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 -----END CERTIFICATE REQUEST-----
 `
+
+var _ = Describe("Approve CSRs", func() {
 	CommonHostname := "ostest-extraworker-3"
 	var (
 		c                     client.Client
@@ -2995,6 +3001,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 			Installer:                  mockInstallerInternal,
 			APIReader:                  c,
 			SpokeK8sClientFactory:      mockClientFactory,
+			SpokeClients:               NewSpokeClientCache(common.GetTestLog(), mockClientFactory, SpokeClientCacheConfig{}, nil),
 			ApproveCsrsRequeueDuration: time.Minute,
 			ImageServiceEnabled:        true,
 		}
@@ -4804,6 +4811,7 @@ var _ = Describe("spokeKubeClient", func() {
 			Log:                   common.GetTestLog(),
 			APIReader:             c,
 			SpokeK8sClientFactory: mockClientFactory,
+			SpokeClients:          NewSpokeClientCache(common.GetTestLog(), mockClientFactory, SpokeClientCacheConfig{}, nil),
 			ImageServiceEnabled:   true,
 		}
 		cdSpec = hivev1.ClusterDeploymentSpec{
@@ -4910,6 +4918,7 @@ var _ = Describe("handleAgentFinalizer", func() {
 			Log:                   common.GetTestLog(),
 			Installer:             mockInstallerInternal,
 			SpokeK8sClientFactory: mockClientFactory,
+			SpokeClients:          NewSpokeClientCache(common.GetTestLog(), mockClientFactory, SpokeClientCacheConfig{}, nil),
 			ImageServiceEnabled:   true,
 		}
 
@@ -5475,6 +5484,7 @@ var _ = Describe("Restore Host - Reconcile an Agent with missing Host", func() {
 			Log:                   common.GetTestLog(),
 			Installer:             mockInstallerInternal,
 			SpokeK8sClientFactory: mockClientFactory,
+			SpokeClients:          NewSpokeClientCache(common.GetTestLog(), mockClientFactory, SpokeClientCacheConfig{}, nil),
 			AgentContainerImage:   agentImage,
 			ImageServiceEnabled:   true,
 		}
@@ -6039,3 +6049,172 @@ var _ = Describe("createNewHost", func() {
 		Expect(host.ClusterID).To(BeNil())
 	})
 })
+
+var _ = Describe("CSR approval policy", func() {
+	const hostname = "ostest-extraworker-3"
+	var (
+		c                 client.Client
+		hr                *AgentReconciler
+		ctx               = context.Background()
+		mockCtrl          *gomock.Controller
+		mockClient        *spoke_k8s_client.MockSpokeK8sClient
+		agent             *v1beta1.Agent
+		clusterInstall    *hiveext.AgentClusterInstall
+		clusterDeployment *hivev1.ClusterDeployment
+	)
+
+	newCsr := func(request string, signerName string, usages []certificatesv1.KeyUsage, username string, groups []string) certificatesv1.CertificateSigningRequest {
+		return certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "csr-" + signerName},
+			Spec: certificatesv1.CertificateSigningRequestSpec{
+				Request:    []byte(request),
+				SignerName: signerName,
+				Usages:     usages,
+				Username:   username,
+				Groups:     groups,
+			},
+		}
+	}
+	clientCsr := func() certificatesv1.CertificateSigningRequest {
+		return newCsr(x509ClientCsr, certificatesv1.KubeAPIServerClientKubeletSignerName,
+			[]certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth},
+			"system:serviceaccount:openshift-machine-config-operator:node-bootstrapper",
+			[]string{"system:serviceaccounts:openshift-machine-config-operator", "system:serviceaccounts", "system:authenticated"})
+	}
+	serverCsr := func() certificatesv1.CertificateSigningRequest {
+		return newCsr(x509ServerCSR, certificatesv1.KubeletServingSignerName,
+			[]certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			nodeUserPrefix+hostname, []string{"system:authenticated", "system:nodes"})
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithStatusSubresource(&v1beta1.Agent{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClient = spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+		hr = &AgentReconciler{
+			Client:    c,
+			APIReader: c,
+			Scheme:    scheme.Scheme,
+			Log:       common.GetTestLog(),
+		}
+		clusterDeployment = newClusterDeployment("clusterDeployment", testNamespace,
+			getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, clusterDeployment)).To(Succeed())
+		clusterInstall = newAgentClusterInstall("test-cluster-aci", testNamespace, hiveext.AgentClusterInstallSpec{}, clusterDeployment)
+		agent = newAgent("agent", testNamespace, v1beta1.AgentSpec{
+			Hostname:              hostname,
+			ClusterDeploymentName: &v1beta1.ClusterReference{Name: clusterDeployment.Name, Namespace: testNamespace},
+		})
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	createClusterInstall := func(policy *hiveext.CSRApprovalPolicy) {
+		clusterInstall.Spec.CSRApprovalPolicy = policy
+		Expect(c.Create(ctx, clusterInstall)).To(Succeed())
+	}
+
+	It("approves the CSRs matching the agent without a policy", func() {
+		createClusterInstall(nil)
+		mockClient.EXPECT().ListCsrs(gomock.Any()).Return(&certificatesv1.CertificateSigningRequestList{
+			Items: []certificatesv1.CertificateSigningRequest{clientCsr()},
+		}, nil)
+		mockClient.EXPECT().ApproveCsr(gomock.Any(), gomock.Any()).Return(nil)
+
+		hr.tryApproveDay2CSRs(ctx, agent, nil, mockClient)
+		Expect(agent.Status.CSRStatus.ApprovedCSRs).To(HaveLen(1))
+		Expect(agent.Status.CSRStatus.RefusedCSRs).To(BeEmpty())
+		Expect(agent.Status.CSRStatus.FirstApprovalAttempt.IsZero()).To(BeFalse())
+		condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.CSRsApprovedCondition)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Reason).To(Equal(v1beta1.CSRsPendingReason))
+	})
+
+	It("refuses the CSRs when manual approval is required", func() {
+		createClusterInstall(&hiveext.CSRApprovalPolicy{Mode: hiveext.CSRApprovalModeManual})
+		mockClient.EXPECT().ListCsrs(gomock.Any()).Return(&certificatesv1.CertificateSigningRequestList{
+			Items: []certificatesv1.CertificateSigningRequest{clientCsr()},
+		}, nil)
+
+		hr.tryApproveDay2CSRs(ctx, agent, nil, mockClient)
+		Expect(agent.Status.CSRStatus.ApprovedCSRs).To(BeEmpty())
+		Expect(agent.Status.CSRStatus.RefusedCSRs).To(HaveLen(1))
+		Expect(agent.Status.CSRStatus.RefusedCSRs[0].Type).To(Equal(v1beta1.CSRTypeClient))
+		Expect(agent.Status.CSRStatus.RefusedCSRs[0].Reason).To(ContainSubstring("manual approval"))
+		condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.CSRsApprovedCondition)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.CSRsRefusedReason))
+		Expect(condition.Message).To(ContainSubstring("csr-" + certificatesv1.KubeAPIServerClientKubeletSignerName))
+	})
+
+	It("records the CSRs approved by the user when manual approval is required", func() {
+		createClusterInstall(&hiveext.CSRApprovalPolicy{Mode: hiveext.CSRApprovalModeManual})
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: hostname}}
+		approved := []certificatesv1.CertificateSigningRequest{clientCsr(), serverCsr()}
+		for i := range approved {
+			approved[i].Status.Conditions = []certificatesv1.CertificateSigningRequestCondition{
+				{Type: certificatesv1.CertificateApproved, LastUpdateTime: metav1.Now()},
+			}
+		}
+		mockClient.EXPECT().ListCsrs(gomock.Any()).Return(&certificatesv1.CertificateSigningRequestList{
+			Items: approved,
+		}, nil).Times(2)
+
+		hr.tryApproveDay2CSRs(ctx, agent, node, mockClient)
+		Expect(agent.Status.CSRStatus.ApprovedCSRs).To(HaveLen(2))
+		Expect(agent.Status.CSRStatus.RefusedCSRs).To(BeEmpty())
+		Expect(areCSRsHandled(true, agent)).To(BeTrue())
+		condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.CSRsApprovedCondition)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(v1beta1.CSRsApprovedReason))
+	})
+
+	It("refuses the CSRs once the approval window expired", func() {
+		createClusterInstall(&hiveext.CSRApprovalPolicy{ApprovalWindow: &metav1.Duration{Duration: time.Hour}})
+		agent.Status.CSRStatus.FirstApprovalAttempt = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		mockClient.EXPECT().ListCsrs(gomock.Any()).Return(&certificatesv1.CertificateSigningRequestList{
+			Items: []certificatesv1.CertificateSigningRequest{clientCsr()},
+		}, nil)
+
+		hr.tryApproveDay2CSRs(ctx, agent, nil, mockClient)
+		Expect(agent.Status.CSRStatus.ApprovedCSRs).To(BeEmpty())
+		Expect(agent.Status.CSRStatus.RefusedCSRs).To(HaveLen(1))
+		Expect(agent.Status.CSRStatus.RefusedCSRs[0].Reason).To(ContainSubstring("approval window"))
+	})
+
+	Context("serving CSRs", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			// The node doesn't report the IP address of the serving CSR
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: hostname}}
+			mockClient.EXPECT().ListCsrs(gomock.Any()).Return(&certificatesv1.CertificateSigningRequestList{
+				Items: []certificatesv1.CertificateSigningRequest{serverCsr()},
+			}, nil).Times(2)
+		})
+
+		It("refuses a CSR with an unknown address", func() {
+			createClusterInstall(nil)
+
+			hr.tryApproveDay2CSRs(ctx, agent, node, mockClient)
+			Expect(agent.Status.CSRStatus.ApprovedCSRs).To(BeEmpty())
+			Expect(agent.Status.CSRStatus.RefusedCSRs).To(HaveLen(1))
+			Expect(agent.Status.CSRStatus.RefusedCSRs[0].Type).To(Equal(v1beta1.CSRTypeServing))
+			Expect(agent.Status.CSRStatus.RefusedCSRs[0].Reason).To(ContainSubstring("192.168.111.28"))
+		})
+
+		It("approves a CSR with an additional SAN allowed by the policy", func() {
+			createClusterInstall(&hiveext.CSRApprovalPolicy{AdditionalSANs: []string{"192.168.111.28"}})
+			mockClient.EXPECT().ApproveCsr(gomock.Any(), gomock.Any()).Return(nil)
+
+			hr.tryApproveDay2CSRs(ctx, agent, node, mockClient)
+			Expect(agent.Status.CSRStatus.ApprovedCSRs).To(HaveLen(1))
+			Expect(agent.Status.CSRStatus.ApprovedCSRs[0].Type).To(Equal(v1beta1.CSRTypeServing))
+			Expect(agent.Status.CSRStatus.RefusedCSRs).To(BeEmpty())
+		})
+	})
+})
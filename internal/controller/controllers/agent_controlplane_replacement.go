@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/swag"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AgentReplacesNodeAnnotation is set on a day2 agent with the master role to replace the control plane node with the
+// given name, e.g. a failed master of an installed cluster
+const AgentReplacesNodeAnnotation = "agent." + aiv1beta1.Group + "/replaces-node"
+
+// replacedNodeRemovedReasons are the reasons of the ControlPlaneReplaced condition once the replaced node was removed
+var replacedNodeRemovedReasons = []string{
+	aiv1beta1.ReadyForReplacementReason,
+	aiv1beta1.WaitingForEtcdMemberReason,
+	aiv1beta1.ControlPlaneReplacedReason,
+}
+
+func replacedNodeName(agent *aiv1beta1.Agent) string {
+	return agent.GetAnnotations()[AgentReplacesNodeAnnotation]
+}
+
+func isControlPlaneReplacement(agent *aiv1beta1.Agent) bool {
+	return replacedNodeName(agent) != ""
+}
+
+// isWaitingForControlPlaneReplacement returns true when the agent replaces a control plane node that wasn't removed
+// yet, and so the agent must not be installed
+func isWaitingForControlPlaneReplacement(agent *aiv1beta1.Agent) bool {
+	if !isControlPlaneReplacement(agent) {
+		return false
+	}
+	condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, aiv1beta1.ControlPlaneReplacedCondition)
+	return condition == nil || !funk.ContainsString(replacedNodeRemovedReasons, condition.Reason)
+}
+
+// prepareControlPlaneReplacement removes the etcd member and the node of the control plane node replaced by the agent
+// once the agent is ready to be installed. The etcd member is removed before the new node joins the cluster so that the
+// quorum of the etcd cluster doesn't include the failed member. The cluster-etcd-operator adds the member of the new
+// node once it joins the cluster. Returns a nil result when the reconcile should continue.
+func (r *AgentReconciler) prepareControlPlaneReplacement(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, h *common.Host) (*ctrl.Result, error) {
+	if !isWaitingForControlPlaneReplacement(agent) || agent.Spec.ClusterDeploymentName == nil || h.ClusterID == nil ||
+		swag.StringValue(h.Kind) != models.HostKindAddToExistingClusterHost {
+		return nil, nil
+	}
+	nodeName := replacedNodeName(agent)
+	log = log.WithField("replaced_node", nodeName)
+
+	if agent.Spec.Role != models.HostRoleMaster {
+		err := r.setControlPlaneReplacedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.ControlPlaneReplacementFailedReason,
+			fmt.Sprintf("%s the agent must have the %s role", aiv1beta1.ControlPlaneReplacementFailedMsg, models.HostRoleMaster))
+		return nil, err
+	}
+	// The replaced node is kept until the agent can be installed, in case the replacement is cancelled
+	if swag.StringValue(h.Status) != models.HostStatusKnown {
+		return nil, nil
+	}
+
+	fail := func(err error) (*ctrl.Result, error) {
+		log.WithError(err).Error("failed to remove the replaced control plane node")
+		if condErr := r.setControlPlaneReplacedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.ControlPlaneReplacementFailedReason,
+			fmt.Sprintf("%s %s", aiv1beta1.ControlPlaneReplacementFailedMsg, err.Error())); condErr != nil {
+			log.WithError(condErr).Error("failed to update the control plane replacement condition")
+		}
+		return &ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, err
+	}
+
+	if err := r.setControlPlaneReplacedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.RemovingReplacedNodeReason,
+		fmt.Sprintf("%s %s", aiv1beta1.RemovingReplacedNodeMsg, nodeName)); err != nil {
+		log.WithError(err).Error("failed to update the control plane replacement condition")
+	}
+	spokeClient, err := r.spokeKubeClient(ctx, agent.Spec.ClusterDeploymentName)
+	if err != nil {
+		return fail(errors.Wrap(err, "failed to create the spoke client"))
+	}
+	log.Infof("Removing the etcd member of replaced node %s", nodeName)
+	if err = spokeClient.RemoveEtcdMember(ctx, nodeName, true); err != nil {
+		return fail(errors.Wrapf(err, "failed to remove the etcd member of node %s", nodeName))
+	}
+	if err = removeSpokeResources(ctx, log, spokeClient, nodeName); err != nil {
+		return fail(errors.Wrapf(err, "failed to remove node %s", nodeName))
+	}
+	log.Infof("Replaced node %s was removed, agent %s/%s can be installed", nodeName, agent.Namespace, agent.Name)
+	if err = r.setControlPlaneReplacedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.ReadyForReplacementReason,
+		aiv1beta1.ReadyForReplacementMsg); err != nil {
+		return fail(err)
+	}
+	return nil, nil
+}
+
+// isControlPlaneReplacementDone returns true when the node doesn't replace a control plane node or once the etcd member
+// of the new node is started
+func (r *AgentReconciler) isControlPlaneReplacementDone(ctx context.Context, agent *aiv1beta1.Agent, node *corev1.Node) (bool, error) {
+	if !isControlPlaneReplacement(agent) {
+		return true, nil
+	}
+	condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, aiv1beta1.ControlPlaneReplacedCondition)
+	if condition != nil && condition.Reason == aiv1beta1.ControlPlaneReplacedReason {
+		return true, nil
+	}
+	spokeClient, err := r.spokeKubeClient(ctx, agent.Spec.ClusterDeploymentName)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create the spoke client")
+	}
+	started, err := spokeClient.IsEtcdMemberStarted(ctx, node.Name)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get the etcd member of node %s", node.Name)
+	}
+	if !started {
+		setControlPlaneReplacedCondition(agent, corev1.ConditionFalse, aiv1beta1.WaitingForEtcdMemberReason, aiv1beta1.WaitingForEtcdMemberMsg)
+		return false, nil
+	}
+	setControlPlaneReplacedCondition(agent, corev1.ConditionTrue, aiv1beta1.ControlPlaneReplacedReason, aiv1beta1.ControlPlaneReplacedMsg)
+	return true, nil
+}
+
+func setControlPlaneReplacedCondition(agent *aiv1beta1.Agent, status corev1.ConditionStatus, reason, message string) {
+	conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, conditionsv1.Condition{
+		Type:    aiv1beta1.ControlPlaneReplacedCondition,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+func (r *AgentReconciler) setControlPlaneReplacedCondition(ctx context.Context, agent *aiv1beta1.Agent, status corev1.ConditionStatus, reason, message string) error {
+	patch := client.MergeFrom(agent.DeepCopy())
+	setControlPlaneReplacedCondition(agent, status, reason, message)
+	return r.Status().Patch(ctx, agent, patch)
+}
@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("control plane replacement", func() {
+	var (
+		c                     client.Client
+		hr                    *AgentReconciler
+		ctx                   = context.Background()
+		mockCtrl              *gomock.Controller
+		mockClientFactory     *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockClient            *spoke_k8s_client.MockSpokeK8sClient
+		agent                 *v1beta1.Agent
+		host                  *common.Host
+		clusterDeploymentName = "test-cluster"
+		replacedNode          = "master-0.example.com"
+		newNode               = "master-3.example.com"
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithStatusSubresource(&v1beta1.Agent{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
+		mockClient = spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+		hr = &AgentReconciler{
+			Client:                c,
+			APIReader:             c,
+			Scheme:                scheme.Scheme,
+			Log:                   common.GetTestLog(),
+			SpokeK8sClientFactory: mockClientFactory,
+			SpokeClients:          NewSpokeClientCache(common.GetTestLog(), mockClientFactory, SpokeClientCacheConfig{}, nil),
+		}
+
+		clusterDeployment := newClusterDeployment(clusterDeploymentName, testNamespace,
+			getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, clusterDeployment)).To(Succeed())
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf(adminKubeConfigStringTemplate, clusterDeploymentName),
+				Namespace: testNamespace,
+			},
+			Data: map[string][]byte{"kubeconfig": []byte("somekubeconfig")},
+		})).To(Succeed())
+
+		agent = newAgent("agent", testNamespace, v1beta1.AgentSpec{
+			Hostname:              newNode,
+			Role:                  models.HostRoleMaster,
+			ClusterDeploymentName: &v1beta1.ClusterReference{Name: clusterDeploymentName, Namespace: testNamespace},
+		})
+		agent.Annotations = map[string]string{AgentReplacesNodeAnnotation: replacedNode}
+		Expect(c.Create(ctx, agent)).To(Succeed())
+
+		hostID := strfmt.UUID(uuid.New().String())
+		clusterID := strfmt.UUID(uuid.New().String())
+		host = &common.Host{Host: models.Host{
+			ID:        &hostID,
+			ClusterID: &clusterID,
+			Kind:      swag.String(models.HostKindAddToExistingClusterHost),
+			Status:    swag.String(models.HostStatusKnown),
+			Role:      models.HostRoleMaster,
+		}}
+
+		mockClientFactory.EXPECT().CreateFromSecret(gomock.Any(), gomock.Any()).Return(mockClient, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	getAgent := func() *v1beta1.Agent {
+		ret := &v1beta1.Agent{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: agent.Name}, ret)).To(Succeed())
+		return ret
+	}
+
+	expectCondition := func(agent *v1beta1.Agent, status corev1.ConditionStatus, reason string) {
+		condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.ControlPlaneReplacedCondition)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(status))
+		Expect(condition.Reason).To(Equal(reason))
+	}
+
+	Context("prepareControlPlaneReplacement", func() {
+		It("removes the etcd member and the node of the replaced node", func() {
+			Expect(isWaitingForControlPlaneReplacement(agent)).To(BeTrue())
+			mockClient.EXPECT().RemoveEtcdMember(gomock.Any(), replacedNode, true).Return(nil)
+			mockClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Name: replacedNode}, gomock.Any()).Return(nil)
+			mockClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+
+			result, err := hr.prepareControlPlaneReplacement(ctx, common.GetTestLog(), agent, host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeNil())
+
+			updated := getAgent()
+			expectCondition(updated, corev1.ConditionFalse, v1beta1.ReadyForReplacementReason)
+			Expect(isWaitingForControlPlaneReplacement(updated)).To(BeFalse())
+		})
+
+		It("keeps the agent waiting when the etcd member can't be removed", func() {
+			mockClient.EXPECT().RemoveEtcdMember(gomock.Any(), replacedNode, true).Return(errors.New("no running etcd pod"))
+
+			result, err := hr.prepareControlPlaneReplacement(ctx, common.GetTestLog(), agent, host)
+			Expect(err).To(HaveOccurred())
+			Expect(result).To(Equal(&ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}))
+
+			updated := getAgent()
+			expectCondition(updated, corev1.ConditionFalse, v1beta1.ControlPlaneReplacementFailedReason)
+			Expect(isWaitingForControlPlaneReplacement(updated)).To(BeTrue())
+		})
+
+		It("rejects an agent without the master role", func() {
+			agent.Spec.Role = models.HostRoleWorker
+
+			result, err := hr.prepareControlPlaneReplacement(ctx, common.GetTestLog(), agent, host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeNil())
+			expectCondition(getAgent(), corev1.ConditionFalse, v1beta1.ControlPlaneReplacementFailedReason)
+		})
+
+		It("keeps the replaced node until the agent can be installed", func() {
+			host.Status = swag.String(models.HostStatusInsufficient)
+
+			result, err := hr.prepareControlPlaneReplacement(ctx, common.GetTestLog(), agent, host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeNil())
+			Expect(conditionsv1.FindStatusCondition(getAgent().Status.Conditions, v1beta1.ControlPlaneReplacedCondition)).To(BeNil())
+		})
+
+		It("does nothing for an agent that doesn't replace a node", func() {
+			delete(agent.Annotations, AgentReplacesNodeAnnotation)
+
+			result, err := hr.prepareControlPlaneReplacement(ctx, common.GetTestLog(), agent, host)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeNil())
+			Expect(isWaitingForControlPlaneReplacement(agent)).To(BeFalse())
+		})
+	})
+
+	Context("UpdateDay2InstallPogress", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			node = &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: newNode},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				}},
+			}
+			setControlPlaneReplacedCondition(agent, corev1.ConditionFalse, v1beta1.ReadyForReplacementReason, v1beta1.ReadyForReplacementMsg)
+			mockInstallerInternal := bminventory.NewMockInstallerInternals(mockCtrl)
+			hr.Installer = mockInstallerInternal
+			mockInstallerInternal.EXPECT().V2UpdateHostInstallProgressInternal(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		})
+
+		It("keeps the node joined until its etcd member is started", func() {
+			mockClient.EXPECT().IsEtcdMemberStarted(gomock.Any(), newNode).Return(false, nil)
+			Expect(hr.UpdateDay2InstallPogress(ctx, &host.Host, agent, node, false)).To(Succeed())
+			Expect(agent.Status.Progress.CurrentStage).To(Equal(models.HostStageJoined))
+			expectCondition(agent, corev1.ConditionFalse, v1beta1.WaitingForEtcdMemberReason)
+
+			mockClient.EXPECT().IsEtcdMemberStarted(gomock.Any(), newNode).Return(true, nil)
+			Expect(hr.UpdateDay2InstallPogress(ctx, &host.Host, agent, node, false)).To(Succeed())
+			Expect(agent.Status.Progress.CurrentStage).To(Equal(models.HostStageDone))
+			expectCondition(agent, corev1.ConditionTrue, v1beta1.ControlPlaneReplacedReason)
+		})
+
+		It("fails when the etcd members can't be listed", func() {
+			mockClient.EXPECT().IsEtcdMemberStarted(gomock.Any(), newNode).Return(false,
+				k8serrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "etcd"))
+			Expect(hr.UpdateDay2InstallPogress(ctx, &host.Host, agent, node, false)).ToNot(Succeed())
+		})
+	})
+})
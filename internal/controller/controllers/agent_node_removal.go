@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/swag"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/drain"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AgentRemoveNodeAnnotation requests the removal of the node of an installed agent from its cluster
+	AgentRemoveNodeAnnotation = "agent." + aiv1beta1.Group + "/remove-node"
+	// AgentNodeDrainTimeoutAnnotation is the time, in time.Duration format, after which the removal continues even if
+	// the node isn't drained
+	AgentNodeDrainTimeoutAnnotation = "agent." + aiv1beta1.Group + "/node-drain-timeout"
+	// AgentNodeDrainDisableEvictionAnnotation deletes the pods of the node instead of evicting them, bypassing the pod
+	// disruption budgets
+	AgentNodeDrainDisableEvictionAnnotation = "agent." + aiv1beta1.Group + "/node-drain-disable-eviction"
+	AgentNodeDrainStartAnnotation           = "agent." + aiv1beta1.Group + "/node-drain-started-at"
+
+	defaultNodeDrainTimeout        = 10 * time.Minute
+	nodeDrainRequeueAfter          = 20 * time.Second
+	etcdRemovalRefusedRequeueAfter = 1 * time.Minute
+)
+
+// installedHostStatuses are the statuses of the hosts that run a node of their cluster
+var installedHostStatuses = []string{models.HostStatusInstalled, models.HostStatusAddedToExistingCluster}
+
+func isNodeRemovalRequested(agent *aiv1beta1.Agent) bool {
+	_, ok := agent.GetAnnotations()[AgentRemoveNodeAnnotation]
+	return ok
+}
+
+// removeAgentNode removes the node of an installed agent from its cluster: the node is cordoned and drained, the etcd
+// member of a control plane node is removed and the agent is unbound. The unbinding reclaims the host into its
+// infraenv, and the node is deleted once the host is rebooted into discovery, see cleanUnboundSpokeNode. The progress
+// is reported in the NodeRemoved condition. Returns a nil result when the reconcile should continue.
+func (r *AgentReconciler) removeAgentNode(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, h *common.Host) (*ctrl.Result, error) {
+	if agent.Spec.ClusterDeploymentName == nil {
+		if err := r.completeNodeRemoval(ctx, log, agent, h); err != nil {
+			log.WithError(err).Warnf("failed to complete the node removal of agent %s/%s", agent.Namespace, agent.Name)
+		}
+		return nil, nil
+	}
+	if h.ClusterID == nil {
+		return nil, nil
+	}
+	log = log.WithField("node_removal", getAgentHostname(agent))
+
+	fail := func(err error) (*ctrl.Result, error) {
+		log.WithError(err).Error("failed to remove the agent node")
+		if condErr := r.setNodeRemovedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.NodeRemovalFailedReason,
+			fmt.Sprintf("%s %s", aiv1beta1.NodeRemovalFailedMsg, err.Error())); condErr != nil {
+			log.WithError(condErr).Error("failed to update the node removal condition")
+		}
+		return &ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, err
+	}
+
+	if funk.ContainsString(installedHostStatuses, swag.StringValue(h.Status)) {
+		spokeClient, clientset, err := r.spokeKubeClientAndSet(ctx, agent.Spec.ClusterDeploymentName)
+		if err != nil {
+			return fail(errors.Wrap(err, "failed to create the spoke client"))
+		}
+		defer spokeClient.Close()
+		nodeName := getAgentHostname(agent)
+		node, err := spokeClient.GetNode(ctx, nodeName)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fail(errors.Wrapf(err, "failed to get node %s", nodeName))
+		}
+		if node != nil {
+			drained, err := r.drainNodeForRemoval(ctx, log, agent, node, clientset)
+			if err != nil {
+				return fail(err)
+			}
+			if !drained {
+				return &ctrl.Result{RequeueAfter: nodeDrainRequeueAfter}, nil
+			}
+			if common.GetEffectiveRole(&h.Host) == models.HostRoleMaster {
+				log.Infof("Removing the etcd member of node %s", nodeName)
+				if err = r.setNodeRemovedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.NodeRemovingEtcdMemberReason,
+					fmt.Sprintf("%s %s", aiv1beta1.NodeRemovingEtcdMemberMsg, nodeName)); err != nil {
+					log.WithError(err).Error("failed to update the node removal condition")
+				}
+				err = spokeClient.RemoveEtcdMember(ctx, nodeName, false)
+				if errors.Is(err, spoke_k8s_client.ErrEtcdMemberRemovalUnsafe) {
+					log.WithError(err).Warnf("Refusing to remove the etcd member of node %s", nodeName)
+					if err = r.setNodeRemovedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.NodeEtcdRemovalRefusedReason,
+						fmt.Sprintf("%s %s", aiv1beta1.NodeEtcdRemovalRefusedMsg, err.Error())); err != nil {
+						log.WithError(err).Error("failed to update the node removal condition")
+					}
+					return &ctrl.Result{RequeueAfter: etcdRemovalRefusedRequeueAfter}, nil
+				}
+				if err != nil {
+					return fail(errors.Wrapf(err, "%s %s", aiv1beta1.NodeRemovingEtcdMemberMsg, nodeName))
+				}
+			}
+		}
+	}
+
+	log.Info("Unbinding the agent of the removed node")
+	delete(agent.Annotations, AgentNodeDrainStartAnnotation)
+	agent.Spec.ClusterDeploymentName = nil
+	if err := r.Update(ctx, agent); err != nil {
+		return fail(errors.Wrap(err, "failed to unbind the agent"))
+	}
+	if err := r.setNodeRemovedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.NodeReclaimingReason, aiv1beta1.NodeReclaimingMsg); err != nil {
+		log.WithError(err).Error("failed to update the node removal condition")
+	}
+	return nil, nil
+}
+
+// drainNodeForRemoval cordons and drains the node, returns true once the node is drained or the drain timed out
+func (r *AgentReconciler) drainNodeForRemoval(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, node *corev1.Node, clientset kubernetes.Interface) (bool, error) {
+	timeout := defaultNodeDrainTimeout
+	if value, ok := agent.GetAnnotations()[AgentNodeDrainTimeoutAnnotation]; ok {
+		var err error
+		if timeout, err = time.ParseDuration(value); err != nil {
+			return false, errors.Wrapf(err, "invalid node drain timeout %s", value)
+		}
+	}
+	startValue, ok := agent.GetAnnotations()[AgentNodeDrainStartAnnotation]
+	if !ok {
+		startValue = time.Now().UTC().Truncate(time.Second).Format(time.RFC3339)
+		setAnnotation(&agent.ObjectMeta, AgentNodeDrainStartAnnotation, startValue)
+		if err := r.Update(ctx, agent); err != nil {
+			return false, errors.Wrap(err, "failed to record the start of the node drain")
+		}
+	}
+	start, err := time.Parse(time.RFC3339, startValue)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid node drain start time %s", startValue)
+	}
+	if time.Since(start) >= timeout {
+		log.Warnf("Timed out draining node %s after %s, continuing with the removal", node.Name, timeout)
+		return true, r.setNodeRemovedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.NodeDrainTimedOutReason, aiv1beta1.NodeDrainTimedOutMsg)
+	}
+
+	out := new(bytes.Buffer)
+	drainHelper := &drain.Helper{
+		Client:              clientset,
+		Ctx:                 ctx,
+		Force:               true,
+		IgnoreAllDaemonSets: true,
+		DeleteEmptyDirData:  true,
+		DisableEviction:     agent.GetAnnotations()[AgentNodeDrainDisableEvictionAnnotation] == "true",
+		GracePeriodSeconds:  -1,
+		Timeout:             nodeDrainRequeueAfter,
+		Out:                 out,
+		ErrOut:              out,
+	}
+	if nodeUnreachable(node) {
+		drainHelper.SkipWaitForDeleteTimeoutSeconds = 60 * 5 // 5 minutes
+	}
+	if err = r.Drainer.RunCordonOrUncordon(drainHelper, node, true); err != nil {
+		log.Debugf("node %s cordon output: %s", node.Name, out)
+		return false, errors.Wrapf(err, "failed to cordon node %s", node.Name)
+	}
+	if err = r.Drainer.RunNodeDrain(drainHelper, node.Name); err != nil {
+		log.WithError(err).Infof("node %s isn't drained yet", node.Name)
+		log.Debugf("node %s drain output: %s", node.Name, out)
+		return false, r.setNodeRemovedCondition(ctx, agent, corev1.ConditionFalse, aiv1beta1.NodeDrainingReason,
+			fmt.Sprintf("%s %s", aiv1beta1.NodeDrainingMsg, err.Error()))
+	}
+	log.Infof("Node %s is drained", node.Name)
+	return true, nil
+}
+
+// completeNodeRemoval reports the removal as done once the host is unbound and the spoke resources are cleaned
+func (r *AgentReconciler) completeNodeRemoval(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, h *common.Host) error {
+	condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, aiv1beta1.NodeRemovedCondition)
+	if condition == nil || condition.Reason != aiv1beta1.NodeReclaimingReason || h.ClusterID != nil || agent.Status.DeprovisionInfo != nil {
+		return nil
+	}
+	log.Infof("Removal of node %s completed", getAgentHostname(agent))
+	delete(agent.Annotations, AgentRemoveNodeAnnotation)
+	if err := r.Update(ctx, agent); err != nil {
+		return errors.Wrapf(err, "failed to remove annotation %s", AgentRemoveNodeAnnotation)
+	}
+	return r.setNodeRemovedCondition(ctx, agent, corev1.ConditionTrue, aiv1beta1.NodeRemovedReason, aiv1beta1.NodeRemovedMsg)
+}
+
+func (r *AgentReconciler) setNodeRemovedCondition(ctx context.Context, agent *aiv1beta1.Agent, status corev1.ConditionStatus, reason, message string) error {
+	patch := client.MergeFrom(agent.DeepCopy())
+	conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, conditionsv1.Condition{
+		Type:    aiv1beta1.NodeRemovedCondition,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+	return r.Status().Patch(ctx, agent, patch)
+}
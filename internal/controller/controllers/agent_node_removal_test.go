@@ -0,0 +1,248 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/kubectl/pkg/drain"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("removeAgentNode", func() {
+	var (
+		c                     client.Client
+		hr                    *AgentReconciler
+		ctx                   = context.Background()
+		mockCtrl              *gomock.Controller
+		mockClientFactory     *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockClient            *spoke_k8s_client.MockSpokeK8sClient
+		mockDrainer           *MockDrainer
+		agent                 *v1beta1.Agent
+		host                  *common.Host
+		clusterDeploymentName = "test-cluster"
+		hostname              = "node-1.example.com"
+		node                  *corev1.Node
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithStatusSubresource(&v1beta1.Agent{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
+		mockClient = spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+		mockDrainer = NewMockDrainer(mockCtrl)
+		hr = &AgentReconciler{
+			Client:                c,
+			APIReader:             c,
+			Scheme:                scheme.Scheme,
+			Log:                   common.GetTestLog(),
+			SpokeK8sClientFactory: mockClientFactory,
+			Drainer:               mockDrainer,
+		}
+
+		clusterDeployment := newClusterDeployment(clusterDeploymentName, testNamespace,
+			getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, clusterDeployment)).To(Succeed())
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf(adminKubeConfigStringTemplate, clusterDeploymentName),
+				Namespace: testNamespace,
+			},
+			Data: map[string][]byte{"kubeconfig": []byte("somekubeconfig")},
+		})).To(Succeed())
+
+		agent = newAgent("agent", testNamespace, v1beta1.AgentSpec{
+			Hostname:              hostname,
+			ClusterDeploymentName: &v1beta1.ClusterReference{Name: clusterDeploymentName, Namespace: testNamespace},
+		})
+		agent.Annotations = map[string]string{AgentRemoveNodeAnnotation: "true"}
+		Expect(c.Create(ctx, agent)).To(Succeed())
+
+		hostID := strfmt.UUID(uuid.New().String())
+		clusterID := strfmt.UUID(uuid.New().String())
+		host = &common.Host{Host: models.Host{
+			ID:        &hostID,
+			ClusterID: &clusterID,
+			Status:    swag.String(models.HostStatusAddedToExistingCluster),
+			Role:      models.HostRoleWorker,
+		}}
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: hostname}}
+
+		mockClientFactory.EXPECT().ClientAndSetFromSecret(gomock.Any(), gomock.Any()).Return(mockClient, nil, nil).AnyTimes()
+		mockClient.EXPECT().Close().AnyTimes()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	getAgent := func() *v1beta1.Agent {
+		ret := &v1beta1.Agent{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: agent.Name}, ret)).To(Succeed())
+		return ret
+	}
+
+	expectCondition := func(status corev1.ConditionStatus, reason string) {
+		condition := conditionsv1.FindStatusCondition(getAgent().Status.Conditions, v1beta1.NodeRemovedCondition)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(status))
+		Expect(condition.Reason).To(Equal(reason))
+	}
+
+	It("drains the node and unbinds the agent", func() {
+		mockClient.EXPECT().GetNode(gomock.Any(), hostname).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.Any(), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.Any(), hostname).Return(nil)
+
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNil())
+
+		updated := getAgent()
+		Expect(updated.Spec.ClusterDeploymentName).To(BeNil())
+		Expect(updated.Annotations).To(HaveKey(AgentRemoveNodeAnnotation))
+		Expect(updated.Annotations).ToNot(HaveKey(AgentNodeDrainStartAnnotation))
+		expectCondition(corev1.ConditionFalse, v1beta1.NodeReclaimingReason)
+	})
+
+	It("removes the etcd member of a control plane node", func() {
+		host.Role = models.HostRoleMaster
+		mockClient.EXPECT().GetNode(gomock.Any(), hostname).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.Any(), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.Any(), hostname).Return(nil)
+		mockClient.EXPECT().RemoveEtcdMember(gomock.Any(), hostname, false).Return(nil)
+
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNil())
+		Expect(getAgent().Spec.ClusterDeploymentName).To(BeNil())
+	})
+
+	It("keeps the agent bound when the etcd member can't be removed", func() {
+		host.Role = models.HostRoleMaster
+		mockClient.EXPECT().GetNode(gomock.Any(), hostname).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.Any(), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.Any(), hostname).Return(nil)
+		mockClient.EXPECT().RemoveEtcdMember(gomock.Any(), hostname, false).Return(errors.New("quorum lost"))
+
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).To(HaveOccurred())
+		Expect(result).To(Equal(&ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}))
+		Expect(getAgent().Spec.ClusterDeploymentName).ToNot(BeNil())
+		expectCondition(corev1.ConditionFalse, v1beta1.NodeRemovalFailedReason)
+	})
+
+	It("keeps the agent bound when the removal of the etcd member is refused", func() {
+		host.Role = models.HostRoleMaster
+		mockClient.EXPECT().GetNode(gomock.Any(), hostname).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.Any(), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.Any(), hostname).Return(nil)
+		mockClient.EXPECT().RemoveEtcdMember(gomock.Any(), hostname, false).Return(
+			fmt.Errorf("only 2 voting members would be left: %w", spoke_k8s_client.ErrEtcdMemberRemovalUnsafe))
+
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(&ctrl.Result{RequeueAfter: etcdRemovalRefusedRequeueAfter}))
+		Expect(getAgent().Spec.ClusterDeploymentName).ToNot(BeNil())
+		expectCondition(corev1.ConditionFalse, v1beta1.NodeEtcdRemovalRefusedReason)
+	})
+
+	It("requeues while the node is draining", func() {
+		mockClient.EXPECT().GetNode(gomock.Any(), hostname).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.Any(), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.Any(), hostname).Return(errors.New("cannot evict pod as it would violate the pod's disruption budget"))
+
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(&ctrl.Result{RequeueAfter: nodeDrainRequeueAfter}))
+
+		updated := getAgent()
+		Expect(updated.Spec.ClusterDeploymentName).ToNot(BeNil())
+		Expect(updated.Annotations).To(HaveKey(AgentNodeDrainStartAnnotation))
+		expectCondition(corev1.ConditionFalse, v1beta1.NodeDrainingReason)
+	})
+
+	It("continues the removal once the drain timed out", func() {
+		agent.Annotations[AgentNodeDrainTimeoutAnnotation] = "5m"
+		agent.Annotations[AgentNodeDrainStartAnnotation] = time.Now().Add(-10 * time.Minute).UTC().Format(time.RFC3339)
+		Expect(c.Update(ctx, agent)).To(Succeed())
+		mockClient.EXPECT().GetNode(gomock.Any(), hostname).Return(node, nil)
+
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNil())
+		Expect(getAgent().Spec.ClusterDeploymentName).To(BeNil())
+	})
+
+	It("evicts the pods without the pod disruption budgets when requested", func() {
+		agent.Annotations[AgentNodeDrainDisableEvictionAnnotation] = "true"
+		Expect(c.Update(ctx, agent)).To(Succeed())
+		mockClient.EXPECT().GetNode(gomock.Any(), hostname).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.Any(), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.Any(), hostname).DoAndReturn(func(helper *drain.Helper, _ string) error {
+			Expect(helper.DisableEviction).To(BeTrue())
+			return nil
+		})
+
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNil())
+	})
+
+	It("unbinds the agent when the node doesn't exist", func() {
+		mockClient.EXPECT().GetNode(gomock.Any(), hostname).Return(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "nodes"}, hostname))
+
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNil())
+		Expect(getAgent().Spec.ClusterDeploymentName).To(BeNil())
+	})
+
+	It("unbinds the agent of a host that isn't installed without draining", func() {
+		host.Status = swag.String(models.HostStatusKnown)
+
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNil())
+		Expect(getAgent().Spec.ClusterDeploymentName).To(BeNil())
+	})
+
+	It("completes the removal once the host is back in its infraenv", func() {
+		agent.Spec.ClusterDeploymentName = nil
+		Expect(c.Update(ctx, agent)).To(Succeed())
+		Expect(hr.setNodeRemovedCondition(ctx, agent, corev1.ConditionFalse, v1beta1.NodeReclaimingReason, v1beta1.NodeReclaimingMsg)).To(Succeed())
+
+		// Still bound in the backend
+		result, err := hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNil())
+		expectCondition(corev1.ConditionFalse, v1beta1.NodeReclaimingReason)
+
+		host.ClusterID = nil
+		result, err = hr.removeAgentNode(ctx, common.GetTestLog(), agent, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeNil())
+		expectCondition(corev1.ConditionTrue, v1beta1.NodeRemovedReason)
+		Expect(getAgent().Annotations).ToNot(HaveKey(AgentRemoveNodeAnnotation))
+	})
+})
@@ -0,0 +1,221 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// AgentClusterInstallDryRunAnnotation makes the controller publish the changes the spec would apply to the cluster
+	// in the status of the AgentClusterInstall, instead of applying them, when set to "true"
+	AgentClusterInstallDryRunAnnotation = "agentclusterinstall." + aiv1beta1.Group + "/dry-run"
+
+	installConfigOverridesField = "install_config_overrides"
+	pullSecretField             = "pull_secret"
+	redactedValue               = `"<redacted>"`
+)
+
+func isDryRunRequested(clusterInstall *hiveext.AgentClusterInstall) bool {
+	return clusterInstall.GetAnnotations()[AgentClusterInstallDryRunAnnotation] == "true"
+}
+
+// dryRun computes the changes the spec would apply to the cluster and validates them with the inventory, without
+// updating the cluster. The changes are reported in the DryRun status and condition of the AgentClusterInstall, and
+// the cluster isn't updated nor installed until the annotation is removed.
+func (r *ClusterDeploymentsReconciler) dryRun(ctx context.Context, log logrus.FieldLogger,
+	clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall, cluster *common.Cluster) (ctrl.Result, error) {
+	clusterInstall.Status.DryRun = &hiveext.DryRunStatus{ObservedGeneration: clusterInstall.Generation}
+
+	params, update, err := r.clusterUpdateParams(ctx, log, clusterDeployment, clusterInstall, cluster)
+	if err != nil {
+		log.WithError(err).Error("failed to compute the dry run of the cluster update")
+		clusterInstall.Status.DryRun.ValidationError = err.Error()
+		clusterDryRun(clusterInstall)
+		return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
+	}
+	if update {
+		clusterInstall.Status.DryRun.Changes, err = dryRunChanges(cluster, params)
+		if err != nil {
+			return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
+		}
+		err = r.Installer.ValidateClusterUpdateInternal(ctx, installer.V2UpdateClusterParams{
+			ClusterUpdateParams: params,
+			ClusterID:           *cluster.ID,
+		})
+		if err != nil {
+			if !IsUserError(err) {
+				log.WithError(err).Error("failed to validate the dry run of the cluster update")
+				return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
+			}
+			clusterInstall.Status.DryRun.ValidationError = err.Error()
+		}
+	}
+
+	// not relevant for day2 cluster - install config is not used
+	installConfigOverrides := clusterInstall.GetAnnotations()[InstallConfigOverrides]
+	if !common.IsDay2Cluster(cluster) && installConfigOverrides != cluster.InstallConfigOverrides {
+		clusterInstall.Status.DryRun.Changes = append(clusterInstall.Status.DryRun.Changes, hiveext.DryRunChange{
+			Field:   installConfigOverridesField,
+			Current: cluster.InstallConfigOverrides,
+			Desired: installConfigOverrides,
+		})
+	}
+
+	clusterDryRun(clusterInstall)
+	log.Infof("Dry run of clusterDeployment %s/%s found %d changes", clusterDeployment.Namespace,
+		clusterDeployment.Name, len(clusterInstall.Status.DryRun.Changes))
+	return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, nil)
+}
+
+// dryRunNotRegistered reports that the cluster of the AgentClusterInstall would be registered
+func (r *ClusterDeploymentsReconciler) dryRunNotRegistered(ctx context.Context, log logrus.FieldLogger,
+	clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall) (ctrl.Result, error) {
+	clusterInstall.Status.DryRun = &hiveext.DryRunStatus{ObservedGeneration: clusterInstall.Generation}
+	setClusterCondition(&clusterInstall.Status.Conditions, hivev1.ClusterInstallCondition{
+		Type:    hiveext.ClusterDryRunCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  hiveext.ClusterDryRunNotRegisteredReason,
+		Message: hiveext.ClusterDryRunNotRegisteredMsg,
+	})
+	return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, nil, nil)
+}
+
+// dryRunChanges returns the params of the update that differ from the cluster, with their JSON values. The absent
+// values of the cluster are considered equal to the zero values of the params.
+func dryRunChanges(cluster *common.Cluster, params *models.V2ClusterUpdateParams) ([]hiveext.DryRunChange, error) {
+	current, err := jsonFields(cluster)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the cluster")
+	}
+	desired, err := jsonFields(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the cluster update params")
+	}
+
+	fields := make([]string, 0, len(desired))
+	for field := range desired {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var changes []hiveext.DryRunChange
+	for _, field := range fields {
+		currentValue, desiredValue := current[field], desired[field]
+		// the params that are not updated are null
+		if string(desiredValue) == "null" {
+			continue
+		}
+		if jsonEqual(currentValue, desiredValue) || (currentValue == nil && jsonZero(desiredValue)) {
+			continue
+		}
+		change := hiveext.DryRunChange{Field: field, Desired: string(desiredValue)}
+		if currentValue != nil {
+			change.Current = string(currentValue)
+		}
+		if field == pullSecretField {
+			change.Current, change.Desired = redactedValue, redactedValue
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func jsonFields(obj interface{}) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	var aValue, bValue interface{}
+	if json.Unmarshal(a, &aValue) != nil || json.Unmarshal(b, &bValue) != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+func jsonZero(raw json.RawMessage) bool {
+	var value interface{}
+	if json.Unmarshal(raw, &value) != nil {
+		return false
+	}
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+func clusterDryRun(clusterInstall *hiveext.AgentClusterInstall) {
+	dryRun := clusterInstall.Status.DryRun
+	condition := hivev1.ClusterInstallCondition{
+		Type:    hiveext.ClusterDryRunCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  hiveext.ClusterDryRunNoChangesReason,
+		Message: hiveext.ClusterDryRunNoChangesMsg,
+	}
+	switch {
+	case dryRun.ValidationError != "":
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = hiveext.ClusterDryRunValidationFailedReason
+		condition.Message = hiveext.ClusterDryRunValidationFailedMsg + " " + dryRun.ValidationError
+	case len(dryRun.Changes) > 0:
+		condition.Reason = hiveext.ClusterDryRunChangesPendingReason
+		condition.Message = hiveext.ClusterDryRunChangesPendingMsg
+	}
+	setClusterCondition(&clusterInstall.Status.Conditions, condition)
+}
+
+// isSpecPendingDryRun returns true when the dry run found changes that are not applied to the cluster
+func isSpecPendingDryRun(clusterInstall *hiveext.AgentClusterInstall) bool {
+	if !isDryRunRequested(clusterInstall) {
+		return false
+	}
+	condition := FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterDryRunCondition)
+	return condition != nil && condition.Reason != hiveext.ClusterDryRunNoChangesReason
+}
+
+// clearDryRun removes the result of the last dry run once the annotation is removed
+func clearDryRun(clusterInstall *hiveext.AgentClusterInstall) {
+	clusterInstall.Status.DryRun = nil
+	conditions := clusterInstall.Status.Conditions[:0]
+	for _, condition := range clusterInstall.Status.Conditions {
+		if condition.Type != hiveext.ClusterDryRunCondition {
+			conditions = append(conditions, condition)
+		}
+	}
+	clusterInstall.Status.Conditions = conditions
+}
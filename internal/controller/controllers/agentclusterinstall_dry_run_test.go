@@ -0,0 +1,253 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("AgentClusterInstall dry run", func() {
+	var (
+		c                       client.Client
+		cr                      *ClusterDeploymentsReconciler
+		ctx                     = context.Background()
+		mockCtrl                *gomock.Controller
+		mockInstallerInternal   *bminventory.MockInstallerInternals
+		mockClusterApi          *cluster.MockAPI
+		mockVersions            *versions.MockHandler
+		mockMirrorRegistries    *mirrorregistries.MockServiceMirrorRegistriesConfigBuilder
+		clusterName             = "test-cluster"
+		agentClusterInstallName = "test-cluster-aci"
+		pullSecretName          = "pull-secret"
+		imageSetName            = "openshift-v4.8.0"
+		releaseImageUrl         = "quay.io/openshift-release-dev/ocp-release:4.8.0-x86_64"
+		ocpVersion              = "4.8"
+		aci                     *hiveext.AgentClusterInstall
+		cd                      *hivev1.ClusterDeployment
+		backEndCluster          *common.Cluster
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithStatusSubresource(&hiveext.AgentClusterInstall{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockInstallerInternal = bminventory.NewMockInstallerInternals(mockCtrl)
+		mockClusterApi = cluster.NewMockAPI(mockCtrl)
+		mockVersions = versions.NewMockHandler(mockCtrl)
+		mockMirrorRegistries = mirrorregistries.NewMockServiceMirrorRegistriesConfigBuilder(mockCtrl)
+		cr = &ClusterDeploymentsReconciler{
+			Client:                        c,
+			APIReader:                     c,
+			Scheme:                        scheme.Scheme,
+			Log:                           common.GetTestLog(),
+			Installer:                     mockInstallerInternal,
+			ClusterApi:                    mockClusterApi,
+			PullSecretHandler:             NewPullSecretHandler(c, c, mockInstallerInternal),
+			VersionsHandler:               mockVersions,
+			MirrorRegistriesConfigBuilder: mockMirrorRegistries,
+		}
+		mockMirrorRegistries.EXPECT().IsMirrorRegistriesConfigured().AnyTimes().Return(false)
+		mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockVersions.EXPECT().GetReleaseImageByURL(gomock.Any(), gomock.Any(), gomock.Any()).Return(&models.ReleaseImage{
+			CPUArchitecture:  &common.TestDefaultConfig.CPUArchitecture,
+			OpenshiftVersion: &ocpVersion,
+			URL:              &releaseImageUrl,
+			Version:          swag.String("4.8.0"),
+		}, nil).AnyTimes()
+		mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil).AnyTimes()
+
+		cd = newClusterDeployment(clusterName, testNamespace,
+			getDefaultClusterDeploymentSpec(clusterName, agentClusterInstallName, pullSecretName))
+		Expect(c.Create(ctx, cd)).To(Succeed())
+		aci = newAgentClusterInstall(agentClusterInstallName, testNamespace, getDefaultAgentClusterInstallSpec(clusterName), cd)
+		aci.Annotations = map[string]string{AgentClusterInstallDryRunAnnotation: "true"}
+		Expect(c.Create(ctx, aci)).To(Succeed())
+		Expect(c.Create(ctx, getDefaultTestPullSecret(pullSecretName, testNamespace))).To(Succeed())
+		Expect(c.Create(ctx, getDefaultTestImageSet(imageSetName, releaseImageUrl))).To(Succeed())
+
+		id := strfmt.UUID(uuid.New().String())
+		backEndCluster = &common.Cluster{
+			Cluster: models.Cluster{
+				ID:                &id,
+				Name:              clusterName,
+				OpenshiftVersion:  ocpVersion,
+				ClusterNetworks:   clusterNetworksEntriesToArray(aci.Spec.Networking.ClusterNetwork),
+				ServiceNetworks:   serviceNetworksEntriesToArray(aci.Spec.Networking.ServiceNetwork),
+				NetworkType:       swag.String(models.ClusterNetworkTypeOpenShiftSDN),
+				Status:            swag.String(models.ClusterStatusInsufficient),
+				APIVips:           common.TestIPv4Networking.APIVips,
+				IngressVips:       common.TestIPv4Networking.IngressVips,
+				BaseDNSDomain:     cd.Spec.BaseDomain,
+				SSHPublicKey:      aci.Spec.SSHPublicKey,
+				Hyperthreading:    models.ClusterHyperthreadingAll,
+				Kind:              swag.String(models.ClusterKindCluster),
+				ControlPlaneCount: int64(aci.Spec.ProvisionRequirements.ControlPlaneAgents),
+			},
+			PullSecret: testPullSecretVal,
+		}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	getClusterInstall := func() *hiveext.AgentClusterInstall {
+		clusterInstall := &hiveext.AgentClusterInstall{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: agentClusterInstallName}, clusterInstall)).To(Succeed())
+		return clusterInstall
+	}
+
+	reconcile := func() {
+		result, err := cr.Reconcile(ctx, newClusterDeploymentRequest(cd))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	}
+
+	It("publishes the changes without updating the cluster", func() {
+		backEndCluster.Name = "old-name"
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+		mockInstallerInternal.EXPECT().ValidateClusterUpdateInternal(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params installer.V2UpdateClusterParams) error {
+				Expect(params.ClusterID).To(Equal(*backEndCluster.ID))
+				Expect(swag.StringValue(params.ClusterUpdateParams.Name)).To(Equal(clusterName))
+				return nil
+			})
+
+		reconcile()
+
+		clusterInstall := getClusterInstall()
+		Expect(clusterInstall.Status.DryRun).ToNot(BeNil())
+		Expect(clusterInstall.Status.DryRun.Changes).To(ConsistOf(hiveext.DryRunChange{
+			Field:   "name",
+			Current: `"old-name"`,
+			Desired: `"test-cluster"`,
+		}))
+		Expect(clusterInstall.Status.DryRun.ValidationError).To(BeEmpty())
+		condition := FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterDryRunCondition)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(hiveext.ClusterDryRunChangesPendingReason))
+		condition = FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterSpecSyncedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(hiveext.ClusterDryRunReason))
+	})
+
+	It("reports the validation failures", func() {
+		backEndCluster.Name = "old-name"
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+		mockInstallerInternal.EXPECT().ValidateClusterUpdateInternal(gomock.Any(), gomock.Any()).
+			Return(common.NewApiError(http.StatusBadRequest, errors.New("cannot use Cluster Managed Networking because it's not compatible with the arm64 architecture")))
+
+		reconcile()
+
+		clusterInstall := getClusterInstall()
+		Expect(clusterInstall.Status.DryRun.ValidationError).To(ContainSubstring("Cluster Managed Networking"))
+		condition := FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterDryRunCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(hiveext.ClusterDryRunValidationFailedReason))
+		Expect(condition.Message).To(ContainSubstring("Cluster Managed Networking"))
+	})
+
+	It("reports no changes when the spec matches the cluster", func() {
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+
+		reconcile()
+
+		clusterInstall := getClusterInstall()
+		Expect(clusterInstall.Status.DryRun.Changes).To(BeEmpty())
+		condition := FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterDryRunCondition)
+		Expect(condition.Reason).To(Equal(hiveext.ClusterDryRunNoChangesReason))
+		condition = FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterSpecSyncedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	})
+
+	It("reports the install config overrides", func() {
+		aci.Annotations[InstallConfigOverrides] = `{"fips": true}`
+		Expect(c.Update(ctx, aci)).To(Succeed())
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+
+		reconcile()
+
+		Expect(getClusterInstall().Status.DryRun.Changes).To(ConsistOf(hiveext.DryRunChange{
+			Field:   "install_config_overrides",
+			Desired: `{"fips": true}`,
+		}))
+	})
+
+	It("doesn't register a new cluster", func() {
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+		reconcile()
+
+		condition := FindStatusCondition(getClusterInstall().Status.Conditions, hiveext.ClusterDryRunCondition)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Reason).To(Equal(hiveext.ClusterDryRunNotRegisteredReason))
+	})
+
+	It("clears the dry run once the annotation is removed", func() {
+		aci = getClusterInstall()
+		delete(aci.Annotations, AgentClusterInstallDryRunAnnotation)
+		Expect(c.Update(ctx, aci)).To(Succeed())
+		aci.Status.DryRun = &hiveext.DryRunStatus{Changes: []hiveext.DryRunChange{{Field: "name"}}}
+		setClusterCondition(&aci.Status.Conditions, hivev1.ClusterInstallCondition{
+			Type:   hiveext.ClusterDryRunCondition,
+			Status: corev1.ConditionTrue,
+			Reason: hiveext.ClusterDryRunChangesPendingReason,
+		})
+		Expect(c.Status().Update(ctx, aci)).To(Succeed())
+		backEndCluster.Name = "old-name"
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+		mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any(), gomock.Any()).Return(backEndCluster, nil)
+		mockInstallerInternal.EXPECT().UpdateClusterInstallConfigInternal(gomock.Any(), gomock.Any()).Return(backEndCluster, nil).AnyTimes()
+		mockClusterApi.EXPECT().IsReadyForInstallation(gomock.Any()).Return(false, "").AnyTimes()
+
+		_, err := cr.Reconcile(ctx, newClusterDeploymentRequest(cd))
+		Expect(err).ToNot(HaveOccurred())
+
+		clusterInstall := getClusterInstall()
+		Expect(clusterInstall.Status.DryRun).To(BeNil())
+		Expect(FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterDryRunCondition)).To(BeNil())
+	})
+})
+
+var _ = Describe("dryRunChanges", func() {
+	It("ignores the zero values absent from the cluster and redacts the pull secret", func() {
+		changes, err := dryRunChanges(&common.Cluster{
+			Cluster:    models.Cluster{Name: "cluster", HTTPProxy: ""},
+			PullSecret: "old",
+		}, &models.V2ClusterUpdateParams{
+			Name:       swag.String("cluster"),
+			HTTPProxy:  swag.String(""),
+			NoProxy:    swag.String(".example.com"),
+			PullSecret: swag.String("new"),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]hiveext.DryRunChange{
+			{Field: "no_proxy", Desired: `".example.com"`},
+			{Field: "pull_secret", Current: `"<redacted>"`, Desired: `"<redacted>"`},
+		}))
+	})
+})
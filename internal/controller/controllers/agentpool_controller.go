@@ -0,0 +1,332 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	AgentPoolFinalizer = "agentpool." + aiv1beta1.Group
+	// AgentPoolLabel is set on the Agents bound by a pool, its value is the name of the pool
+	AgentPoolLabel = "agentpool." + aiv1beta1.Group + "/name"
+)
+
+// AgentPoolReconciler reconciles a AgentPool object
+type AgentPoolReconciler struct {
+	client.Client
+	Log logrus.FieldLogger
+}
+
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agentpools,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agentpools/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agentpools/finalizers,verbs=update
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch;update;patch
+
+func (r *AgentPoolReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := addRequestIdIfNeeded(origCtx)
+	log := logutil.FromContext(ctx, r.Log).WithFields(
+		logrus.Fields{
+			"agent_pool":           req.Name,
+			"agent_pool_namespace": req.Namespace,
+		})
+
+	defer func() {
+		log.Debug("AgentPool Reconcile ended")
+	}()
+
+	log.Debug("AgentPool Reconcile started")
+
+	pool := &aiv1beta1.AgentPool{}
+	if err := r.Get(ctx, req.NamespacedName, pool); err != nil {
+		log.WithError(err).Errorf("Failed to get AgentPool %s", req.NamespacedName)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !pool.DeletionTimestamp.IsZero() {
+		return r.releaseAgents(ctx, log, pool)
+	}
+
+	if !funk.ContainsString(pool.GetFinalizers(), AgentPoolFinalizer) {
+		controllerutil.AddFinalizer(pool, AgentPoolFinalizer)
+		if err := r.Update(ctx, pool); err != nil {
+			log.WithError(err).Errorf("failed to add finalizer %s to resource %s %s", AgentPoolFinalizer, pool.Name, pool.Namespace)
+			return ctrl.Result{}, err
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&pool.Spec.AgentSelector)
+	if err != nil {
+		log.WithError(err).Error("invalid agent selector")
+		setAgentPoolCondition(pool, corev1.ConditionFalse, aiv1beta1.AgentPoolInputErrorReason, fmt.Sprintf("The agent selector is invalid: %s", err))
+		return ctrl.Result{}, r.Status().Update(ctx, pool)
+	}
+
+	agents := aiv1beta1.AgentList{}
+	if err = r.List(ctx, &agents, client.InNamespace(pool.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+	members, removing, released, candidates := splitAgentsByPool(&agents, pool, selector)
+	for _, agent := range released {
+		if err = r.releaseAgent(ctx, log, agent); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	desired := int(pool.Spec.Replicas)
+	switch {
+	case len(members) < desired:
+		count := min(desired-len(members), len(candidates))
+		for _, agent := range candidates[:count] {
+			if err = r.bindAgent(ctx, log, pool, agent); err != nil {
+				return ctrl.Result{}, err
+			}
+			members = append(members, agent)
+		}
+		candidates = candidates[count:]
+	case len(members) > desired:
+		sortAgentsByRemovalOrder(members)
+		for _, agent := range members[desired:] {
+			if err = r.removeAgent(ctx, log, agent); err != nil {
+				return ctrl.Result{}, err
+			}
+			removing = append(removing, agent)
+		}
+		members = members[:desired]
+	}
+
+	return ctrl.Result{}, r.updateAgentPoolStatus(ctx, log, pool, members, removing, candidates)
+}
+
+// splitAgentsByPool returns the Agents bound by the pool, the Agents of the pool whose node is being removed, the
+// Agents of the pool that are no longer bound to a cluster, and the Agents matching the selector that can be bound, in
+// a stable order
+func splitAgentsByPool(agents *aiv1beta1.AgentList, pool *aiv1beta1.AgentPool, selector labels.Selector) (members, removing, released, candidates []*aiv1beta1.Agent) {
+	for i := range agents.Items {
+		agent := &agents.Items[i]
+		if name, ok := agent.GetLabels()[AgentPoolLabel]; ok {
+			if name != pool.Name {
+				continue
+			}
+			switch {
+			case agent.Spec.ClusterDeploymentName == nil:
+				released = append(released, agent)
+			case isNodeRemovalRequested(agent):
+				removing = append(removing, agent)
+			default:
+				members = append(members, agent)
+			}
+			continue
+		}
+		if selector.Matches(labels.Set(agent.GetLabels())) && isAgentAvailableForPool(agent) {
+			candidates = append(candidates, agent)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Name < candidates[j].Name })
+	return members, removing, released, candidates
+}
+
+// isAgentAvailableForPool checks that the Agent isn't used by another cluster, isn't still being removed from a
+// cluster and is ready to be bound
+func isAgentAvailableForPool(agent *aiv1beta1.Agent) bool {
+	return agent.DeletionTimestamp.IsZero() &&
+		agent.Spec.ClusterDeploymentName == nil &&
+		!isNodeRemovalRequested(agent) &&
+		agent.Status.DebugInfo.State == models.HostStatusKnownUnbound
+}
+
+// sortAgentsByRemovalOrder puts first the Agents that should be kept when scaling down: the installed ones, then the
+// others by name. The Agents at the end of the list are removed first, so the installation of the Agents that aren't
+// installed yet is cancelled before removing the nodes of the cluster.
+func sortAgentsByRemovalOrder(agents []*aiv1beta1.Agent) {
+	sort.SliceStable(agents, func(i, j int) bool {
+		iInstalled := conditionsv1.IsStatusConditionTrue(agents[i].Status.Conditions, aiv1beta1.InstalledCondition)
+		jInstalled := conditionsv1.IsStatusConditionTrue(agents[j].Status.Conditions, aiv1beta1.InstalledCondition)
+		if iInstalled != jInstalled {
+			return iInstalled
+		}
+		return agents[i].Name < agents[j].Name
+	})
+}
+
+// bindAgent approves the Agent and binds it to the cluster of the pool, the installation of the cluster or of the day2
+// host then starts as for any other Agent
+func (r *AgentPoolReconciler) bindAgent(ctx context.Context, log logrus.FieldLogger, pool *aiv1beta1.AgentPool, agent *aiv1beta1.Agent) error {
+	log.Infof("Binding agent %s to cluster %s/%s", agent.Name, pool.Spec.ClusterDeploymentName.Namespace, pool.Spec.ClusterDeploymentName.Name)
+	if agent.Labels == nil {
+		agent.Labels = make(map[string]string)
+	}
+	agent.Labels[AgentPoolLabel] = pool.Name
+	agent.Spec.Approved = true
+	agent.Spec.ClusterDeploymentName = &aiv1beta1.ClusterReference{
+		Name:      pool.Spec.ClusterDeploymentName.Name,
+		Namespace: pool.Spec.ClusterDeploymentName.Namespace,
+	}
+	agent.Spec.Role = pool.Spec.Role
+	if agent.Spec.Role == "" {
+		agent.Spec.Role = models.HostRoleWorker
+	}
+	agent.Spec.MachineConfigPool = pool.Spec.MachineConfigPool
+	if err := r.Update(ctx, agent); err != nil {
+		log.WithError(err).Errorf("failed to bind agent %s", agent.Name)
+		return err
+	}
+	return nil
+}
+
+// removeAgent requests the removal of the node of the Agent, see removeAgentNode. The Agent controller drains the node
+// of an installed Agent, removes the etcd member of a control plane node and unbinds the Agent, so that it is reclaimed
+// and can be used by another pool. The Agent keeps the pool label until it is unbound.
+func (r *AgentPoolReconciler) removeAgent(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent) error {
+	log.Infof("Requesting the removal of the node of agent %s", agent.Name)
+	setAnnotation(&agent.ObjectMeta, AgentRemoveNodeAnnotation, "true")
+	if err := r.Update(ctx, agent); err != nil {
+		log.WithError(err).Errorf("failed to request the node removal of agent %s", agent.Name)
+		return err
+	}
+	return nil
+}
+
+// releaseAgent removes the pool label from an Agent of the pool that is no longer bound to a cluster
+func (r *AgentPoolReconciler) releaseAgent(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent) error {
+	log.Infof("Releasing unbound agent %s", agent.Name)
+	delete(agent.Labels, AgentPoolLabel)
+	if err := r.Update(ctx, agent); err != nil {
+		log.WithError(err).Errorf("failed to release agent %s", agent.Name)
+		return err
+	}
+	return nil
+}
+
+// releaseAgents removes the pool label from the Agents of a deleted pool. The Agents stay bound to the cluster, the
+// nodes aren't removed when the pool is deleted.
+func (r *AgentPoolReconciler) releaseAgents(ctx context.Context, log logrus.FieldLogger, pool *aiv1beta1.AgentPool) (ctrl.Result, error) {
+	if !funk.ContainsString(pool.GetFinalizers(), AgentPoolFinalizer) {
+		return ctrl.Result{}, nil
+	}
+	agents := aiv1beta1.AgentList{}
+	if err := r.List(ctx, &agents, client.InNamespace(pool.Namespace), client.MatchingLabels{AgentPoolLabel: pool.Name}); err != nil {
+		return ctrl.Result{}, err
+	}
+	for i := range agents.Items {
+		agent := &agents.Items[i]
+		delete(agent.Labels, AgentPoolLabel)
+		if err := r.Update(ctx, agent); err != nil {
+			log.WithError(err).Errorf("failed to release agent %s", agent.Name)
+			return ctrl.Result{}, err
+		}
+	}
+	controllerutil.RemoveFinalizer(pool, AgentPoolFinalizer)
+	if err := r.Update(ctx, pool); err != nil {
+		log.WithError(err).Errorf("failed to remove finalizer %s from resource %s %s", AgentPoolFinalizer, pool.Name, pool.Namespace)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *AgentPoolReconciler) updateAgentPoolStatus(ctx context.Context, log logrus.FieldLogger, pool *aiv1beta1.AgentPool, members, removing, candidates []*aiv1beta1.Agent) error {
+	var replicas, installed, available int32
+	for _, agent := range members {
+		replicas++
+		if conditionsv1.IsStatusConditionTrue(agent.Status.Conditions, aiv1beta1.InstalledCondition) {
+			installed++
+		}
+	}
+	for range candidates {
+		available++
+	}
+	pool.Status.Replicas = replicas
+	pool.Status.InstalledReplicas = installed
+	pool.Status.AvailableAgents = available
+
+	switch {
+	case pool.Status.Replicas < pool.Spec.Replicas:
+		setAgentPoolCondition(pool, corev1.ConditionFalse, aiv1beta1.AgentPoolMissingReason,
+			fmt.Sprintf("%d of %d agents are bound, waiting for more agents matching the selector", pool.Status.Replicas, pool.Spec.Replicas))
+	case len(removing) > 0:
+		setAgentPoolCondition(pool, corev1.ConditionFalse, aiv1beta1.AgentPoolScalingReason,
+			fmt.Sprintf("%d of %d agents are installed, the nodes of %d agents are being removed", installed, pool.Spec.Replicas, len(removing)))
+	case installed < pool.Spec.Replicas:
+		setAgentPoolCondition(pool, corev1.ConditionFalse, aiv1beta1.AgentPoolScalingReason,
+			fmt.Sprintf("%d of %d agents are installed", installed, pool.Spec.Replicas))
+	default:
+		setAgentPoolCondition(pool, corev1.ConditionTrue, aiv1beta1.AgentPoolScaledReason,
+			fmt.Sprintf("All the %d agents are installed", pool.Spec.Replicas))
+	}
+
+	if err := r.Status().Update(ctx, pool); err != nil {
+		log.WithError(err).Error("failed to update agent pool status")
+		return err
+	}
+	return nil
+}
+
+func setAgentPoolCondition(pool *aiv1beta1.AgentPool, status corev1.ConditionStatus, reason, message string) {
+	conditionsv1.SetStatusConditionNoHeartbeat(&pool.Status.Conditions, conditionsv1.Condition{
+		Type:    aiv1beta1.AgentPoolScaledCondition,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+func (r *AgentPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mapAgentToAgentPool := func(ctx context.Context, agent client.Object) []reconcile.Request {
+		log := logutil.FromContext(ctx, r.Log).WithFields(
+			logrus.Fields{
+				"agent":           agent.GetName(),
+				"agent_namespace": agent.GetNamespace(),
+			})
+		poolList := &aiv1beta1.AgentPoolList{}
+		if err := r.List(ctx, poolList, client.InNamespace(agent.GetNamespace())); err != nil {
+			log.Debugf("failed to list agent pools")
+			return []reconcile.Request{}
+		}
+
+		reply := make([]reconcile.Request, 0, len(poolList.Items))
+		for _, pool := range poolList.Items {
+			reply = append(reply, reconcile.Request{NamespacedName: types.NamespacedName{
+				Namespace: pool.Namespace,
+				Name:      pool.Name,
+			}})
+		}
+		return reply
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aiv1beta1.AgentPool{}).
+		Watches(&aiv1beta1.Agent{}, handler.EnqueueRequestsFromMapFunc(mapAgentToAgentPool)).
+		Complete(r)
+}
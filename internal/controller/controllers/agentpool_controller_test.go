@@ -0,0 +1,239 @@
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newPoolAgent(name, namespace, state string, labels map[string]string) *v1beta1.Agent {
+	return &v1beta1.Agent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Status: v1beta1.AgentStatus{
+			DebugInfo: v1beta1.DebugInfo{State: state},
+		},
+	}
+}
+
+var _ = Describe("AgentPool reconcile", func() {
+	var (
+		c           client.Client
+		pr          *AgentPoolReconciler
+		ctx         = context.Background()
+		poolName    = "workers"
+		poolLabels  = map[string]string{"size": "large"}
+		clusterName = v1beta1.ClusterReference{Name: "test-cluster", Namespace: "test-cluster-namespace"}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithStatusSubresource(&v1beta1.AgentPool{}, &v1beta1.Agent{}).Build()
+		pr = &AgentPoolReconciler{
+			Client: c,
+			Log:    common.GetTestLog(),
+		}
+	})
+
+	createPool := func(replicas int32) {
+		pool := &v1beta1.AgentPool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      poolName,
+				Namespace: testNamespace,
+			},
+			Spec: v1beta1.AgentPoolSpec{
+				ClusterDeploymentName: clusterName,
+				AgentSelector:         metav1.LabelSelector{MatchLabels: poolLabels},
+				Replicas:              replicas,
+				MachineConfigPool:     "large-workers",
+			},
+		}
+		Expect(c.Create(ctx, pool)).To(Succeed())
+	}
+
+	getPool := func() *v1beta1.AgentPool {
+		pool := &v1beta1.AgentPool{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: poolName}, pool)).To(Succeed())
+		return pool
+	}
+
+	getAgent := func(name string) *v1beta1.Agent {
+		agent := &v1beta1.Agent{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: name}, agent)).To(Succeed())
+		return agent
+	}
+
+	setReplicas := func(replicas int32) {
+		pool := getPool()
+		pool.Spec.Replicas = replicas
+		Expect(c.Update(ctx, pool)).To(Succeed())
+	}
+
+	reconcilePool := func() {
+		result, err := pr.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: poolName}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	}
+
+	expectBound := func(name string) {
+		agent := getAgent(name)
+		Expect(agent.Labels).To(HaveKeyWithValue(AgentPoolLabel, poolName))
+		Expect(agent.Spec.Approved).To(BeTrue())
+		Expect(agent.Spec.ClusterDeploymentName).To(Equal(&clusterName))
+		Expect(agent.Spec.Role).To(Equal(models.HostRoleWorker))
+		Expect(agent.Spec.MachineConfigPool).To(Equal("large-workers"))
+	}
+
+	expectNotBound := func(name string) {
+		agent := getAgent(name)
+		Expect(agent.Labels).ToNot(HaveKey(AgentPoolLabel))
+		Expect(agent.Spec.ClusterDeploymentName).To(BeNil())
+	}
+
+	It("binds the available agents matching the selector", func() {
+		createPool(2)
+		Expect(c.Create(ctx, newPoolAgent("agent1", testNamespace, models.HostStatusKnownUnbound, poolLabels))).To(Succeed())
+		Expect(c.Create(ctx, newPoolAgent("agent2", testNamespace, models.HostStatusDiscoveringUnbound, poolLabels))).To(Succeed())
+		Expect(c.Create(ctx, newPoolAgent("agent3", testNamespace, models.HostStatusKnownUnbound, map[string]string{"size": "small"}))).To(Succeed())
+		Expect(c.Create(ctx, newPoolAgent("agent4", testNamespace, models.HostStatusKnownUnbound,
+			map[string]string{"size": "large", AgentPoolLabel: "other-pool"}))).To(Succeed())
+
+		reconcilePool()
+
+		expectBound("agent1")
+		expectNotBound("agent2")
+		expectNotBound("agent3")
+		Expect(getAgent("agent4").Labels).To(HaveKeyWithValue(AgentPoolLabel, "other-pool"))
+
+		pool := getPool()
+		Expect(pool.GetFinalizers()).To(ContainElement(AgentPoolFinalizer))
+		Expect(pool.Status.Replicas).To(BeEquivalentTo(1))
+		Expect(pool.Status.AvailableAgents).To(BeEquivalentTo(0))
+		condition := conditionsv1.FindStatusCondition(pool.Status.Conditions, v1beta1.AgentPoolScaledCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.AgentPoolMissingReason))
+
+		// The second agent is bound once it is discovered
+		agent := getAgent("agent2")
+		agent.Status.DebugInfo.State = models.HostStatusKnownUnbound
+		Expect(c.Status().Update(ctx, agent)).To(Succeed())
+		reconcilePool()
+		expectBound("agent2")
+
+		pool = getPool()
+		Expect(pool.Status.Replicas).To(BeEquivalentTo(2))
+		condition = conditionsv1.FindStatusCondition(pool.Status.Conditions, v1beta1.AgentPoolScaledCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.AgentPoolScalingReason))
+	})
+
+	It("reports the pool as scaled once the agents are installed", func() {
+		createPool(1)
+		Expect(c.Create(ctx, newPoolAgent("agent1", testNamespace, models.HostStatusKnownUnbound, poolLabels))).To(Succeed())
+		reconcilePool()
+
+		agent := getAgent("agent1")
+		conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, conditionsv1.Condition{
+			Type:   v1beta1.InstalledCondition,
+			Status: corev1.ConditionTrue,
+		})
+		Expect(c.Status().Update(ctx, agent)).To(Succeed())
+		reconcilePool()
+
+		pool := getPool()
+		Expect(pool.Status.InstalledReplicas).To(BeEquivalentTo(1))
+		condition := conditionsv1.FindStatusCondition(pool.Status.Conditions, v1beta1.AgentPoolScaledCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(v1beta1.AgentPoolScaledReason))
+	})
+
+	It("removes the nodes of the agents that aren't installed first when scaling down", func() {
+		createPool(3)
+		for _, name := range []string{"agent1", "agent2", "agent3"} {
+			Expect(c.Create(ctx, newPoolAgent(name, testNamespace, models.HostStatusKnownUnbound, poolLabels))).To(Succeed())
+		}
+		reconcilePool()
+
+		agent := getAgent("agent3")
+		conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, conditionsv1.Condition{
+			Type:   v1beta1.InstalledCondition,
+			Status: corev1.ConditionTrue,
+		})
+		Expect(c.Status().Update(ctx, agent)).To(Succeed())
+
+		setReplicas(1)
+		reconcilePool()
+
+		// The Agent controller removes the nodes, the agents stay in the pool until they are unbound
+		for _, name := range []string{"agent1", "agent2"} {
+			expectBound(name)
+			Expect(getAgent(name).Annotations).To(HaveKeyWithValue(AgentRemoveNodeAnnotation, "true"))
+		}
+		Expect(getAgent("agent3").Annotations).ToNot(HaveKey(AgentRemoveNodeAnnotation))
+		pool := getPool()
+		Expect(pool.Status.Replicas).To(BeEquivalentTo(1))
+		condition := conditionsv1.FindStatusCondition(pool.Status.Conditions, v1beta1.AgentPoolScaledCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.AgentPoolScalingReason))
+
+		// No other agent is removed while the nodes are being removed
+		reconcilePool()
+		Expect(getAgent("agent3").Annotations).ToNot(HaveKey(AgentRemoveNodeAnnotation))
+
+		for _, name := range []string{"agent1", "agent2"} {
+			agent = getAgent(name)
+			agent.Spec.ClusterDeploymentName = nil
+			Expect(c.Update(ctx, agent)).To(Succeed())
+		}
+		reconcilePool()
+
+		expectNotBound("agent1")
+		expectNotBound("agent2")
+		expectBound("agent3")
+		pool = getPool()
+		Expect(pool.Status.Replicas).To(BeEquivalentTo(1))
+		Expect(pool.Status.AvailableAgents).To(BeEquivalentTo(0))
+		condition = conditionsv1.FindStatusCondition(pool.Status.Conditions, v1beta1.AgentPoolScaledCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	})
+
+	It("reports an invalid selector as an input error", func() {
+		createPool(1)
+		pool := getPool()
+		pool.Spec.AgentSelector = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "size", Operator: "Unknown"},
+		}}
+		Expect(c.Update(ctx, pool)).To(Succeed())
+		reconcilePool()
+
+		condition := conditionsv1.FindStatusCondition(getPool().Status.Conditions, v1beta1.AgentPoolScaledCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.AgentPoolInputErrorReason))
+	})
+
+	It("releases the agents without unbinding them when deleted", func() {
+		createPool(1)
+		Expect(c.Create(ctx, newPoolAgent("agent1", testNamespace, models.HostStatusKnownUnbound, poolLabels))).To(Succeed())
+		reconcilePool()
+
+		Expect(c.Delete(ctx, getPool())).To(Succeed())
+		reconcilePool()
+
+		agent := getAgent("agent1")
+		Expect(agent.Labels).ToNot(HaveKey(AgentPoolLabel))
+		Expect(agent.Spec.ClusterDeploymentName).To(Equal(&clusterName))
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: poolName}, &v1beta1.AgentPool{})).ToNot(Succeed())
+	})
+})
@@ -70,6 +70,7 @@ type BMACReconciler struct {
 	Scheme                *runtime.Scheme
 	Installer             bminventory.InstallerInternals
 	SpokeK8sClientFactory spoke_k8s_client.SpokeK8sClientFactory
+	SpokeClients          SpokeClientCache
 	spokeClient           client.Client
 	ConvergedFlowEnabled  bool
 	PauseProvisionedBMHs  bool
@@ -1675,7 +1676,7 @@ func (r *BMACReconciler) getSpokeClient(clusterDeployment *hivev1.ClusterDeploym
 	if r.spokeClient != nil {
 		return r.spokeClient, err
 	}
-	return r.SpokeK8sClientFactory.CreateFromSecret(clusterDeployment, secret)
+	return r.SpokeClients.Get(clusterDeployment, secret)
 }
 
 // Returns a list of BMH ReconcileRequests for a given Agent
@@ -1984,6 +1985,7 @@ func (r *BMACReconciler) drainAgentNode(ctx context.Context, log logrus.FieldLog
 		log.WithError(err).Error("failed to create spoke client")
 		return false, err
 	}
+	defer client.Close()
 
 	nodeName := getAgentHostname(agent)
 	node, err := client.GetNode(ctx, nodeName)
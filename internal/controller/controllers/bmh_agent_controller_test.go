@@ -3123,6 +3123,7 @@ var _ = Describe("handleBMHFinalizer", func() {
 			PauseProvisionedBMHs:  true,
 			Drainer:               mockDrainer,
 			SpokeK8sClientFactory: mockClientFactory,
+			SpokeClients:          NewSpokeClientCache(common.GetTestLog(), mockClientFactory, SpokeClientCacheConfig{}, nil),
 		}
 		bmh = newBMH("testBMH", &bmh_v1alpha1.BareMetalHostSpec{})
 		bmh.Labels = map[string]string{BMH_INFRA_ENV_LABEL: "testInfraEnv"}
@@ -3301,6 +3302,7 @@ var _ = Describe("handleBMHFinalizer", func() {
 						return mockSpokeClient, clientset, nil
 					},
 				)
+				mockSpokeClient.EXPECT().Close().AnyTimes()
 			}
 
 			validateStartAnnotation := func(annotations map[string]string) {
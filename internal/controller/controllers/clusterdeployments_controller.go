@@ -90,6 +90,7 @@ const (
 const HighAvailabilityModeNone = "None"
 const defaultRequeueAfterOnError = 10 * time.Second
 const longerRequeueAfterOnError = 1 * time.Minute
+const spokeAPIUnreachableRequeueAfter = 1 * time.Minute
 
 // from https://github.com/openshift/hive/blob/04f2f4f8768b4d8aa413feb5dc5410b5f6e3dcfa/pkg/constants/constants.go#L153
 // not importing this code because it will create a lot of vendoring issues
@@ -117,6 +118,9 @@ type ClusterDeploymentsReconciler struct {
 	VersionsHandler               versions.Handler
 	SpokeK8sClientFactory         spoke_k8s_client.SpokeK8sClientFactory
 	MirrorRegistriesConfigBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder
+
+	// SpokeClients caches the clients of the installed clusters, also used to check the health of their API
+	SpokeClients SpokeClientCache
 }
 
 const minimalOpenShiftVersionForDefaultNetworkTypeOVNKubernetes = "4.12.0-0.0"
@@ -229,11 +233,7 @@ func (r *ClusterDeploymentsReconciler) Reconcile(origCtx context.Context, req ct
 
 	cluster, err := r.Installer.GetClusterByKubeKey(req.NamespacedName)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		if !isInstalled(clusterDeployment, clusterInstall) {
-			return r.createNewCluster(ctx, log, req.NamespacedName, pullSecret, releaseImage, clusterDeployment, clusterInstall, mirrorRegistryConfiguration)
-		}
-
-		return r.createNewDay2Cluster(ctx, log, req.NamespacedName, releaseImage, clusterDeployment, clusterInstall)
+		return r.registerCluster(ctx, log, req.NamespacedName, pullSecret, releaseImage, clusterDeployment, clusterInstall, mirrorRegistryConfiguration)
 	}
 	if err != nil {
 		return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
@@ -243,6 +243,11 @@ func (r *ClusterDeploymentsReconciler) Reconcile(origCtx context.Context, req ct
 		return ctrl.Result{}, fmt.Errorf("cluster associated with namespace '%s', name '%s' is missing ID", req.Namespace, req.Name)
 	}
 
+	if isDryRunRequested(clusterInstall) {
+		return r.dryRun(ctx, log, clusterDeployment, clusterInstall, cluster)
+	}
+	clearDryRun(clusterInstall)
+
 	// check for updates from user, compare spec and update if needed
 	cluster, err = r.updateIfNeeded(ctx, log, clusterDeployment, clusterInstall, cluster, mirrorRegistryConfiguration)
 	if err != nil {
@@ -282,6 +287,20 @@ func (r *ClusterDeploymentsReconciler) Reconcile(origCtx context.Context, req ct
 	return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, nil)
 }
 
+// registerCluster registers the cluster of the ClusterDeployment, as a day2 cluster when it is already installed
+func (r *ClusterDeploymentsReconciler) registerCluster(ctx context.Context, log logrus.FieldLogger, key types.NamespacedName,
+	pullSecret string, releaseImage *models.ReleaseImage, clusterDeployment *hivev1.ClusterDeployment,
+	clusterInstall *hiveext.AgentClusterInstall, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (ctrl.Result, error) {
+	if isDryRunRequested(clusterInstall) {
+		return r.dryRunNotRegistered(ctx, log, clusterDeployment, clusterInstall)
+	}
+	if !isInstalled(clusterDeployment, clusterInstall) {
+		return r.createNewCluster(ctx, log, key, pullSecret, releaseImage, clusterDeployment, clusterInstall, mirrorRegistryConfiguration)
+	}
+
+	return r.createNewDay2Cluster(ctx, log, key, releaseImage, clusterDeployment, clusterInstall)
+}
+
 func (r *ClusterDeploymentsReconciler) getPullSecret(ctx context.Context, clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall) (string, error) {
 	if clusterDeployment.Spec.PullSecretRef == nil || clusterDeployment.Spec.PullSecretRef.Name == "" {
 		// Pull secret is not required for already installed clusters
@@ -471,6 +490,14 @@ func (r *ClusterDeploymentsReconciler) installDay1(ctx context.Context, log logr
 }
 
 func (r *ClusterDeploymentsReconciler) spokeKubeClient(ctx context.Context, clusterDeployment *hivev1.ClusterDeployment) (spoke_k8s_client.SpokeK8sClient, error) {
+	secret, err := r.adminKubeConfigSecret(ctx, clusterDeployment)
+	if err != nil {
+		return nil, err
+	}
+	return r.SpokeClients.Get(clusterDeployment, secret)
+}
+
+func (r *ClusterDeploymentsReconciler) adminKubeConfigSecret(ctx context.Context, clusterDeployment *hivev1.ClusterDeployment) (*corev1.Secret, error) {
 	adminKubeConfigSecretName := getClusterDeploymentAdminKubeConfigSecretName(clusterDeployment)
 
 	namespacedName := types.NamespacedName{
@@ -487,7 +514,23 @@ func (r *ClusterDeploymentsReconciler) spokeKubeClient(ctx context.Context, clus
 		r.Log.WithError(err).Errorf("failed to label kubeconfig secret %s", namespacedName)
 		return nil, err
 	}
-	return r.SpokeK8sClientFactory.CreateFromSecret(clusterDeployment, secret)
+	return secret, nil
+}
+
+// checkSpokeAPIReachable sets the SpokeAPIReachable condition of an installed cluster, and returns false when its API
+// is unreachable. The condition is informative, the day2 hosts are installed anyway.
+func (r *ClusterDeploymentsReconciler) checkSpokeAPIReachable(ctx context.Context, log logrus.FieldLogger,
+	clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall) bool {
+	secret, err := r.adminKubeConfigSecret(ctx, clusterDeployment)
+	if err == nil {
+		err = r.SpokeClients.CheckHealth(ctx, clusterDeployment, secret)
+	}
+	clusterSpokeAPIReachable(clusterInstall, err)
+	if err != nil {
+		log.WithError(err).Warnf("The API of cluster deployment %s/%s is unreachable", clusterDeployment.Namespace, clusterDeployment.Name)
+		return false
+	}
+	return true
 }
 
 func (r *ClusterDeploymentsReconciler) updateWorkerMcpPaused(ctx context.Context, log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall, clusterDeployment *hivev1.ClusterDeployment) error {
@@ -525,12 +568,22 @@ func (r *ClusterDeploymentsReconciler) updateWorkerMcpPaused(ctx context.Context
 }
 
 func (r *ClusterDeploymentsReconciler) installDay2Hosts(ctx context.Context, log logrus.FieldLogger, clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall, cluster *common.Cluster) (ctrl.Result, error) {
+	spokeAPIReachable := r.checkSpokeAPIReachable(ctx, log, clusterDeployment, clusterInstall)
 	hosts, err := r.Installer.GetKnownApprovedHosts(*cluster.ID)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get ready and approved hosts for cluster %s", cluster.ID.String())
 		return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
 	}
+	waitingAgents, err := r.agentsWaitingForControlPlaneReplacement(ctx, clusterDeployment)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get the agents replacing control plane nodes of cluster %s", cluster.ID.String())
+		return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
+	}
 	for _, h := range hosts {
+		if waitingAgents[h.ID.String()] {
+			log.Infof("Day2 host %s waits for the removal of the control plane node it replaces", *h.ID)
+			continue
+		}
 		log.Infof("Installing Day2 host %s in %s %s", *h.ID, clusterDeployment.Name, clusterDeployment.Namespace)
 		err = r.Installer.InstallSingleDay2HostInternal(ctx, *cluster.ID, h.InfraEnvID, *h.ID)
 		if err != nil {
@@ -538,7 +591,32 @@ func (r *ClusterDeploymentsReconciler) installDay2Hosts(ctx context.Context, log
 		}
 	}
 	err = r.updateWorkerMcpPaused(ctx, log, clusterInstall, clusterDeployment)
-	return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
+	result, err := r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
+	if err == nil && result.IsZero() && !spokeAPIReachable {
+		// refresh the SpokeAPIReachable condition until the API is reachable again
+		result.RequeueAfter = spokeAPIUnreachableRequeueAfter
+	}
+	return result, err
+}
+
+// agentsWaitingForControlPlaneReplacement returns the names of the agents of the cluster deployment that must not be
+// installed until the control plane node they replace is removed
+func (r *ClusterDeploymentsReconciler) agentsWaitingForControlPlaneReplacement(ctx context.Context, clusterDeployment *hivev1.ClusterDeployment) (map[string]bool, error) {
+	agents := &aiv1beta1.AgentList{}
+	if err := r.List(ctx, agents); err != nil {
+		return nil, err
+	}
+	waiting := make(map[string]bool)
+	for i := range agents.Items {
+		agent := &agents.Items[i]
+		if agent.Spec.ClusterDeploymentName != nil &&
+			agent.Spec.ClusterDeploymentName.Name == clusterDeployment.Name &&
+			agent.Spec.ClusterDeploymentName.Namespace == clusterDeployment.Namespace &&
+			isWaitingForControlPlaneReplacement(agent) {
+			waiting[agent.Name] = true
+		}
+	}
+	return waiting, nil
 }
 
 func (r *ClusterDeploymentsReconciler) createNoIngressKubeConfig(ctx context.Context, log logrus.FieldLogger, cluster *hivev1.ClusterDeployment, c *common.Cluster, clusterInstall *hiveext.AgentClusterInstall) error {
@@ -1083,6 +1161,38 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(
 	cluster *common.Cluster,
 	mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, error) {
 
+	params, update, err := r.clusterUpdateParams(ctx, log, clusterDeployment, clusterInstall, cluster)
+	if err != nil {
+		return cluster, err
+	}
+	if !update {
+		return cluster, nil
+	}
+
+	var clusterAfterUpdate *common.Cluster
+
+	clusterAfterUpdate, err = r.Installer.UpdateClusterNonInteractive(ctx, installer.V2UpdateClusterParams{
+		ClusterUpdateParams: params,
+		ClusterID:           *cluster.ID,
+	}, mirrorRegistryConfiguration)
+	if err != nil {
+		return cluster, err
+	}
+
+	log.Infof("Updated clusterDeployment %s/%s", clusterDeployment.Namespace, clusterDeployment.Name)
+
+	return clusterAfterUpdate, nil
+}
+
+// clusterUpdateParams compares the spec with the cluster and returns the params of the update, and whether the
+// cluster needs to be updated
+func (r *ClusterDeploymentsReconciler) clusterUpdateParams(
+	ctx context.Context,
+	log logrus.FieldLogger,
+	clusterDeployment *hivev1.ClusterDeployment,
+	clusterInstall *hiveext.AgentClusterInstall,
+	cluster *common.Cluster) (*models.V2ClusterUpdateParams, bool, error) {
+
 	update := false
 	params := &models.V2ClusterUpdateParams{}
 
@@ -1099,7 +1209,7 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(
 
 	shouldUpdateNetworkParams, err := r.updateNetworkParams(clusterDeployment, clusterInstall, cluster, params)
 	if err != nil {
-		return cluster, errors.Wrap(err, "failed to update network params")
+		return nil, false, errors.Wrap(err, "failed to update network params")
 	}
 	update = swag.BoolValue(shouldUpdateNetworkParams) || update
 	// Trim key before comparing as done in RegisterClusterInternal
@@ -1109,13 +1219,13 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(
 	// Update ignition endpoint if needed
 	shouldUpdate, err := r.updateIgnitionInUpdateParams(ctx, log, clusterInstall, cluster, params)
 	if err != nil {
-		return cluster, errors.Wrap(err, "Couldn't resolve clusterdeployment ignition fields")
+		return nil, false, errors.Wrap(err, "Couldn't resolve clusterdeployment ignition fields")
 	}
 	update = shouldUpdate || update
 
 	pullSecretData, err := r.PullSecretHandler.GetValidPullSecret(ctx, getPullSecretKey(clusterDeployment.Namespace, spec.PullSecretRef))
 	if err != nil {
-		return cluster, errors.Wrap(err, "failed to get pull secret for update")
+		return nil, false, errors.Wrap(err, "failed to get pull secret for update")
 	}
 
 	if pullSecretData != cluster.PullSecret {
@@ -1133,7 +1243,7 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(
 	//update platform
 	platform, err := getPlatform(clusterInstall.Spec)
 	if err != nil {
-		return cluster, err
+		return nil, false, err
 	}
 	if cluster.Platform != nil && platform != nil && *cluster.Platform.Type != *platform.Type {
 		params.Platform = platform
@@ -1178,23 +1288,7 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(
 		update = true
 	}
 
-	if !update {
-		return cluster, nil
-	}
-
-	var clusterAfterUpdate *common.Cluster
-
-	clusterAfterUpdate, err = r.Installer.UpdateClusterNonInteractive(ctx, installer.V2UpdateClusterParams{
-		ClusterUpdateParams: params,
-		ClusterID:           *cluster.ID,
-	}, mirrorRegistryConfiguration)
-	if err != nil {
-		return cluster, err
-	}
-
-	log.Infof("Updated clusterDeployment %s/%s", clusterDeployment.Namespace, clusterDeployment.Name)
-
-	return clusterAfterUpdate, nil
+	return params, update, nil
 }
 
 func selectClusterNetworkType(params *models.V2ClusterUpdateParams, cluster *common.Cluster) (*string, error) {
@@ -2045,7 +2139,11 @@ func clusterSpecSynced(cluster *hiveext.AgentClusterInstall, syncErr error) {
 	var condStatus corev1.ConditionStatus
 	var reason string
 	var msg string
-	if syncErr == nil {
+	if syncErr == nil && isSpecPendingDryRun(cluster) {
+		condStatus = corev1.ConditionFalse
+		reason = hiveext.ClusterDryRunReason
+		msg = hiveext.ClusterDryRunMsg
+	} else if syncErr == nil {
 		condStatus = corev1.ConditionTrue
 		reason = hiveext.ClusterSyncedOkReason
 		msg = hiveext.ClusterSyncedOkReason
@@ -2067,6 +2165,21 @@ func clusterSpecSynced(cluster *hiveext.AgentClusterInstall, syncErr error) {
 	})
 }
 
+func clusterSpokeAPIReachable(clusterInstall *hiveext.AgentClusterInstall, healthErr error) {
+	condition := hivev1.ClusterInstallCondition{
+		Type:    hiveext.ClusterSpokeAPIReachableCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  hiveext.ClusterSpokeAPIReachableReason,
+		Message: hiveext.ClusterSpokeAPIReachableMsg,
+	}
+	if healthErr != nil {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = hiveext.ClusterSpokeAPIUnreachableReason
+		condition.Message = hiveext.ClusterSpokeAPIUnreachableMsg + " " + healthErr.Error()
+	}
+	setClusterCondition(&clusterInstall.Status.Conditions, condition)
+}
+
 func clusterRequirementsMet(
 	clusterInstall *hiveext.AgentClusterInstall,
 	status string,
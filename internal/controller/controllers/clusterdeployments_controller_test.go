@@ -33,6 +33,7 @@ import (
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	"github.com/openshift/hive/apis/hive/v1/aws"
 	"github.com/pkg/errors"
@@ -2021,6 +2022,22 @@ var _ = Describe("cluster reconcile", func() {
 			backEndCluster *common.Cluster
 		)
 
+		// mockSpokeAPIReachable makes the API of the installed cluster reachable for the day2 installations
+		mockSpokeAPIReachable := func() {
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf(adminKubeConfigStringTemplate, cluster.Name),
+					Namespace: testNamespace,
+				},
+				Data: map[string][]byte{
+					"kubeconfig": []byte("somekubeconfig"),
+				},
+			})).To(Succeed())
+			mockSpokeClients := NewMockSpokeClientCache(mockCtrl)
+			mockSpokeClients.EXPECT().CheckHealth(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			cr.SpokeClients = mockSpokeClients
+		}
+
 		BeforeEach(func() {
 			pullSecret := getDefaultTestPullSecret("pull-secret", testNamespace)
 			Expect(c.Create(ctx, pullSecret)).To(BeNil())
@@ -2828,6 +2845,7 @@ var _ = Describe("cluster reconcile", func() {
 		})
 
 		It("install day2 host", func() {
+			mockSpokeAPIReachable()
 			openshiftID := strfmt.UUID(uuid.New().String())
 			backEndCluster.Status = swag.String(models.ClusterStatusInstalled)
 			backEndCluster.OpenshiftClusterID = openshiftID
@@ -2865,6 +2883,52 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterRequirementsMetCondition).Status).To(Equal(corev1.ConditionTrue))
 		})
 
+		It("doesn't install a day2 host replacing a control plane node that wasn't removed", func() {
+			mockSpokeAPIReachable()
+			backEndCluster.Status = swag.String(models.ClusterStatusAddingHosts)
+			backEndCluster.OpenshiftClusterID = strfmt.UUID(uuid.New().String())
+			backEndCluster.Kind = swag.String(models.ClusterKindAddHostsCluster)
+			id := strfmt.UUID(uuid.New().String())
+			h := &models.Host{
+				ID:     &id,
+				Status: swag.String(models.HostStatusKnown),
+			}
+			agent := newAgent(id.String(), testNamespace, aiv1beta1.AgentSpec{
+				ClusterDeploymentName: &aiv1beta1.ClusterReference{Name: cluster.Name, Namespace: cluster.Namespace},
+				Role:                  models.HostRoleMaster,
+			})
+			agent.Annotations = map[string]string{AgentReplacesNodeAnnotation: "master-0"}
+			Expect(c.Create(ctx, agent)).To(Succeed())
+
+			backEndCluster.Hosts = []*models.Host{h}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(1)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockInstallerInternal.EXPECT().GetKnownApprovedHosts(gomock.Any()).Return([]*common.Host{{Host: *h}}, nil)
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any(), gomock.Any()).Return(backEndCluster, nil)
+
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			By("installs the host once the replaced node was removed")
+			conditionsv1.SetStatusConditionNoHeartbeat(&agent.Status.Conditions, conditionsv1.Condition{
+				Type:   aiv1beta1.ControlPlaneReplacedCondition,
+				Status: corev1.ConditionFalse,
+				Reason: aiv1beta1.ReadyForReplacementReason,
+			})
+			Expect(c.Update(ctx, agent)).To(Succeed())
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(1)
+			mockInstallerInternal.EXPECT().GetKnownApprovedHosts(gomock.Any()).Return([]*common.Host{{Host: *h}}, nil)
+			mockInstallerInternal.EXPECT().InstallSingleDay2HostInternal(gomock.Any(), gomock.Any(), gomock.Any(), id).Return(nil)
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any(), gomock.Any()).Return(backEndCluster, nil)
+			result, err = cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
 		It("install failure day2 host", func() {
 			openshiftID := strfmt.UUID(uuid.New().String())
 			backEndCluster.Status = swag.String(models.ClusterStatusInstalled)
@@ -4277,12 +4341,88 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterSpecSyncedCondition).Reason).To(Equal(hiveext.ClusterBackendErrorReason))
 		})
 	})
+	Context("spoke API health", func() {
+		var (
+			clusterDeployment *hivev1.ClusterDeployment
+			mockSpokeClients  *MockSpokeClientCache
+		)
+
+		BeforeEach(func() {
+			mockMirrorRegistries.EXPECT().IsMirrorRegistriesConfigured().AnyTimes().Return(false)
+			mockVersions.EXPECT().GetReleaseImageByURL(gomock.Any(), gomock.Any(), gomock.Any()).Return(releaseImage, nil)
+
+			pullSecret := getDefaultTestPullSecret("pull-secret", testNamespace)
+			Expect(c.Create(ctx, pullSecret)).To(BeNil())
+			imageSet := getDefaultTestImageSet(imageSetName, releaseImageUrl)
+			Expect(c.Create(ctx, imageSet)).To(BeNil())
+			clusterDeployment = newClusterDeployment(clusterName, testNamespace, defaultClusterSpec)
+			clusterDeployment.Spec.Installed = true
+			Expect(c.Create(ctx, clusterDeployment)).To(BeNil())
+			aci := newAgentClusterInstall(agentClusterInstallName, testNamespace, defaultAgentClusterInstallSpec, clusterDeployment)
+			Expect(c.Create(ctx, aci)).ShouldNot(HaveOccurred())
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf(adminKubeConfigStringTemplate, clusterDeployment.Name),
+					Namespace: testNamespace,
+				},
+				Data: map[string][]byte{
+					"kubeconfig": []byte("somekubeconfig"),
+				},
+			})).To(Succeed())
+			id := strfmt.UUID(uuid.NewString())
+			cluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:     &id,
+					Kind:   swag.String(models.ClusterKindAddHostsCluster),
+					Status: swag.String(models.ClusterStatusAddingHosts),
+				},
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(cluster, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any(), gomock.Any()).Return(cluster, nil)
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			mockSpokeClients = NewMockSpokeClientCache(mockCtrl)
+			cr.SpokeClients = mockSpokeClients
+		})
+
+		It("sets the condition when the API is reachable", func() {
+			mockSpokeClients.EXPECT().CheckHealth(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockInstallerInternal.EXPECT().GetKnownApprovedHosts(gomock.Any()).Return(nil, nil)
+			result, err := cr.Reconcile(ctx, newClusterDeploymentRequest(clusterDeployment))
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			aci := getTestClusterInstall()
+			condition := FindStatusCondition(aci.Status.Conditions, hiveext.ClusterSpokeAPIReachableCondition)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(hiveext.ClusterSpokeAPIReachableReason))
+		})
+
+		It("installs the day2 hosts and requeues when the API is unreachable", func() {
+			mockSpokeClients.EXPECT().CheckHealth(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+			hostID := strfmt.UUID(uuid.NewString())
+			mockInstallerInternal.EXPECT().GetKnownApprovedHosts(gomock.Any()).Return([]*common.Host{{Host: models.Host{ID: &hostID}}}, nil)
+			mockInstallerInternal.EXPECT().InstallSingleDay2HostInternal(gomock.Any(), gomock.Any(), gomock.Any(), hostID).Return(nil)
+			result, err := cr.Reconcile(ctx, newClusterDeploymentRequest(clusterDeployment))
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{RequeueAfter: spokeAPIUnreachableRequeueAfter}))
+
+			aci := getTestClusterInstall()
+			condition := FindStatusCondition(aci.Status.Conditions, hiveext.ClusterSpokeAPIReachableCondition)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(hiveext.ClusterSpokeAPIUnreachableReason))
+			Expect(condition.Message).To(ContainSubstring("connection refused"))
+		})
+	})
+
 	Context("pause day2 worker MCP", func() {
 		var (
 			clusterDeployment *hivev1.ClusterDeployment
 			aci               *hiveext.AgentClusterInstall
 			cluster           *common.Cluster
-			mockClientFactory *spoke_k8s_client.MockSpokeK8sClientFactory
+			mockSpokeClients  *MockSpokeClientCache
 		)
 		createKubeconfigSecret := func() {
 			secretName := fmt.Sprintf(adminKubeConfigStringTemplate, clusterDeployment.Name)
@@ -4323,8 +4463,10 @@ var _ = Describe("cluster reconcile", func() {
 			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any(), gomock.Any()).Return(cluster, nil)
 			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
-			mockClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
-			cr.SpokeK8sClientFactory = mockClientFactory
+			mockSpokeClients = NewMockSpokeClientCache(mockCtrl)
+			mockSpokeClients.EXPECT().CheckHealth(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			cr.SpokeClients = mockSpokeClients
+			createKubeconfigSecret()
 		})
 		It("no hosts", func() {
 			mockInstallerInternal.EXPECT().GetKnownApprovedHosts(gomock.Any()).Return(nil, nil)
@@ -4397,9 +4539,8 @@ var _ = Describe("cluster reconcile", func() {
 			})
 			Expect(c.Create(ctx, agent)).ToNot(HaveOccurred())
 			request := newClusterDeploymentRequest(clusterDeployment)
-			createKubeconfigSecret()
 			mockClient := spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
-			mockClientFactory.EXPECT().CreateFromSecret(gomock.Any(), gomock.Any()).Return(mockClient, nil).AnyTimes()
+			mockSpokeClients.EXPECT().Get(gomock.Any(), gomock.Any()).Return(mockClient, nil).AnyTimes()
 			mockClient.EXPECT().PatchMachineConfigPoolPaused(gomock.Any(), true, "worker").Return(nil)
 			result, err := cr.Reconcile(ctx, request)
 			Expect(err).To(BeNil())
@@ -4425,9 +4566,8 @@ var _ = Describe("cluster reconcile", func() {
 			})
 			Expect(c.Create(ctx, agent)).ToNot(HaveOccurred())
 			request := newClusterDeploymentRequest(clusterDeployment)
-			createKubeconfigSecret()
 			mockClient := spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
-			mockClientFactory.EXPECT().CreateFromSecret(gomock.Any(), gomock.Any()).Return(mockClient, nil).AnyTimes()
+			mockSpokeClients.EXPECT().Get(gomock.Any(), gomock.Any()).Return(mockClient, nil).AnyTimes()
 			mockClient.EXPECT().PatchMachineConfigPoolPaused(gomock.Any(), false, "worker").Return(nil)
 			result, err := cr.Reconcile(ctx, request)
 			Expect(err).To(BeNil())
@@ -4455,9 +4595,8 @@ var _ = Describe("cluster reconcile", func() {
 				Expect(c.Create(ctx, agent)).ToNot(HaveOccurred())
 			}
 			request := newClusterDeploymentRequest(clusterDeployment)
-			createKubeconfigSecret()
 			mockClient := spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
-			mockClientFactory.EXPECT().CreateFromSecret(gomock.Any(), gomock.Any()).Return(mockClient, nil).AnyTimes()
+			mockSpokeClients.EXPECT().Get(gomock.Any(), gomock.Any()).Return(mockClient, nil).AnyTimes()
 			mockClient.EXPECT().PatchMachineConfigPoolPaused(gomock.Any(), true, "worker").Return(nil)
 			mockVersions.EXPECT().GetReleaseImageByURL(gomock.Any(), gomock.Any(), gomock.Any()).Return(releaseImage, nil).AnyTimes()
 
@@ -0,0 +1,573 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/apis/hive/v1/agent"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ClusterInstanceLabel is set on the NMStateConfigs and the BareMetalHosts rendered from a ClusterInstance, its
+	// value is the name of the ClusterInstance
+	ClusterInstanceLabel = "clusterinstance." + aiv1beta1.Group + "/name"
+
+	clusterInstanceOperatorsSuffix = "-operators"
+	globalOperatorsNamespace       = "openshift-operators"
+)
+
+// ClusterInstanceReconciler reconciles a ClusterInstance object
+type ClusterInstanceReconciler struct {
+	client.Client
+	Log    logrus.FieldLogger
+	Scheme *runtime.Scheme
+}
+
+// clusterInstanceInputError is returned when the ClusterInstance references objects that don't exist or is
+// inconsistent, the rendering is retried when the user fixes it
+type clusterInstanceInputError struct {
+	error
+}
+
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=clusterinstances,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=clusterinstances/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=clusterinstances/finalizers,verbs=update
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=infraenvs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=nmstateconfigs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch
+//+kubebuilder:rbac:groups=hive.openshift.io,resources=clusterdeployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=hive.openshift.io,resources=clusterimagesets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=extensions.hive.openshift.io,resources=agentclusterinstalls,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=metal3.io,resources=baremetalhosts,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *ClusterInstanceReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := addRequestIdIfNeeded(origCtx)
+	log := logutil.FromContext(ctx, r.Log).WithFields(
+		logrus.Fields{
+			"cluster_instance":           req.Name,
+			"cluster_instance_namespace": req.Namespace,
+		})
+
+	defer func() {
+		log.Debug("ClusterInstance Reconcile ended")
+	}()
+
+	log.Debug("ClusterInstance Reconcile started")
+
+	instance := &aiv1beta1.ClusterInstance{}
+	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		log.WithError(err).Errorf("Failed to get ClusterInstance %s", req.NamespacedName)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The rendered objects are owned by the ClusterInstance, they are garbage collected when it is deleted
+	if !instance.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	err := r.validateClusterInstance(ctx, instance)
+	if err == nil {
+		err = r.render(ctx, log, instance)
+	}
+	var inputErr *clusterInstanceInputError
+	switch {
+	case errors.As(err, &inputErr):
+		log.WithError(err).Warn("invalid ClusterInstance")
+		setClusterInstanceCondition(instance, aiv1beta1.ClusterInstanceRenderedCondition, corev1.ConditionFalse,
+			aiv1beta1.ClusterInstanceInputErrorReason, err.Error())
+	case err != nil:
+		log.WithError(err).Error("failed to render the ClusterInstance")
+		setClusterInstanceCondition(instance, aiv1beta1.ClusterInstanceRenderedCondition, corev1.ConditionFalse,
+			aiv1beta1.ClusterInstanceRenderErrorReason, err.Error())
+	default:
+		setClusterInstanceCondition(instance, aiv1beta1.ClusterInstanceRenderedCondition, corev1.ConditionTrue,
+			aiv1beta1.ClusterInstanceRenderedReason, "The cluster resources have been rendered")
+	}
+
+	if statusErr := r.updateClusterInstanceStatus(ctx, log, instance); statusErr != nil {
+		return ctrl.Result{}, statusErr
+	}
+	switch {
+	case inputErr != nil:
+		// The referenced secrets and image sets aren't watched
+		return ctrl.Result{RequeueAfter: longerRequeueAfterOnError}, nil
+	case err != nil:
+		return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *ClusterInstanceReconciler) validateClusterInstance(ctx context.Context, instance *aiv1beta1.ClusterInstance) error {
+	var missing []string
+	exists := func(key types.NamespacedName, obj client.Object, kind string) error {
+		if err := r.Get(ctx, key, obj); err != nil {
+			if k8serrors.IsNotFound(err) {
+				missing = append(missing, fmt.Sprintf("%s %s", kind, key.Name))
+				return nil
+			}
+			return err
+		}
+		return nil
+	}
+
+	if err := exists(types.NamespacedName{Name: instance.Spec.ClusterImageSetName}, &hivev1.ClusterImageSet{}, "ClusterImageSet"); err != nil {
+		return err
+	}
+	if err := exists(types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.PullSecretRef.Name}, &corev1.Secret{}, "Secret"); err != nil {
+		return err
+	}
+	hostNames := make(map[string]bool)
+	for _, node := range instance.Spec.Nodes {
+		if hostNames[node.HostName] {
+			return &clusterInstanceInputError{errors.Errorf("the host name %s is used by more than one node", node.HostName)}
+		}
+		hostNames[node.HostName] = true
+		if err := exists(types.NamespacedName{Namespace: instance.Namespace, Name: node.BMC.CredentialsName}, &corev1.Secret{}, "Secret"); err != nil {
+			return err
+		}
+	}
+	if len(missing) > 0 {
+		return &clusterInstanceInputError{errors.Errorf("the referenced resources don't exist: %s", strings.Join(missing, ", "))}
+	}
+	return nil
+}
+
+// render creates or updates the objects of the cluster. Only the fields set from the ClusterInstance are updated, the
+// fields set by the other controllers, e.g. the metadata of the installed cluster, are kept.
+func (r *ClusterInstanceReconciler) render(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance) error {
+	operatorsConfigMap, err := r.renderOperatorsConfigMap(ctx, log, instance)
+	if err != nil {
+		return err
+	}
+	if err = r.renderClusterDeployment(ctx, log, instance); err != nil {
+		return err
+	}
+	if err = r.renderAgentClusterInstall(ctx, log, instance, operatorsConfigMap); err != nil {
+		return err
+	}
+	if err = r.renderInfraEnv(ctx, log, instance); err != nil {
+		return err
+	}
+	for i := range instance.Spec.Nodes {
+		node := &instance.Spec.Nodes[i]
+		if err = r.renderNMStateConfig(ctx, log, instance, node); err != nil {
+			return err
+		}
+		if err = r.renderBareMetalHost(ctx, log, instance, node); err != nil {
+			return err
+		}
+	}
+	return r.deleteRemovedNodes(ctx, log, instance)
+}
+
+func (r *ClusterInstanceReconciler) createOrUpdate(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance,
+	obj client.Object, mutate func()) error {
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+		mutate()
+		return controllerutil.SetControllerReference(instance, obj, r.Scheme)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to render %T %s", obj, obj.GetName())
+	}
+	if result != controllerutil.OperationResultNone {
+		log.Infof("%T %s %s", obj, obj.GetName(), result)
+	}
+	return nil
+}
+
+func (r *ClusterInstanceReconciler) renderClusterDeployment(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance) error {
+	cd := &hivev1.ClusterDeployment{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	return r.createOrUpdate(ctx, log, instance, cd, func() {
+		cd.Spec.ClusterName = instance.Spec.ClusterName
+		cd.Spec.BaseDomain = instance.Spec.BaseDomain
+		cd.Spec.PullSecretRef = &corev1.LocalObjectReference{Name: instance.Spec.PullSecretRef.Name}
+		cd.Spec.ClusterInstallRef = &hivev1.ClusterInstallLocalReference{
+			Group:   hiveext.Group,
+			Version: hiveext.Version,
+			Kind:    "AgentClusterInstall",
+			Name:    instance.Name,
+		}
+		cd.Spec.Platform = hivev1.Platform{
+			AgentBareMetal: &agent.BareMetalPlatform{
+				AgentSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{aiv1beta1.InfraEnvNameLabel: instance.Name},
+				},
+			},
+		}
+	})
+}
+
+func (r *ClusterInstanceReconciler) renderAgentClusterInstall(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance,
+	operatorsConfigMap *corev1.ConfigMap) error {
+	var masters, workers int
+	for _, node := range instance.Spec.Nodes {
+		if node.Role == models.HostRoleWorker {
+			workers++
+		} else {
+			masters++
+		}
+	}
+	manifests := append([]hiveext.ManifestsConfigMapReference{}, instance.Spec.ManifestsConfigMapRefs...)
+	if operatorsConfigMap != nil {
+		manifests = append(manifests, hiveext.ManifestsConfigMapReference{Name: operatorsConfigMap.Name})
+	}
+
+	aci := &hiveext.AgentClusterInstall{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	return r.createOrUpdate(ctx, log, instance, aci, func() {
+		aci.Spec.ClusterDeploymentRef = corev1.LocalObjectReference{Name: instance.Name}
+		aci.Spec.ImageSetRef = &hivev1.ClusterImageSetReference{Name: instance.Spec.ClusterImageSetName}
+		aci.Spec.Networking = instance.Spec.Networking
+		aci.Spec.SSHPublicKey = instance.Spec.SSHPublicKey
+		aci.Spec.ProvisionRequirements.ControlPlaneAgents = masters
+		aci.Spec.ProvisionRequirements.WorkerAgents = workers
+		aci.Spec.APIVIPs = instance.Spec.APIVIPs
+		aci.Spec.IngressVIPs = instance.Spec.IngressVIPs
+		aci.Spec.PlatformType = instance.Spec.PlatformType
+		aci.Spec.HoldInstallation = instance.Spec.HoldInstallation
+		aci.Spec.ManifestsConfigMapRefs = manifests
+		aci.Spec.Proxy = nil
+		if instance.Spec.Proxy != nil {
+			aci.Spec.Proxy = &hiveext.Proxy{
+				HTTPProxy:  instance.Spec.Proxy.HTTPProxy,
+				HTTPSProxy: instance.Spec.Proxy.HTTPSProxy,
+				NoProxy:    instance.Spec.Proxy.NoProxy,
+			}
+		}
+	})
+}
+
+func (r *ClusterInstanceReconciler) renderInfraEnv(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance) error {
+	infraEnv := &aiv1beta1.InfraEnv{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	return r.createOrUpdate(ctx, log, instance, infraEnv, func() {
+		infraEnv.Spec.ClusterRef = &aiv1beta1.ClusterReference{Name: instance.Name, Namespace: instance.Namespace}
+		infraEnv.Spec.PullSecretRef = &corev1.LocalObjectReference{Name: instance.Spec.PullSecretRef.Name}
+		infraEnv.Spec.SSHAuthorizedKey = instance.Spec.SSHPublicKey
+		infraEnv.Spec.CpuArchitecture = instance.Spec.CPUArchitecture
+		infraEnv.Spec.Proxy = instance.Spec.Proxy
+		infraEnv.Spec.AdditionalNTPSources = instance.Spec.AdditionalNTPSources
+		infraEnv.Spec.NMStateConfigLabelSelector = metav1.LabelSelector{
+			MatchLabels: map[string]string{ClusterInstanceLabel: instance.Name},
+		}
+	})
+}
+
+func (r *ClusterInstanceReconciler) renderNMStateConfig(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance,
+	node *aiv1beta1.ClusterInstanceNode) error {
+	nmStateConfig := &aiv1beta1.NMStateConfig{ObjectMeta: metav1.ObjectMeta{Name: node.HostName, Namespace: instance.Namespace}}
+	if node.NodeNetwork == nil {
+		return client.IgnoreNotFound(r.deleteIfOwned(ctx, log, instance, nmStateConfig))
+	}
+	return r.createOrUpdate(ctx, log, instance, nmStateConfig, func() {
+		setLabel(&nmStateConfig.ObjectMeta, ClusterInstanceLabel, instance.Name)
+		nmStateConfig.Spec = *node.NodeNetwork.DeepCopy()
+	})
+}
+
+func (r *ClusterInstanceReconciler) renderBareMetalHost(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance,
+	node *aiv1beta1.ClusterInstanceNode) error {
+	bmh := &bmh_v1alpha1.BareMetalHost{ObjectMeta: metav1.ObjectMeta{Name: node.HostName, Namespace: instance.Namespace}}
+	return r.createOrUpdate(ctx, log, instance, bmh, func() {
+		setLabel(&bmh.ObjectMeta, ClusterInstanceLabel, instance.Name)
+		setLabel(&bmh.ObjectMeta, BMH_INFRA_ENV_LABEL, instance.Name)
+		role := node.Role
+		if role == "" {
+			role = models.HostRoleMaster
+		}
+		setAnnotation(&bmh.ObjectMeta, BMH_AGENT_HOSTNAME, node.HostName)
+		setAnnotation(&bmh.ObjectMeta, BMH_AGENT_ROLE, string(role))
+		setOptionalAnnotation(&bmh.ObjectMeta, BMH_AGENT_INSTALLER_ARGS, node.InstallerArgs)
+		setOptionalAnnotation(&bmh.ObjectMeta, BMH_AGENT_IGNITION_CONFIG_OVERRIDES, node.IgnitionConfigOverride)
+		// The hardware is inspected by the discovery agent
+		setAnnotation(&bmh.ObjectMeta, BMH_INSPECT_ANNOTATION, "disabled")
+		bmh.Spec.Online = true
+		bmh.Spec.BootMACAddress = node.BootMACAddress
+		bmh.Spec.AutomatedCleaningMode = bmh_v1alpha1.CleaningModeDisabled
+		bmh.Spec.BMC = bmh_v1alpha1.BMCDetails{
+			Address:                        node.BMC.Address,
+			CredentialsName:                node.BMC.CredentialsName,
+			DisableCertificateVerification: node.BMC.DisableCertificateVerification,
+		}
+	})
+}
+
+// deleteRemovedNodes deletes the BareMetalHosts and the NMStateConfigs of the nodes removed from the ClusterInstance
+func (r *ClusterInstanceReconciler) deleteRemovedNodes(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance) error {
+	hostNames := make(map[string]bool)
+	for _, node := range instance.Spec.Nodes {
+		hostNames[node.HostName] = true
+	}
+	selector := []client.ListOption{client.InNamespace(instance.Namespace), client.MatchingLabels{ClusterInstanceLabel: instance.Name}}
+
+	bmhs := &bmh_v1alpha1.BareMetalHostList{}
+	if err := r.List(ctx, bmhs, selector...); err != nil {
+		return err
+	}
+	for i := range bmhs.Items {
+		if !hostNames[bmhs.Items[i].Name] {
+			if err := r.deleteIfOwned(ctx, log, instance, &bmhs.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	nmStateConfigs := &aiv1beta1.NMStateConfigList{}
+	if err := r.List(ctx, nmStateConfigs, selector...); err != nil {
+		return err
+	}
+	for i := range nmStateConfigs.Items {
+		if !hostNames[nmStateConfigs.Items[i].Name] {
+			if err := r.deleteIfOwned(ctx, log, instance, &nmStateConfigs.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *ClusterInstanceReconciler) deleteIfOwned(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance, obj client.Object) error {
+	if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(obj, instance) {
+		return nil
+	}
+	log.Infof("Deleting %T %s of a removed node", obj, obj.GetName())
+	return client.IgnoreNotFound(r.Delete(ctx, obj))
+}
+
+// renderOperatorsConfigMap renders the namespaces, the operator groups and the subscriptions of the operators as
+// manifests of the installation. It returns nil when the ClusterInstance doesn't have operators.
+func (r *ClusterInstanceReconciler) renderOperatorsConfigMap(ctx context.Context, log logrus.FieldLogger,
+	instance *aiv1beta1.ClusterInstance) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      instance.Name + clusterInstanceOperatorsSuffix,
+		Namespace: instance.Namespace,
+	}}
+	if len(instance.Spec.Operators) == 0 {
+		if err := r.deleteIfOwned(ctx, log, instance, configMap); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+	data, err := renderOperatorManifests(instance.Spec.Operators)
+	if err != nil {
+		return nil, err
+	}
+	err = r.createOrUpdate(ctx, log, instance, configMap, func() {
+		configMap.Data = data
+	})
+	return configMap, err
+}
+
+func renderOperatorManifests(operators []aiv1beta1.ClusterInstanceOperator) (map[string]string, error) {
+	data := make(map[string]string)
+	add := func(fileName string, manifest map[string]interface{}) error {
+		content, err := yaml.Marshal(manifest)
+		if err != nil {
+			return err
+		}
+		data[fileName] = string(content)
+		return nil
+	}
+	for _, operator := range operators {
+		// The operators of the global namespace use its existing operator group
+		if operator.Namespace != globalOperatorsNamespace {
+			if err := add(fmt.Sprintf("50-%s-namespace.yaml", operator.Name), map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+				"metadata":   map[string]interface{}{"name": operator.Namespace},
+			}); err != nil {
+				return nil, err
+			}
+			if err := add(fmt.Sprintf("50-%s-operatorgroup.yaml", operator.Name), map[string]interface{}{
+				"apiVersion": "operators.coreos.com/v1",
+				"kind":       "OperatorGroup",
+				"metadata":   map[string]interface{}{"name": operator.Name, "namespace": operator.Namespace},
+				"spec":       map[string]interface{}{"targetNamespaces": []string{operator.Namespace}},
+			}); err != nil {
+				return nil, err
+			}
+		}
+		spec := map[string]interface{}{
+			"name":                operator.Name,
+			"source":              defaultString(operator.Source, "redhat-operators"),
+			"sourceNamespace":     defaultString(operator.SourceNamespace, "openshift-marketplace"),
+			"installPlanApproval": "Automatic",
+		}
+		if operator.Channel != "" {
+			spec["channel"] = operator.Channel
+		}
+		if err := add(fmt.Sprintf("50-%s-subscription.yaml", operator.Name), map[string]interface{}{
+			"apiVersion": "operators.coreos.com/v1alpha1",
+			"kind":       "Subscription",
+			"metadata":   map[string]interface{}{"name": operator.Name, "namespace": operator.Namespace},
+			"spec":       spec,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+func defaultString(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+func setLabel(meta *metav1.ObjectMeta, key, value string) {
+	if meta.Labels == nil {
+		meta.Labels = make(map[string]string)
+	}
+	meta.Labels[key] = value
+}
+
+// setOptionalAnnotation sets the annotation, or removes it when the value is empty
+func setOptionalAnnotation(meta *metav1.ObjectMeta, key, value string) {
+	if value == "" {
+		delete(meta.Annotations, key)
+		return
+	}
+	setAnnotation(meta, key, value)
+}
+
+func (r *ClusterInstanceReconciler) updateClusterInstanceStatus(ctx context.Context, log logrus.FieldLogger, instance *aiv1beta1.ClusterInstance) error {
+	aci := &hiveext.AgentClusterInstall{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}, aci)
+	switch {
+	case k8serrors.IsNotFound(err):
+		instance.Status.ClusterDeploymentRef = nil
+		instance.Status.InstallState = ""
+		setClusterInstanceCondition(instance, aiv1beta1.ClusterInstanceProvisionedCondition, corev1.ConditionFalse,
+			aiv1beta1.ClusterInstanceProvisionPendingReason, "The cluster resources haven't been rendered")
+	case err != nil:
+		return err
+	default:
+		instance.Status.ClusterDeploymentRef = &corev1.LocalObjectReference{Name: aci.Spec.ClusterDeploymentRef.Name}
+		instance.Status.InstallState = aci.Status.DebugInfo.State
+		setClusterInstanceProvisioned(instance, aci)
+	}
+
+	agents := &aiv1beta1.AgentList{}
+	if err = r.List(ctx, agents, client.InNamespace(instance.Namespace),
+		client.MatchingLabels{aiv1beta1.InfraEnvNameLabel: instance.Name}); err != nil {
+		return err
+	}
+	agentsByHost := make(map[string]*aiv1beta1.Agent)
+	for i := range agents.Items {
+		agent := &agents.Items[i]
+		hostName := agent.Labels[AGENT_BMH_LABEL]
+		if hostName == "" {
+			hostName = agent.Spec.Hostname
+		}
+		agentsByHost[hostName] = agent
+	}
+	instance.Status.Nodes = make([]aiv1beta1.ClusterInstanceNodeStatus, 0, len(instance.Spec.Nodes))
+	for _, node := range instance.Spec.Nodes {
+		status := aiv1beta1.ClusterInstanceNodeStatus{HostName: node.HostName}
+		if agent, ok := agentsByHost[node.HostName]; ok {
+			status.AgentName = agent.Name
+			status.State = agent.Status.DebugInfo.State
+		}
+		instance.Status.Nodes = append(instance.Status.Nodes, status)
+	}
+	sort.Slice(instance.Status.Nodes, func(i, j int) bool { return instance.Status.Nodes[i].HostName < instance.Status.Nodes[j].HostName })
+
+	if err = r.Status().Update(ctx, instance); err != nil {
+		log.WithError(err).Error("failed to update cluster instance status")
+		return err
+	}
+	return nil
+}
+
+// setClusterInstanceProvisioned aggregates the Completed and the Failed conditions of the AgentClusterInstall
+func setClusterInstanceProvisioned(instance *aiv1beta1.ClusterInstance, aci *hiveext.AgentClusterInstall) {
+	completed := FindStatusCondition(aci.Status.Conditions, hiveext.ClusterCompletedCondition)
+	failed := FindStatusCondition(aci.Status.Conditions, hiveext.ClusterFailedCondition)
+	switch {
+	case completed != nil && completed.Status == corev1.ConditionTrue:
+		setClusterInstanceCondition(instance, aiv1beta1.ClusterInstanceProvisionedCondition, corev1.ConditionTrue,
+			aiv1beta1.ClusterInstanceProvisionedReason, completed.Message)
+	case failed != nil && failed.Status == corev1.ConditionTrue:
+		setClusterInstanceCondition(instance, aiv1beta1.ClusterInstanceProvisionedCondition, corev1.ConditionFalse,
+			aiv1beta1.ClusterInstanceProvisionFailedReason, failed.Message)
+	case completed != nil && completed.Reason == hiveext.ClusterInstallationInProgressReason:
+		setClusterInstanceCondition(instance, aiv1beta1.ClusterInstanceProvisionedCondition, corev1.ConditionFalse,
+			aiv1beta1.ClusterInstanceProvisioningReason, completed.Message)
+	default:
+		setClusterInstanceCondition(instance, aiv1beta1.ClusterInstanceProvisionedCondition, corev1.ConditionFalse,
+			aiv1beta1.ClusterInstanceProvisionPendingReason, "The installation has not yet started")
+	}
+}
+
+func setClusterInstanceCondition(instance *aiv1beta1.ClusterInstance, conditionType conditionsv1.ConditionType,
+	status corev1.ConditionStatus, reason, message string) {
+	conditionsv1.SetStatusConditionNoHeartbeat(&instance.Status.Conditions, conditionsv1.Condition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+func (r *ClusterInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The Agents are labeled with the name of their InfraEnv, which is the name of the ClusterInstance
+	mapAgentToClusterInstance := func(ctx context.Context, agent client.Object) []reconcile.Request {
+		name, ok := agent.GetLabels()[aiv1beta1.InfraEnvNameLabel]
+		if !ok {
+			return []reconcile.Request{}
+		}
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: agent.GetNamespace(), Name: name}}}
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aiv1beta1.ClusterInstance{}).
+		Owns(&hivev1.ClusterDeployment{}).
+		Owns(&hiveext.AgentClusterInstall{}).
+		Owns(&aiv1beta1.InfraEnv{}).
+		Owns(&aiv1beta1.NMStateConfig{}).
+		Owns(&bmh_v1alpha1.BareMetalHost{}).
+		Owns(&corev1.ConfigMap{}).
+		Watches(&aiv1beta1.Agent{}, handler.EnqueueRequestsFromMapFunc(mapAgentToClusterInstance)).
+		Complete(r)
+}
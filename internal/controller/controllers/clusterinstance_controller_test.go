@@ -0,0 +1,249 @@
+package controllers
+
+import (
+	"context"
+
+	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ClusterInstance reconcile", func() {
+	var (
+		c            client.Client
+		cr           *ClusterInstanceReconciler
+		ctx          = context.Background()
+		instanceName = "sno"
+		instanceKey  = types.NamespacedName{Namespace: testNamespace, Name: instanceName}
+	)
+
+	newSecret := func(name string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace}}
+	}
+
+	newNode := func(hostName string, role models.HostRole) v1beta1.ClusterInstanceNode {
+		return v1beta1.ClusterInstanceNode{
+			HostName:       hostName,
+			Role:           role,
+			BootMACAddress: "52:54:00:00:00:01",
+			BMC: v1beta1.ClusterInstanceBMC{
+				Address:         "redfish-virtualmedia://192.0.2.1/redfish/v1/Systems/" + hostName,
+				CredentialsName: "bmc-secret",
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithStatusSubresource(&v1beta1.ClusterInstance{}).Build()
+		cr = &ClusterInstanceReconciler{
+			Client: c,
+			Log:    common.GetTestLog(),
+			Scheme: scheme.Scheme,
+		}
+		Expect(c.Create(ctx, newSecret("pull-secret"))).To(Succeed())
+		Expect(c.Create(ctx, newSecret("bmc-secret"))).To(Succeed())
+		Expect(c.Create(ctx, &hivev1.ClusterImageSet{ObjectMeta: metav1.ObjectMeta{Name: "openshift-v4.16"}})).To(Succeed())
+	})
+
+	createInstance := func(nodes ...v1beta1.ClusterInstanceNode) {
+		Expect(c.Create(ctx, &v1beta1.ClusterInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: instanceName, Namespace: testNamespace},
+			Spec: v1beta1.ClusterInstanceSpec{
+				ClusterName:         "sno",
+				BaseDomain:          "example.com",
+				ClusterImageSetName: "openshift-v4.16",
+				PullSecretRef:       corev1.LocalObjectReference{Name: "pull-secret"},
+				SSHPublicKey:        "ssh-rsa AAAA",
+				Operators: []v1beta1.ClusterInstanceOperator{
+					{Name: "local-storage-operator", Namespace: "openshift-local-storage", Channel: "stable"},
+				},
+				Nodes: nodes,
+			},
+		})).To(Succeed())
+	}
+
+	getInstance := func() *v1beta1.ClusterInstance {
+		instance := &v1beta1.ClusterInstance{}
+		Expect(c.Get(ctx, instanceKey, instance)).To(Succeed())
+		return instance
+	}
+
+	reconcile := func() ctrl.Result {
+		result, err := cr.Reconcile(ctx, ctrl.Request{NamespacedName: instanceKey})
+		Expect(err).ToNot(HaveOccurred())
+		return result
+	}
+
+	It("renders the resources of the cluster", func() {
+		node := newNode("sno-0", "")
+		node.NodeNetwork = &v1beta1.NMStateConfigSpec{
+			Interfaces: []*v1beta1.Interface{{Name: "eth0", MacAddress: "52:54:00:00:00:01"}},
+			NetConfig:  v1beta1.NetConfig{Raw: []byte("interfaces: []")},
+		}
+		createInstance(node)
+		Expect(reconcile()).To(Equal(ctrl.Result{}))
+		instance := getInstance()
+
+		cd := &hivev1.ClusterDeployment{}
+		Expect(c.Get(ctx, instanceKey, cd)).To(Succeed())
+		Expect(metav1.IsControlledBy(cd, instance)).To(BeTrue())
+		Expect(cd.Spec.ClusterName).To(Equal("sno"))
+		Expect(cd.Spec.ClusterInstallRef.Name).To(Equal(instanceName))
+		Expect(cd.Spec.Platform.AgentBareMetal.AgentSelector.MatchLabels).To(HaveKeyWithValue(v1beta1.InfraEnvNameLabel, instanceName))
+
+		aci := &hiveext.AgentClusterInstall{}
+		Expect(c.Get(ctx, instanceKey, aci)).To(Succeed())
+		Expect(metav1.IsControlledBy(aci, instance)).To(BeTrue())
+		Expect(aci.Spec.ProvisionRequirements.ControlPlaneAgents).To(Equal(1))
+		Expect(aci.Spec.ProvisionRequirements.WorkerAgents).To(Equal(0))
+		Expect(aci.Spec.ImageSetRef.Name).To(Equal("openshift-v4.16"))
+		Expect(aci.Spec.ManifestsConfigMapRefs).To(ConsistOf(hiveext.ManifestsConfigMapReference{Name: instanceName + "-operators"}))
+
+		infraEnv := &v1beta1.InfraEnv{}
+		Expect(c.Get(ctx, instanceKey, infraEnv)).To(Succeed())
+		Expect(infraEnv.Spec.ClusterRef.Name).To(Equal(instanceName))
+		Expect(infraEnv.Spec.NMStateConfigLabelSelector.MatchLabels).To(HaveKeyWithValue(ClusterInstanceLabel, instanceName))
+
+		nmStateConfig := &v1beta1.NMStateConfig{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "sno-0"}, nmStateConfig)).To(Succeed())
+		Expect(nmStateConfig.Labels).To(HaveKeyWithValue(ClusterInstanceLabel, instanceName))
+
+		bmh := &bmh_v1alpha1.BareMetalHost{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "sno-0"}, bmh)).To(Succeed())
+		Expect(bmh.Labels).To(HaveKeyWithValue(BMH_INFRA_ENV_LABEL, instanceName))
+		Expect(bmh.Annotations).To(HaveKeyWithValue(BMH_AGENT_ROLE, "master"))
+		Expect(bmh.Annotations).To(HaveKeyWithValue(BMH_AGENT_HOSTNAME, "sno-0"))
+		Expect(bmh.Spec.BMC.CredentialsName).To(Equal("bmc-secret"))
+		Expect(bmh.Spec.Online).To(BeTrue())
+
+		configMap := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: instanceName + "-operators"}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKey("50-local-storage-operator-subscription.yaml"))
+		Expect(configMap.Data["50-local-storage-operator-subscription.yaml"]).To(ContainSubstring("channel: stable"))
+		Expect(configMap.Data).To(HaveKey("50-local-storage-operator-operatorgroup.yaml"))
+
+		Expect(conditionsv1.IsStatusConditionTrue(instance.Status.Conditions, v1beta1.ClusterInstanceRenderedCondition)).To(BeTrue())
+		Expect(instance.Status.ClusterDeploymentRef.Name).To(Equal(instanceName))
+		Expect(instance.Status.Nodes).To(Equal([]v1beta1.ClusterInstanceNodeStatus{{HostName: "sno-0"}}))
+	})
+
+	It("reports the state of the installation and of the nodes", func() {
+		createInstance(newNode("sno-0", models.HostRoleMaster))
+		reconcile()
+
+		aci := &hiveext.AgentClusterInstall{}
+		Expect(c.Get(ctx, instanceKey, aci)).To(Succeed())
+		aci.Status.DebugInfo.State = models.ClusterStatusInstalling
+		aci.Status.Conditions = []hivev1.ClusterInstallCondition{{
+			Type:    hiveext.ClusterCompletedCondition,
+			Status:  corev1.ConditionFalse,
+			Reason:  hiveext.ClusterInstallationInProgressReason,
+			Message: "The installation is in progress: Installing",
+		}}
+		Expect(c.Update(ctx, aci)).To(Succeed())
+		agent := newAgent("agent", testNamespace, v1beta1.AgentSpec{})
+		agent.Labels = map[string]string{v1beta1.InfraEnvNameLabel: instanceName, AGENT_BMH_LABEL: "sno-0"}
+		agent.Status.DebugInfo.State = models.HostStatusInstallingInProgress
+		Expect(c.Create(ctx, agent)).To(Succeed())
+		reconcile()
+
+		instance := getInstance()
+		Expect(instance.Status.InstallState).To(Equal(models.ClusterStatusInstalling))
+		Expect(instance.Status.Nodes).To(Equal([]v1beta1.ClusterInstanceNodeStatus{{
+			HostName:  "sno-0",
+			AgentName: "agent",
+			State:     models.HostStatusInstallingInProgress,
+		}}))
+		condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, v1beta1.ClusterInstanceProvisionedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.ClusterInstanceProvisioningReason))
+
+		aci.Status.Conditions = []hivev1.ClusterInstallCondition{{
+			Type:    hiveext.ClusterCompletedCondition,
+			Status:  corev1.ConditionTrue,
+			Reason:  hiveext.ClusterInstalledReason,
+			Message: "The installation has completed: Cluster is installed",
+		}}
+		Expect(c.Update(ctx, aci)).To(Succeed())
+		reconcile()
+		Expect(conditionsv1.IsStatusConditionTrue(getInstance().Status.Conditions, v1beta1.ClusterInstanceProvisionedCondition)).To(BeTrue())
+	})
+
+	It("deletes the resources of the removed nodes", func() {
+		createInstance(newNode("node-0", models.HostRoleMaster), newNode("node-1", models.HostRoleWorker))
+		reconcile()
+		aci := &hiveext.AgentClusterInstall{}
+		Expect(c.Get(ctx, instanceKey, aci)).To(Succeed())
+		Expect(aci.Spec.ProvisionRequirements.WorkerAgents).To(Equal(1))
+
+		instance := getInstance()
+		instance.Spec.Nodes = instance.Spec.Nodes[:1]
+		Expect(c.Update(ctx, instance)).To(Succeed())
+		reconcile()
+
+		err := c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "node-1"}, &bmh_v1alpha1.BareMetalHost{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "node-0"}, &bmh_v1alpha1.BareMetalHost{})).To(Succeed())
+		Expect(c.Get(ctx, instanceKey, aci)).To(Succeed())
+		Expect(aci.Spec.ProvisionRequirements.WorkerAgents).To(Equal(0))
+	})
+
+	It("keeps the fields set by the other controllers", func() {
+		createInstance(newNode("sno-0", models.HostRoleMaster))
+		reconcile()
+		cd := &hivev1.ClusterDeployment{}
+		Expect(c.Get(ctx, instanceKey, cd)).To(Succeed())
+		cd.Spec.Installed = true
+		Expect(c.Update(ctx, cd)).To(Succeed())
+
+		reconcile()
+		Expect(c.Get(ctx, instanceKey, cd)).To(Succeed())
+		Expect(cd.Spec.Installed).To(BeTrue())
+	})
+
+	It("reports the missing references", func() {
+		node := newNode("sno-0", models.HostRoleMaster)
+		node.BMC.CredentialsName = "missing-secret"
+		createInstance(node)
+		Expect(reconcile()).To(Equal(ctrl.Result{RequeueAfter: longerRequeueAfterOnError}))
+
+		condition := conditionsv1.FindStatusCondition(getInstance().Status.Conditions, v1beta1.ClusterInstanceRenderedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.ClusterInstanceInputErrorReason))
+		Expect(condition.Message).To(ContainSubstring("Secret missing-secret"))
+		err := c.Get(ctx, instanceKey, &hivev1.ClusterDeployment{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("rejects duplicate host names", func() {
+		createInstance(newNode("node-0", models.HostRoleMaster), newNode("node-0", models.HostRoleWorker))
+		reconcile()
+		condition := conditionsv1.FindStatusCondition(getInstance().Status.Conditions, v1beta1.ClusterInstanceRenderedCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.ClusterInstanceInputErrorReason))
+		Expect(condition.Message).To(ContainSubstring("node-0"))
+	})
+})
+
+var _ = Describe("renderOperatorManifests", func() {
+	It("uses the global operator group for the operators of openshift-operators", func() {
+		data, err := renderOperatorManifests([]v1beta1.ClusterInstanceOperator{{Name: "web-terminal", Namespace: "openshift-operators"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(HaveLen(1))
+		Expect(data["50-web-terminal-subscription.yaml"]).To(ContainSubstring("source: redhat-operators"))
+		Expect(data["50-web-terminal-subscription.yaml"]).To(ContainSubstring("sourceNamespace: openshift-marketplace"))
+	})
+})
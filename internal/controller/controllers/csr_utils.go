@@ -150,7 +150,8 @@ func validateNodeClientCSR(agent *aiv1beta1.Agent, csr *certificatesv1.Certifica
 }
 
 // Validate that server CSR can be approved.  Server CSR can be approved after node joins the cluster  and alternative names are matched
-func validateNodeServerCSR(agent *aiv1beta1.Agent, node *corev1.Node, csr *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest) (bool, error) {
+// with the node addresses or the additional SANs allowed by the CSR approval policy
+func validateNodeServerCSR(agent *aiv1beta1.Agent, node *corev1.Node, additionalSANs []string, csr *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest) (bool, error) {
 
 	// Username must consist from ""system:node:" and the node name
 	nodeAsking := strings.TrimPrefix(csr.Spec.Username, nodeUserPrefix)
@@ -193,6 +194,7 @@ func validateNodeServerCSR(agent *aiv1beta1.Agent, node *corev1.Node, csr *certi
 			x509CSR.Subject.CommonName, csr.Spec.Username, csr.Name)
 	}
 	nodeDNSNames := append(getNodeDNSNames(node), node.Name)
+	nodeDNSNames = append(nodeDNSNames, additionalSANs...)
 
 	// Any DNS name in CSR must exist in the nodeDNSNames
 	// TODO: May need to modify for IPv6 only node
@@ -202,7 +204,7 @@ func validateNodeServerCSR(agent *aiv1beta1.Agent, node *corev1.Node, csr *certi
 				dnsName, nodeDNSNames)
 		}
 	}
-	nodeIPs := getNodeIPs(node)
+	nodeIPs := append(getNodeIPs(node), additionalSANs...)
 	// Any IP address in CSR must exist in nodeIPs
 	for _, ip := range x509CSR.IPAddresses {
 		if !funk.ContainsString(nodeIPs, ip.String()) {
@@ -213,12 +215,23 @@ func validateNodeServerCSR(agent *aiv1beta1.Agent, node *corev1.Node, csr *certi
 	return true, nil
 }
 
-func createNodeServerCsrValidator(node *corev1.Node) nodeCsrValidator {
+func createNodeServerCsrValidator(node *corev1.Node, additionalSANs []string) nodeCsrValidator {
 	return func(agent *aiv1beta1.Agent, csr *certificatesv1.CertificateSigningRequest, x509cr *x509.CertificateRequest) (bool, error) {
-		return validateNodeServerCSR(agent, node, csr, x509cr)
+		return validateNodeServerCSR(agent, node, additionalSANs, csr, x509cr)
 	}
 }
 
+// isCsrOfType returns true if the CSR is signed by the signer of the given CSR type
+func isCsrOfType(csr *certificatesv1.CertificateSigningRequest, csrType aiv1beta1.CSRType) bool {
+	switch csrType {
+	case aiv1beta1.CSRTypeClient:
+		return csr.Spec.SignerName == certificatesv1.KubeAPIServerClientKubeletSignerName
+	case aiv1beta1.CSRTypeServing:
+		return csr.Spec.SignerName == certificatesv1.KubeletServingSignerName
+	}
+	return false
+}
+
 // If the concatenation of ["system:node:", agent's host name] is equal the the CSR CN, the CSR is associated with the agent
 // TODO: Verify IPV6 CN to follow this condition
 func isCsrAssociatedWithAgent(x509CSR *x509.CertificateRequest, agent *aiv1beta1.Agent) bool {
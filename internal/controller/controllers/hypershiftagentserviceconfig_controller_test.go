@@ -667,3 +667,17 @@ func (c fakeSpokeK8sClient) PatchMachineConfigPoolPaused(ctx context.Context, pa
 func (c fakeSpokeK8sClient) DeleteNode(ctx context.Context, name string) error {
 	return nil
 }
+
+func (c fakeSpokeK8sClient) RemoveEtcdMember(ctx context.Context, nodeName string, replaced bool) error {
+	return nil
+}
+
+func (c fakeSpokeK8sClient) IsEtcdMemberStarted(ctx context.Context, nodeName string) (bool, error) {
+	return true, nil
+}
+
+func (c fakeSpokeK8sClient) CheckHealth(ctx context.Context) error {
+	return nil
+}
+
+func (c fakeSpokeK8sClient) Close() {}
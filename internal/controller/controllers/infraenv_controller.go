@@ -72,6 +72,11 @@ const (
 
 type InfraEnvConfig struct {
 	ImageType models.ImageType `envconfig:"ISO_IMAGE_TYPE" default:"minimal-iso"`
+	// ImageRegenerationDebounce delays the update of the discovery image on NMStateConfig changes until they stop
+	// changing for the given period, zero applies them immediately
+	ImageRegenerationDebounce time.Duration `envconfig:"INFRAENV_IMAGE_REGENERATION_DEBOUNCE" default:"0s"`
+	// ImageHistoryLength is the number of generations of the discovery image kept in the InfraEnv status
+	ImageHistoryLength int `envconfig:"INFRAENV_IMAGE_HISTORY_LENGTH" default:"10"`
 }
 
 // InfraEnvReconciler reconciles a InfraEnv object
@@ -89,6 +94,8 @@ type InfraEnvReconciler struct {
 	PullSecretHandler
 	InsecureIPXEURLs    bool
 	ImageServiceEnabled bool
+
+	nmStateConfigs nmStateConfigsDebouncer
 }
 
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=nmstateconfigs,verbs=get;list;watch
@@ -223,12 +230,15 @@ func (r *InfraEnvReconciler) updateInfraEnv(ctx context.Context, log logrus.Fiel
 	if err != nil {
 		return nil, err
 	}
-	if len(staticNetworkConfig) > 0 {
-		log.Infof("the amount of nmStateConfigs included in the image is: %d", len(staticNetworkConfig))
-		updateParams.InfraEnvUpdateParams.StaticNetworkConfig = staticNetworkConfig
-	} else if internalInfraEnv.StaticNetworkConfig != "" {
-		log.Infof("removed all nmStateConfigs from the image")
-		updateParams.InfraEnvUpdateParams.StaticNetworkConfig = []*models.HostStaticNetworkConfig{}
+	// the NMStateConfigs are kept as they are until they stop changing for the debounce period
+	if !r.debounceNMStateConfigs(log, infraEnv, internalInfraEnv, staticNetworkConfig) {
+		if len(staticNetworkConfig) > 0 {
+			log.Infof("the amount of nmStateConfigs included in the image is: %d", len(staticNetworkConfig))
+			updateParams.InfraEnvUpdateParams.StaticNetworkConfig = staticNetworkConfig
+		} else if internalInfraEnv.StaticNetworkConfig != "" {
+			log.Infof("removed all nmStateConfigs from the image")
+			updateParams.InfraEnvUpdateParams.StaticNetworkConfig = []*models.HostStaticNetworkConfig{}
+		}
 	}
 
 	existingKargs, err := kubeKernelArgs(internalInfraEnv)
@@ -730,28 +740,51 @@ func (r *InfraEnvReconciler) updateInfraEnvStatus(
 
 	r.setStaticNetworkDownloadURL(log, infraEnv, internalInfraEnv)
 
-	message := aiv1beta1.InfraEnvAvailableMessage
+	// the image doesn't include the pending NMStateConfigs yet, it is held until they are applied
+	nmStateConfigsRequeueAfter := r.nmStateConfigsRequeueAfter(infraEnv)
+	condition := conditionsv1.Condition{
+		Type:    aiv1beta1.ImageCreatedCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  aiv1beta1.InfraEnvAvailableReason,
+		Message: aiv1beta1.InfraEnvAvailableMessage,
+	}
+	if nmStateConfigsRequeueAfter > 0 {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = aiv1beta1.ImageUpdatePendingReason
+		condition.Message = aiv1beta1.ImageStateUpdatePending
+	}
 	if r.ImageServiceEnabled {
-		isoUpdated = r.updateISODownloadURL(log, infraEnv, internalInfraEnv)
+		if nmStateConfigsRequeueAfter > 0 {
+			holdISODownloadURL(log, infraEnv)
+		} else {
+			isoUpdated = r.updateISODownloadURL(log, infraEnv, internalInfraEnv)
+			condition.Message = fmt.Sprintf("%s: %s", condition.Message, aiv1beta1.ImageStateCreated)
+		}
+		r.recordImageGeneration(log, infraEnv, internalInfraEnv)
 
 		if err = r.setBootArtifactURLs(log, infraEnv, internalInfraEnv, isoUpdated); err != nil {
 			return r.handleInfraEnvReconciliationError(ctx, log, infraEnv, err, internalInfraEnv)
 		}
-		message = fmt.Sprintf("%s: %s", message, aiv1beta1.ImageStateCreated)
 	}
 
-	conditionsv1.SetStatusConditionNoHeartbeat(&infraEnv.Status.Conditions, conditionsv1.Condition{
-		Type:    aiv1beta1.ImageCreatedCondition,
-		Status:  corev1.ConditionTrue,
-		Reason:  aiv1beta1.InfraEnvAvailableReason,
-		Message: message,
-	})
+	conditionsv1.SetStatusConditionNoHeartbeat(&infraEnv.Status.Conditions, condition)
 
 	if updateErr := r.Status().Update(ctx, infraEnv); updateErr != nil {
 		log.WithError(updateErr).Error("failed to update infraEnv status")
 		return ctrl.Result{Requeue: true}, nil
 	}
-	return ctrl.Result{Requeue: false}, nil
+	return ctrl.Result{Requeue: false, RequeueAfter: nmStateConfigsRequeueAfter}, nil
+}
+
+// holdISODownloadURL clears the ISO download URL so that the hosts don't boot an image that doesn't include the
+// pending changes, it is published again once the image is updated
+func holdISODownloadURL(log logrus.FieldLogger, infraEnv *aiv1beta1.InfraEnv) {
+	if infraEnv.Status.ISODownloadURL == "" {
+		return
+	}
+	log.Infof("ISODownloadURL %s held until the pending nmStateConfigs are applied", infraEnv.Status.ISODownloadURL)
+	infraEnv.Status.ISODownloadURL = ""
+	infraEnv.Status.CreatedTime = nil
 }
 
 // Add conditions on ISO generation if relevant. Ignore errors if they mean it's just an image generation in progress.
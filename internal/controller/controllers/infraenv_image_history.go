@@ -0,0 +1,214 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/swag"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const imageDigestLength = 16
+
+// nmStateConfigsDebouncer delays the update of the NMStateConfigs of the discovery images until they stop changing
+// for the debounce period, so a burst of NMStateConfig updates triggers a single generation of the image
+type nmStateConfigsDebouncer struct {
+	lock    sync.Mutex
+	pending map[types.NamespacedName]pendingNMStateConfigs
+}
+
+type pendingNMStateConfigs struct {
+	digest string
+	since  time.Time
+}
+
+// debounce returns the time left before the NMStateConfigs of the InfraEnv with the given digest may be applied
+func (d *nmStateConfigsDebouncer) debounce(key types.NamespacedName, digest string, period time.Duration, now time.Time) time.Duration {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.pending == nil {
+		d.pending = make(map[types.NamespacedName]pendingNMStateConfigs)
+	}
+	pending, ok := d.pending[key]
+	if !ok || pending.digest != digest {
+		pending = pendingNMStateConfigs{digest: digest, since: now}
+		d.pending[key] = pending
+	}
+	remaining := pending.since.Add(period).Sub(now)
+	if remaining <= 0 {
+		delete(d.pending, key)
+		return 0
+	}
+	return remaining
+}
+
+// remaining returns the time left before the pending NMStateConfigs of the InfraEnv may be applied
+func (d *nmStateConfigsDebouncer) remaining(key types.NamespacedName, period time.Duration, now time.Time) time.Duration {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	pending, ok := d.pending[key]
+	if !ok {
+		return 0
+	}
+	if remaining := pending.since.Add(period).Sub(now); remaining > 0 {
+		return remaining
+	}
+	// requeue immediately, the NMStateConfigs are applied by the next reconcile
+	return time.Nanosecond
+}
+
+// forget drops the pending NMStateConfigs of the InfraEnv
+func (d *nmStateConfigsDebouncer) forget(key types.NamespacedName) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.pending, key)
+}
+
+// debounceNMStateConfigs returns true when the update of the NMStateConfigs of the image has to be delayed
+func (r *InfraEnvReconciler) debounceNMStateConfigs(log logrus.FieldLogger, infraEnv *aiv1beta1.InfraEnv,
+	internalInfraEnv *common.InfraEnv, staticNetworkConfig []*models.HostStaticNetworkConfig) bool {
+	if r.Config.ImageRegenerationDebounce <= 0 {
+		return false
+	}
+	key := types.NamespacedName{Namespace: infraEnv.Namespace, Name: infraEnv.Name}
+	desired := staticNetworkConfigDigest(staticNetworkConfig)
+	if current, err := internalStaticNetworkConfigDigest(internalInfraEnv); err == nil && current == desired {
+		r.nmStateConfigs.forget(key)
+		return false
+	}
+	remaining := r.nmStateConfigs.debounce(key, desired, r.Config.ImageRegenerationDebounce, time.Now())
+	if remaining > 0 {
+		log.Infof("nmStateConfigs changed, the image will be updated in %s unless they change again", remaining)
+		return true
+	}
+	return false
+}
+
+// nmStateConfigsRequeueAfter returns when the InfraEnv has to be reconciled again to apply its pending NMStateConfigs
+func (r *InfraEnvReconciler) nmStateConfigsRequeueAfter(infraEnv *aiv1beta1.InfraEnv) time.Duration {
+	if r.Config.ImageRegenerationDebounce <= 0 {
+		return 0
+	}
+	key := types.NamespacedName{Namespace: infraEnv.Namespace, Name: infraEnv.Name}
+	return r.nmStateConfigs.remaining(key, r.Config.ImageRegenerationDebounce, time.Now())
+}
+
+// recordImageGeneration adds a generation to the image history of the InfraEnv when the inputs of its image changed,
+// with the inputs that changed as triggers
+func (r *InfraEnvReconciler) recordImageGeneration(log logrus.FieldLogger, infraEnv *aiv1beta1.InfraEnv, internalInfraEnv *common.InfraEnv) {
+	digests := imageInputDigests(internalInfraEnv)
+	imageID := imageIDFromDigests(digests)
+	if len(infraEnv.Status.ImageHistory) > 0 && infraEnv.Status.ImageHistory[0].ImageID == imageID {
+		return
+	}
+
+	var triggers []aiv1beta1.ImageGenerationTrigger
+	if len(infraEnv.Status.ImageInputDigests) == 0 {
+		triggers = []aiv1beta1.ImageGenerationTrigger{aiv1beta1.ImageGenerationTriggerCreated}
+	} else {
+		for trigger, digest := range digests {
+			if infraEnv.Status.ImageInputDigests[trigger] != digest {
+				triggers = append(triggers, trigger)
+			}
+		}
+		sort.Slice(triggers, func(i, j int) bool { return triggers[i] < triggers[j] })
+	}
+	log.Infof("discovery image %s generated, triggered by %v", imageID, triggers)
+
+	generatedAt := time.Time(internalInfraEnv.GeneratedAt)
+	if generatedAt.IsZero() {
+		generatedAt = time.Now()
+	}
+	generation := aiv1beta1.ImageGeneration{
+		Time:     metav1.NewTime(generatedAt),
+		Triggers: triggers,
+		ImageID:  imageID,
+	}
+	history := append([]aiv1beta1.ImageGeneration{generation}, infraEnv.Status.ImageHistory...)
+	if length := r.Config.ImageHistoryLength; length > 0 && len(history) > length {
+		history = history[:length]
+	}
+	infraEnv.Status.ImageHistory = history
+	infraEnv.Status.ImageInputDigests = digests
+}
+
+// imageInputDigests returns the digests of the inputs of the discovery image of the InfraEnv, by the trigger their
+// change causes
+func imageInputDigests(internalInfraEnv *common.InfraEnv) map[aiv1beta1.ImageGenerationTrigger]string {
+	var proxy string
+	if internalInfraEnv.Proxy != nil {
+		proxy = strings.Join([]string{
+			swag.StringValue(internalInfraEnv.Proxy.HTTPProxy),
+			swag.StringValue(internalInfraEnv.Proxy.HTTPSProxy),
+			swag.StringValue(internalInfraEnv.Proxy.NoProxy),
+		}, "\n")
+	}
+	staticNetworkConfig, err := internalStaticNetworkConfigDigest(internalInfraEnv)
+	if err != nil {
+		staticNetworkConfig = digest(internalInfraEnv.StaticNetworkConfig)
+	}
+	return map[aiv1beta1.ImageGenerationTrigger]string{
+		aiv1beta1.ImageGenerationTriggerNMStateConfig:          staticNetworkConfig,
+		aiv1beta1.ImageGenerationTriggerProxy:                  digest(proxy),
+		aiv1beta1.ImageGenerationTriggerNTPSources:             digest(internalInfraEnv.AdditionalNtpSources),
+		aiv1beta1.ImageGenerationTriggerImageType:              digest(string(common.ImageTypeValue(internalInfraEnv.Type))),
+		aiv1beta1.ImageGenerationTriggerOSImage:                digest(internalInfraEnv.OpenshiftVersion + "\n" + internalInfraEnv.CPUArchitecture),
+		aiv1beta1.ImageGenerationTriggerIgnitionConfigOverride: digest(internalInfraEnv.IgnitionConfigOverride + "\n" + internalInfraEnv.InternalIgnitionConfigOverride),
+		aiv1beta1.ImageGenerationTriggerSSHAuthorizedKey:       digest(internalInfraEnv.SSHAuthorizedKey),
+		aiv1beta1.ImageGenerationTriggerAdditionalTrustBundle:  digest(internalInfraEnv.AdditionalTrustBundle),
+		aiv1beta1.ImageGenerationTriggerKernelArguments:        digest(swag.StringValue(internalInfraEnv.KernelArguments)),
+		aiv1beta1.ImageGenerationTriggerPullSecret:             digest(internalInfraEnv.PullSecret),
+		aiv1beta1.ImageGenerationTriggerMirrorRegistry:         digest(internalInfraEnv.MirrorRegistryConfiguration),
+		aiv1beta1.ImageGenerationTriggerSigningKey:             digest(internalInfraEnv.ImageTokenKey),
+	}
+}
+
+func imageIDFromDigests(digests map[aiv1beta1.ImageGenerationTrigger]string) string {
+	inputs := make([]string, 0, len(digests))
+	for trigger, inputDigest := range digests {
+		inputs = append(inputs, string(trigger)+"="+inputDigest)
+	}
+	sort.Strings(inputs)
+	return digest(strings.Join(inputs, "\n"))
+}
+
+func internalStaticNetworkConfigDigest(internalInfraEnv *common.InfraEnv) (string, error) {
+	var staticNetworkConfig []*models.HostStaticNetworkConfig
+	if internalInfraEnv.StaticNetworkConfig != "" {
+		if err := json.Unmarshal([]byte(internalInfraEnv.StaticNetworkConfig), &staticNetworkConfig); err != nil {
+			return "", err
+		}
+	}
+	return staticNetworkConfigDigest(staticNetworkConfig), nil
+}
+
+// staticNetworkConfigDigest returns a digest of the static network config that doesn't depend on the order of the
+// hosts and of their interfaces
+func staticNetworkConfigDigest(staticNetworkConfig []*models.HostStaticNetworkConfig) string {
+	hosts := make([]string, 0, len(staticNetworkConfig))
+	for _, hostConfig := range staticNetworkConfig {
+		interfaces := make([]string, 0, len(hostConfig.MacInterfaceMap))
+		for _, item := range hostConfig.MacInterfaceMap {
+			interfaces = append(interfaces, item.MacAddress+"="+item.LogicalNicName)
+		}
+		sort.Strings(interfaces)
+		hosts = append(hosts, digest(strings.Join(interfaces, ",")+"\n"+hostConfig.NetworkYaml))
+	}
+	sort.Strings(hosts)
+	return digest(strings.Join(hosts, "\n"))
+}
+
+func digest(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])[:imageDigestLength]
+}
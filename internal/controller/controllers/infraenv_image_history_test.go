@@ -0,0 +1,240 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("infraEnv image history", func() {
+	var (
+		c                     client.Client
+		ir                    *InfraEnvReconciler
+		mockCtrl              *gomock.Controller
+		mockInstallerInternal *bminventory.MockInstallerInternals
+		ctx                   = context.Background()
+		infraEnvID            strfmt.UUID
+		infraEnvImage         *aiv1beta1.InfraEnv
+		key                   = types.NamespacedName{Namespace: testNamespace, Name: "infraEnvImage"}
+	)
+
+	newBackendInfraEnv := func(modify func(*common.InfraEnv)) *common.InfraEnv {
+		infraEnv := &common.InfraEnv{
+			InfraEnv: models.InfraEnv{
+				ID:               &infraEnvID,
+				DownloadURL:      "https://downloadurl",
+				CPUArchitecture:  "x86_64",
+				OpenshiftVersion: "4.14",
+				Proxy:            &models.Proxy{HTTPProxy: swag.String("http://proxy.example.com")},
+			},
+			GeneratedAt:   strfmt.DateTime(time.Now().Truncate(time.Second)),
+			ImageTokenKey: "key",
+		}
+		if modify != nil {
+			modify(infraEnv)
+		}
+		return infraEnv
+	}
+
+	reconcile := func(backendInfraEnv *common.InfraEnv) ctrl.Result {
+		mockInstallerInternal.EXPECT().GetInfraEnvByKubeKey(gomock.Any()).Return(backendInfraEnv, nil)
+		mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockInstallerInternal.EXPECT().UpdateInfraEnvInternal(gomock.Any(), gomock.Any(), nil, nil).Return(backendInfraEnv, nil)
+		result, err := ir.Reconcile(ctx, newInfraEnvRequest(infraEnvImage))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.Get(ctx, key, infraEnvImage)).To(Succeed())
+		return result
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithStatusSubresource(&aiv1beta1.InfraEnv{}).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockInstallerInternal = bminventory.NewMockInstallerInternals(mockCtrl)
+		mockOSImages := versions.NewMockOSImages(mockCtrl)
+		mockOSImages.EXPECT().GetOsImageOrLatest(gomock.Any(), gomock.Any()).Return(&models.OsImage{
+			CPUArchitecture: swag.String("x86_64"), OpenshiftVersion: swag.String("4.14")}, nil).AnyTimes()
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		ir = &InfraEnvReconciler{
+			Client:              c,
+			Config:              InfraEnvConfig{ImageType: models.ImageTypeMinimalIso, ImageHistoryLength: 10},
+			Log:                 common.GetTestLog(),
+			Installer:           mockInstallerInternal,
+			APIReader:           c,
+			ServiceBaseURL:      "https://www.acme.com",
+			ImageServiceBaseURL: "https://images.example.com",
+			OsImages:            mockOSImages,
+			PullSecretHandler:   NewPullSecretHandler(c, c, mockInstallerInternal),
+			AuthType:            auth.TypeNone,
+			ImageServiceEnabled: true,
+		}
+		Expect(c.Create(ctx, getDefaultTestPullSecret("pull-secret", testNamespace))).To(Succeed())
+		infraEnvImage = newInfraEnvImage("infraEnvImage", testNamespace, aiv1beta1.InfraEnvSpec{
+			PullSecretRef:              &corev1.LocalObjectReference{Name: "pull-secret"},
+			NMStateConfigLabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"nmstate": "infraEnvImage"}},
+		})
+		Expect(c.Create(ctx, infraEnvImage)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("records the generations of the image with their triggers", func() {
+		backendInfraEnv := newBackendInfraEnv(nil)
+		reconcile(backendInfraEnv)
+		Expect(infraEnvImage.Status.ImageHistory).To(HaveLen(1))
+		first := infraEnvImage.Status.ImageHistory[0]
+		Expect(first.Triggers).To(Equal([]aiv1beta1.ImageGenerationTrigger{aiv1beta1.ImageGenerationTriggerCreated}))
+		Expect(first.ImageID).ToNot(BeEmpty())
+		Expect(first.Time.Time.Equal(time.Time(backendInfraEnv.GeneratedAt))).To(BeTrue())
+
+		By("not recording a generation when the image didn't change")
+		reconcile(backendInfraEnv)
+		Expect(infraEnvImage.Status.ImageHistory).To(HaveLen(1))
+
+		By("recording the changed inputs as triggers")
+		reconcile(newBackendInfraEnv(func(infraEnv *common.InfraEnv) {
+			infraEnv.Proxy = &models.Proxy{HTTPProxy: swag.String("http://other-proxy.example.com")}
+			infraEnv.ImageTokenKey = "regenerated-key"
+		}))
+		Expect(infraEnvImage.Status.ImageHistory).To(HaveLen(2))
+		Expect(infraEnvImage.Status.ImageHistory[0].Triggers).To(Equal([]aiv1beta1.ImageGenerationTrigger{
+			aiv1beta1.ImageGenerationTriggerProxy,
+			aiv1beta1.ImageGenerationTriggerSigningKey,
+		}))
+		Expect(infraEnvImage.Status.ImageHistory[0].ImageID).ToNot(Equal(first.ImageID))
+		Expect(infraEnvImage.Status.ImageHistory[1]).To(Equal(first))
+	})
+
+	It("keeps the latest generations of the image", func() {
+		ir.Config.ImageHistoryLength = 2
+		for _, sshKey := range []string{"key1", "key2", "key3"} {
+			reconcile(newBackendInfraEnv(func(infraEnv *common.InfraEnv) {
+				infraEnv.SSHAuthorizedKey = sshKey
+			}))
+		}
+		Expect(infraEnvImage.Status.ImageHistory).To(HaveLen(2))
+		for _, generation := range infraEnvImage.Status.ImageHistory {
+			Expect(generation.Triggers).To(Equal([]aiv1beta1.ImageGenerationTrigger{aiv1beta1.ImageGenerationTriggerSSHAuthorizedKey}))
+		}
+	})
+
+	Context("NMStateConfig debounce", func() {
+		var nmStateConfig *aiv1beta1.NMStateConfig
+
+		BeforeEach(func() {
+			ir.Config.ImageRegenerationDebounce = time.Hour
+			nmStateConfig = newNMStateConfig("nmstate", testNamespace, "nmstate", "infraEnvImage", aiv1beta1.NMStateConfigSpec{
+				Interfaces: []*aiv1beta1.Interface{{Name: "eth0", MacAddress: "09:23:0f:d8:92:AA"}},
+				NetConfig:  aiv1beta1.NetConfig{Raw: []byte("interfaces: []")},
+			})
+			Expect(c.Create(ctx, nmStateConfig)).To(Succeed())
+		})
+
+		expectStaticNetworkConfig := func(backendInfraEnv *common.InfraEnv, expected gomegatypes.GomegaMatcher) ctrl.Result {
+			mockInstallerInternal.EXPECT().GetInfraEnvByKubeKey(gomock.Any()).Return(backendInfraEnv, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockInstallerInternal.EXPECT().UpdateInfraEnvInternal(gomock.Any(), gomock.Any(), nil, nil).
+				Do(func(ctx context.Context, params installer.UpdateInfraEnvParams, internalIgnitionConfig *string, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) {
+					Expect(params.InfraEnvUpdateParams.StaticNetworkConfig).To(expected)
+				}).Return(backendInfraEnv, nil)
+			result, err := ir.Reconcile(ctx, newInfraEnvRequest(infraEnvImage))
+			Expect(err).ToNot(HaveOccurred())
+			return result
+		}
+
+		expectImageHeld := func(held bool) {
+			Expect(c.Get(ctx, key, infraEnvImage)).To(Succeed())
+			condition := conditionsv1.FindStatusCondition(infraEnvImage.Status.Conditions, aiv1beta1.ImageCreatedCondition)
+			Expect(condition).ToNot(BeNil())
+			if held {
+				Expect(infraEnvImage.Status.ISODownloadURL).To(BeEmpty())
+				Expect(infraEnvImage.Status.CreatedTime).To(BeNil())
+				Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+				Expect(condition.Reason).To(Equal(aiv1beta1.ImageUpdatePendingReason))
+			} else {
+				Expect(infraEnvImage.Status.ISODownloadURL).To(Equal("https://downloadurl"))
+				Expect(infraEnvImage.Status.CreatedTime).ToNot(BeNil())
+				Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(aiv1beta1.InfraEnvAvailableReason))
+			}
+		}
+
+		It("applies the NMStateConfigs once they stop changing", func() {
+			backendInfraEnv := newBackendInfraEnv(nil)
+			result := expectStaticNetworkConfig(backendInfraEnv, BeNil())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+			expectImageHeld(true)
+
+			By("restarting the debounce period when the NMStateConfigs change again")
+			pending := ir.nmStateConfigs.pending[key]
+			Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "nmstate"}, nmStateConfig)).To(Succeed())
+			nmStateConfig.Spec.NetConfig.Raw = []byte("interfaces: [eth0]")
+			Expect(c.Update(ctx, nmStateConfig)).To(Succeed())
+			expectStaticNetworkConfig(backendInfraEnv, BeNil())
+			Expect(ir.nmStateConfigs.pending[key].digest).ToNot(Equal(pending.digest))
+
+			By("applying the NMStateConfigs after the debounce period")
+			ir.nmStateConfigs.pending[key] = pendingNMStateConfigs{
+				digest: ir.nmStateConfigs.pending[key].digest,
+				since:  time.Now().Add(-2 * time.Hour),
+			}
+			result = expectStaticNetworkConfig(backendInfraEnv, HaveLen(1))
+			Expect(result.RequeueAfter).To(BeZero())
+			Expect(ir.nmStateConfigs.pending).ToNot(HaveKey(key))
+			expectImageHeld(false)
+		})
+
+		It("doesn't delay the update when the image has the NMStateConfigs", func() {
+			Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "nmstate"}, nmStateConfig)).To(Succeed())
+			staticNetworkConfig, err := json.Marshal([]*models.HostStaticNetworkConfig{{
+				MacInterfaceMap: BuildMacInterfaceMap(common.GetTestLog(), *nmStateConfig),
+				NetworkYaml:     string(nmStateConfig.Spec.NetConfig.Raw),
+			}})
+			Expect(err).ToNot(HaveOccurred())
+			backendInfraEnv := newBackendInfraEnv(func(infraEnv *common.InfraEnv) {
+				infraEnv.StaticNetworkConfig = string(staticNetworkConfig)
+			})
+			result := expectStaticNetworkConfig(backendInfraEnv, HaveLen(1))
+			Expect(result.RequeueAfter).To(BeZero())
+		})
+	})
+})
+
+var _ = Describe("nmStateConfigsDebouncer", func() {
+	It("waits for the NMStateConfigs to stop changing", func() {
+		var debouncer nmStateConfigsDebouncer
+		key := types.NamespacedName{Namespace: testNamespace, Name: "infraEnv"}
+		now := time.Now()
+		Expect(debouncer.debounce(key, "a", time.Minute, now)).To(Equal(time.Minute))
+		Expect(debouncer.debounce(key, "a", time.Minute, now.Add(30*time.Second))).To(Equal(30 * time.Second))
+		Expect(debouncer.remaining(key, time.Minute, now.Add(40*time.Second))).To(Equal(20 * time.Second))
+		Expect(debouncer.debounce(key, "b", time.Minute, now.Add(40*time.Second))).To(Equal(time.Minute))
+		Expect(debouncer.debounce(key, "b", time.Minute, now.Add(100*time.Second))).To(BeZero())
+		Expect(debouncer.remaining(key, time.Minute, now.Add(100*time.Second))).To(BeZero())
+	})
+})
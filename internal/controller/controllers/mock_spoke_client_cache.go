@@ -5,6 +5,7 @@
 package controllers
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +37,20 @@ func (m *MockSpokeClientCache) EXPECT() *MockSpokeClientCacheMockRecorder {
 	return m.recorder
 }
 
+// CheckHealth mocks base method.
+func (m *MockSpokeClientCache) CheckHealth(arg0 context.Context, arg1 *v1.ClusterDeployment, arg2 *v10.Secret) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckHealth", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckHealth indicates an expected call of CheckHealth.
+func (mr *MockSpokeClientCacheMockRecorder) CheckHealth(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockSpokeClientCache)(nil).CheckHealth), arg0, arg1, arg2)
+}
+
 // Get mocks base method.
 func (m *MockSpokeClientCache) Get(arg0 *v1.ClusterDeployment, arg1 *v10.Secret) (spoke_k8s_client.SpokeK8sClient, error) {
 	m.ctrl.T.Helper()
@@ -50,3 +65,17 @@ func (mr *MockSpokeClientCacheMockRecorder) Get(arg0, arg1 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSpokeClientCache)(nil).Get), arg0, arg1)
 }
+
+// Start mocks base method.
+func (m *MockSpokeClientCache) Start(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockSpokeClientCacheMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockSpokeClientCache)(nil).Start), arg0)
+}
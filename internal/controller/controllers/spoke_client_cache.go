@@ -1,38 +1,93 @@
 package controllers
 
 import (
+	"container/list"
+	"context"
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
+const (
+	defaultSpokeClientCacheMaxSize     = 1000
+	defaultSpokeClientCacheIdleTimeout = 30 * time.Minute
+	defaultSpokeClientHealthInterval   = 5 * time.Minute
+	spokeClientHealthCheckTimeout      = 10 * time.Second
+)
+
+type SpokeClientCacheConfig struct {
+	// MaxSize is the maximum number of cached clients, the least recently used clients are evicted first
+	MaxSize int `envconfig:"SPOKE_CLIENT_CACHE_MAX_SIZE" default:"1000"`
+	// IdleTimeout is the time after which a client that wasn't used is evicted
+	IdleTimeout time.Duration `envconfig:"SPOKE_CLIENT_CACHE_IDLE_TIMEOUT" default:"30m"`
+	// HealthCheckInterval is the interval of the health checks of the cached clients
+	HealthCheckInterval time.Duration `envconfig:"SPOKE_CLIENT_HEALTH_CHECK_INTERVAL" default:"5m"`
+}
+
+func (c *SpokeClientCacheConfig) setDefaults() {
+	if c.MaxSize <= 0 {
+		c.MaxSize = defaultSpokeClientCacheMaxSize
+	}
+	if c.IdleTimeout <= 0 {
+		c.IdleTimeout = defaultSpokeClientCacheIdleTimeout
+	}
+	if c.HealthCheckInterval <= 0 {
+		c.HealthCheckInterval = defaultSpokeClientHealthInterval
+	}
+}
+
 //go:generate mockgen --build_flags=--mod=mod -package=controllers -destination=mock_spoke_client_cache.go . SpokeClientCache
 type SpokeClientCache interface {
 	Get(clusterDeployment *hivev1.ClusterDeployment, secret *corev1.Secret) (spoke_k8s_client.SpokeK8sClient, error)
+	// CheckHealth checks that the API of the spoke cluster is reachable with the client of the given secret
+	CheckHealth(ctx context.Context, clusterDeployment *hivev1.ClusterDeployment, secret *corev1.Secret) error
+	// Start evicts the idle clients and checks the health of the cached clients periodically, until the context is
+	// done
+	Start(ctx context.Context) error
 }
 
 type spokeClientCache struct {
 	sync.Mutex
+	log           logrus.FieldLogger
 	clientFactory spoke_k8s_client.SpokeK8sClientFactory
-	clientMap     map[string]*spokeClient
+	config        SpokeClientCacheConfig
+	metrics       *spoke_k8s_client.Metrics
+	clientMap     map[string]*list.Element
+	// lru holds the cached clients, the most recently used first
+	lru *list.List
+	now func() time.Time
 }
 
 type spokeClient struct {
-	spokeK8sClient *spoke_k8s_client.SpokeK8sClient
+	key            string
+	spoke          string
+	spokeK8sClient spoke_k8s_client.SpokeK8sClient
 	secretHash     string
+	lastUsed       time.Time
 }
 
-func NewSpokeClientCache(clientFactory spoke_k8s_client.SpokeK8sClientFactory) SpokeClientCache {
+// NewSpokeClientCache creates a cache of spoke clients bounded by the given configuration, the zero values of the
+// configuration are replaced by the defaults. The metrics are optional.
+func NewSpokeClientCache(log logrus.FieldLogger, clientFactory spoke_k8s_client.SpokeK8sClientFactory,
+	config SpokeClientCacheConfig, metrics *spoke_k8s_client.Metrics) SpokeClientCache {
+	config.setDefaults()
 	return &spokeClientCache{
+		log:           log,
 		clientFactory: clientFactory,
-		clientMap:     map[string]*spokeClient{},
+		config:        config,
+		metrics:       metrics,
+		clientMap:     map[string]*list.Element{},
+		lru:           list.New(),
+		now:           time.Now,
 	}
 }
 
@@ -42,6 +97,7 @@ func (c *spokeClientCache) Get(clusterDeployment *hivev1.ClusterDeployment,
 	secret *corev1.Secret) (spoke_k8s_client.SpokeK8sClient, error) {
 	c.Lock()
 	defer c.Unlock()
+	defer c.updateSize()
 
 	// Compute a hash for the content of the secret:
 	secretHash, err := c.calculateSecretHash(secret)
@@ -49,22 +105,109 @@ func (c *spokeClientCache) Get(clusterDeployment *hivev1.ClusterDeployment,
 		return nil, err
 	}
 
+	now := c.now()
+	c.evictIdle(now)
+
 	// Get client from cache or create a new one if not available
-	key := types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}
-	client, present := c.clientMap[key.String()]
-	if !present || client.secretHash != secretHash {
-		spokeK8sClient, err := c.clientFactory.CreateFromSecret(clusterDeployment, secret)
-		if err != nil {
-			return nil, errors.Wrapf(err, "Failed to create client using secret '%s'", secret.Name)
+	key := types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}.String()
+	if elem, present := c.clientMap[key]; present {
+		client := elem.Value.(*spokeClient)
+		if client.secretHash == secretHash {
+			client.lastUsed = now
+			c.lru.MoveToFront(elem)
+			return client.spokeK8sClient, nil
+		}
+		c.remove(elem)
+	}
+
+	spokeK8sClient, err := c.clientFactory.CreateFromSecret(clusterDeployment, secret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to create client using secret '%s'", secret.Name)
+	}
+	c.clientMap[key] = c.lru.PushFront(&spokeClient{
+		key:            key,
+		spoke:          spoke_k8s_client.SpokeName(clusterDeployment, secret),
+		spokeK8sClient: spokeK8sClient,
+		secretHash:     secretHash,
+		lastUsed:       now,
+	})
+	for c.lru.Len() > c.config.MaxSize {
+		c.remove(c.lru.Back())
+	}
+	return spokeK8sClient, nil
+}
+
+func (c *spokeClientCache) CheckHealth(ctx context.Context, clusterDeployment *hivev1.ClusterDeployment,
+	secret *corev1.Secret) error {
+	client, err := c.Get(clusterDeployment, secret)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, spokeClientHealthCheckTimeout)
+	defer cancel()
+	if err = client.CheckHealth(ctx); err != nil {
+		return errors.Wrapf(err, "the API of spoke cluster %s is unreachable",
+			spoke_k8s_client.SpokeName(clusterDeployment, secret))
+	}
+	return nil
+}
+
+func (c *spokeClientCache) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.config.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			c.checkCachedClients(ctx)
 		}
-		client = &spokeClient{
-			spokeK8sClient: &spokeK8sClient,
-			secretHash:     secretHash,
+	}
+}
+
+// checkCachedClients evicts the idle clients and checks the health of the others. The checks run without the lock,
+// so that an unreachable spoke doesn't block the reconcilers.
+func (c *spokeClientCache) checkCachedClients(ctx context.Context) {
+	c.Lock()
+	c.evictIdle(c.now())
+	c.updateSize()
+	clients := make([]spokeClient, 0, c.lru.Len())
+	for elem := c.lru.Front(); elem != nil; elem = elem.Next() {
+		clients = append(clients, *elem.Value.(*spokeClient))
+	}
+	c.Unlock()
+
+	for _, client := range clients {
+		checkCtx, cancel := context.WithTimeout(ctx, spokeClientHealthCheckTimeout)
+		if err := client.spokeK8sClient.CheckHealth(checkCtx); err != nil {
+			c.log.WithError(err).Warnf("The API of spoke cluster %s is unreachable", client.spoke)
 		}
-		c.clientMap[key.String()] = client
+		cancel()
 	}
+}
+
+func (c *spokeClientCache) evictIdle(now time.Time) {
+	for elem := c.lru.Back(); elem != nil; elem = c.lru.Back() {
+		if now.Sub(elem.Value.(*spokeClient).lastUsed) < c.config.IdleTimeout {
+			return
+		}
+		c.remove(elem)
+	}
+}
 
-	return *client.spokeK8sClient, nil
+func (c *spokeClientCache) remove(elem *list.Element) {
+	client := c.lru.Remove(elem).(*spokeClient)
+	delete(c.clientMap, client.key)
+	client.spokeK8sClient.Close()
+	if c.metrics != nil {
+		c.metrics.DeleteSpoke(client.spoke)
+	}
+}
+
+func (c *spokeClientCache) updateSize() {
+	if c.metrics != nil {
+		c.metrics.SetCacheSize(c.lru.Len())
+	}
 }
 
 func (c *spokeClientCache) calculateSecretHash(secret *corev1.Secret) (string, error) {
@@ -72,6 +215,6 @@ func (c *spokeClientCache) calculateSecretHash(secret *corev1.Secret) (string, e
 	if err != nil {
 		return "", err
 	}
-	hash := fmt.Sprintf("%x", sha256.New().Sum(data))
+	hash := fmt.Sprintf("%x", sha256.Sum256(data))
 	return hash, nil
 }
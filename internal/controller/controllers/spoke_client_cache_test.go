@@ -1,12 +1,19 @@
 package controllers
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -42,7 +49,7 @@ var _ = Context("with kubeconfig test secret", func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockSpokeClient = spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
 		mockSpokeFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
-		clientCache = NewSpokeClientCache(mockSpokeFactory)
+		clientCache = NewSpokeClientCache(common.GetTestLog(), mockSpokeFactory, SpokeClientCacheConfig{}, nil)
 		kubeconfigSecret = newKubeconfigSecret()
 	})
 
@@ -79,7 +86,8 @@ var _ = Context("with kubeconfig test secret", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(client).To(Equal(mockSpokeClient))
 
-			// create a client from a new kubeconfig
+			// create a client from a new kubeconfig, the previous client is closed
+			mockSpokeClient.EXPECT().Close()
 			newKubeconfigSecret := kubeconfigSecret.DeepCopy()
 			newKubeconfigSecret.Data["kubeconfig"] = []byte("new")
 			mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), newKubeconfigSecret).Return(mockSpokeClient, nil)
@@ -97,4 +105,114 @@ var _ = Context("with kubeconfig test secret", func() {
 			Expect(err.Error()).Should(ContainSubstring("Failed to create client using secret"))
 		})
 	})
+
+	Describe("Eviction", func() {
+		var (
+			now    time.Time
+			cache  *spokeClientCache
+			secret = func(name string) *corev1.Secret {
+				s := newKubeconfigSecret()
+				s.Name = name
+				return s
+			}
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+			cache = NewSpokeClientCache(common.GetTestLog(), mockSpokeFactory, SpokeClientCacheConfig{
+				MaxSize:     2,
+				IdleTimeout: time.Hour,
+			}, nil).(*spokeClientCache)
+			cache.now = func() time.Time { return now }
+		})
+
+		It("evicts the least recently used client when the cache is full", func() {
+			clients := map[string]*spoke_k8s_client.MockSpokeK8sClient{}
+			for _, name := range []string{"a", "b", "c"} {
+				clients[name] = spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+			}
+			for _, name := range []string{"a", "b"} {
+				mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), secret(name)).Return(clients[name], nil)
+				_, err := cache.Get(nil, secret(name))
+				Expect(err).ToNot(HaveOccurred())
+				now = now.Add(time.Minute)
+			}
+			// "a" is used again, so "b" is the least recently used
+			_, err := cache.Get(nil, secret("a"))
+			Expect(err).ToNot(HaveOccurred())
+
+			clients["b"].EXPECT().Close()
+			mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), secret("c")).Return(clients["c"], nil)
+			_, err = cache.Get(nil, secret("c"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cache.clientMap).To(HaveLen(2))
+			Expect(cache.clientMap).To(HaveKey(fmt.Sprintf("%s/a", testKubeconfigSecretNamespace)))
+			Expect(cache.clientMap).To(HaveKey(fmt.Sprintf("%s/c", testKubeconfigSecretNamespace)))
+		})
+
+		It("evicts the idle clients", func() {
+			mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), secret("a")).Return(mockSpokeClient, nil)
+			_, err := cache.Get(nil, secret("a"))
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(2 * time.Hour)
+			mockSpokeClient.EXPECT().Close()
+			otherClient := spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+			mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), secret("b")).Return(otherClient, nil)
+			_, err = cache.Get(nil, secret("b"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cache.clientMap).To(HaveLen(1))
+		})
+
+		It("evicts the idle clients before the health checks", func() {
+			mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), secret("a")).Return(mockSpokeClient, nil)
+			_, err := cache.Get(nil, secret("a"))
+			Expect(err).ToNot(HaveOccurred())
+			otherClient := spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+			mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), secret("b")).Return(otherClient, nil)
+			now = now.Add(30 * time.Minute)
+			_, err = cache.Get(nil, secret("b"))
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(45 * time.Minute)
+			mockSpokeClient.EXPECT().Close()
+			otherClient.EXPECT().CheckHealth(gomock.Any()).Return(errors.New("connection refused"))
+			cache.checkCachedClients(context.Background())
+			Expect(cache.clientMap).To(HaveLen(1))
+		})
+	})
+
+	Describe("CheckHealth", func() {
+		It("succeeds when the API is reachable", func() {
+			mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), kubeconfigSecret).Return(mockSpokeClient, nil)
+			mockSpokeClient.EXPECT().CheckHealth(gomock.Any()).Return(nil)
+			Expect(clientCache.CheckHealth(context.Background(), nil, kubeconfigSecret)).To(Succeed())
+		})
+
+		It("fails when the API is unreachable", func() {
+			mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), kubeconfigSecret).Return(mockSpokeClient, nil)
+			mockSpokeClient.EXPECT().CheckHealth(gomock.Any()).Return(errors.New("connection refused"))
+			err := clientCache.CheckHealth(context.Background(), nil, kubeconfigSecret)
+			Expect(err).To(MatchError(ContainSubstring("the API of spoke cluster test-namespace/test-secret is unreachable")))
+		})
+
+		It("fails when the client can't be created", func() {
+			mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), kubeconfigSecret).Return(nil, errors.New("error"))
+			Expect(clientCache.CheckHealth(context.Background(), nil, kubeconfigSecret)).ToNot(Succeed())
+		})
+	})
+
+	It("reports the size of the cache", func() {
+		registry := prometheus.NewRegistry()
+		metrics := spoke_k8s_client.NewMetrics(registry)
+		clientCache = NewSpokeClientCache(common.GetTestLog(), mockSpokeFactory, SpokeClientCacheConfig{}, metrics)
+		mockSpokeFactory.EXPECT().CreateFromSecret(gomock.Any(), kubeconfigSecret).Return(mockSpokeClient, nil)
+		_, err := clientCache.Get(nil, kubeconfigSecret)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP assisted_installer_spoke_client_cache_size The number of spoke clients in the cache
+# TYPE assisted_installer_spoke_client_cache_size gauge
+assisted_installer_spoke_client_cache_size 1
+`), "assisted_installer_spoke_client_cache_size")).To(Succeed())
+	})
 })
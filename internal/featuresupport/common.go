@@ -13,7 +13,7 @@ import (
 
 func GetSupportLevel[T models.FeatureSupportLevelID | models.ArchitectureSupportLevelID](featureId T, filters interface{}) models.SupportLevel {
 	if reflect.TypeOf(featureId).Name() == "FeatureSupportLevelID" {
-		ret, _ := featuresList[models.FeatureSupportLevelID(featureId)].getSupportLevel(filters.(SupportLevelFilters))
+		ret, _ := getFeature(models.FeatureSupportLevelID(featureId)).getSupportLevel(filters.(SupportLevelFilters))
 		return ret
 	}
 	return cpuFeaturesList[models.ArchitectureSupportLevelID(featureId)].getSupportLevel(filters.(string))
@@ -101,7 +101,7 @@ func getActivatedFeatures(log logrus.FieldLogger, cluster *common.Cluster, infra
 		}
 	}
 
-	for _, feature := range featuresList {
+	for _, feature := range getFeatures() {
 		if feature.getFeatureActiveLevel(cluster, infraEnv, clusterUpdateParams, infraenvUpdateParams) == activeLevelActive {
 			activatedFeatures = append(activatedFeatures, feature)
 			log.Debugf("%s feature is activated", feature.GetName())
@@ -112,7 +112,7 @@ func getActivatedFeatures(log logrus.FieldLogger, cluster *common.Cluster, infra
 }
 
 func IsFeatureCompatibleWithArchitecture(feature models.FeatureSupportLevelID, openshiftVersion, cpuArchitecture string) bool {
-	return isFeatureCompatibleWithArchitecture(featuresList[feature], openshiftVersion, cpuArchitecture)
+	return isFeatureCompatibleWithArchitecture(getFeature(feature), openshiftVersion, cpuArchitecture)
 }
 
 func isFeatureCompatibleWithArchitecture(feature SupportLevelFeature, openshiftVersion, cpuArchitecture string) bool {
@@ -72,7 +72,7 @@ var featuresList = map[models.FeatureSupportLevelID]SupportLevelFeature{
 }
 
 func GetFeatureByID(featureID models.FeatureSupportLevelID) SupportLevelFeature {
-	return featuresList[featureID]
+	return getFeature(featureID)
 }
 
 func getFeatureSupportList(features map[models.FeatureSupportLevelID]SupportLevelFeature, filters SupportLevelFilters) []models.Feature {
@@ -160,9 +160,10 @@ func GetFeatureSupportList(openshiftVersion string, cpuArchitecture *string, pla
 	if cpuArchitecture == nil {
 		filters.CPUArchitecture = swag.String(common.DefaultCPUArchitecture)
 	}
-	featuresSupportList := overrideInvalidRequest(featuresList, *filters.CPUArchitecture, openshiftVersion)
+	features := getFeatures()
+	featuresSupportList := overrideInvalidRequest(features, *filters.CPUArchitecture, openshiftVersion)
 	if featuresSupportList == nil {
-		featuresSupportList = getFeatureSupportList(featuresList, filters)
+		featuresSupportList = getFeatureSupportList(features, filters)
 	}
 
 	return featuresSupportList
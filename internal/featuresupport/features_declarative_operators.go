@@ -0,0 +1,145 @@
+package featuresupport
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
+	"github.com/openshift/assisted-service/models"
+)
+
+var (
+	// declarativeFeatures are the features of the declarative operators, indexed by their feature ID.  They are kept
+	// apart from the builtin features because they are registered when the operators are loaded, while the API
+	// handlers may already read the features.
+	declarativeFeatures     = map[models.FeatureSupportLevelID]*DeclarativeOperatorFeature{}
+	declarativeFeaturesLock sync.RWMutex
+)
+
+// DeclarativeOperatorFeature describes the support for an operator loaded from a declarative definition
+type DeclarativeOperatorFeature struct {
+	definition *declarative.Definition
+}
+
+func (f *DeclarativeOperatorFeature) New() SupportLevelFeature {
+	return &DeclarativeOperatorFeature{definition: f.definition}
+}
+
+func (f *DeclarativeOperatorFeature) getId() models.FeatureSupportLevelID {
+	return models.FeatureSupportLevelID(f.definition.FeatureSupport.ID)
+}
+
+func (f *DeclarativeOperatorFeature) GetName() string {
+	return f.definition.FullName
+}
+
+func (f *DeclarativeOperatorFeature) getSupportLevel(filters SupportLevelFilters) (models.SupportLevel, models.IncompatibilityReason) {
+	if !isFeatureCompatibleWithArchitecture(f, filters.OpenshiftVersion, swag.StringValue(filters.CPUArchitecture)) {
+		return models.SupportLevelUnavailable, models.IncompatibilityReasonCPUArchitecture
+	}
+
+	if minVersion := f.definition.FeatureSupport.MinOpenshiftVersion; minVersion != "" {
+		if isNotSupported, err := common.BaseVersionLessThan(minVersion, filters.OpenshiftVersion); isNotSupported || err != nil {
+			return models.SupportLevelUnavailable, models.IncompatibilityReasonOpenshiftVersion
+		}
+	}
+
+	return f.definition.FeatureSupport.SupportLevel, ""
+}
+
+func (f *DeclarativeOperatorFeature) getIncompatibleArchitectures(_ *string) []models.ArchitectureSupportLevelID {
+	ret := []models.ArchitectureSupportLevelID{}
+	if len(f.definition.FeatureSupport.Architectures) == 0 {
+		return ret
+	}
+	for architecture, architectureID := range cpuArchitectureFeatureIdMap {
+		if !slices.Contains(f.definition.FeatureSupport.Architectures, architecture) {
+			ret = append(ret, architectureID)
+		}
+	}
+	slices.Sort(ret)
+	return ret
+}
+
+func (f *DeclarativeOperatorFeature) getIncompatibleFeatures(string) []models.FeatureSupportLevelID {
+	return f.definition.FeatureSupport.IncompatibleFeatures
+}
+
+func (f *DeclarativeOperatorFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *models.InfraEnv, clusterUpdateParams *models.V2ClusterUpdateParams, _ *models.InfraEnvUpdateParams) featureActiveLevel {
+	if isOperatorActivated(f.definition.Name, cluster, clusterUpdateParams) {
+		return activeLevelActive
+	}
+	return activeLevelNotActive
+}
+
+// RegisterDeclarativeOperatorFeature adds the feature of a declarative operator to the list of features.  Registering
+// the same operator again replaces its feature, but the feature ID can't be used by any other feature.
+func RegisterDeclarativeOperatorFeature(definition *declarative.Definition) error {
+	feature := &DeclarativeOperatorFeature{definition: definition}
+	if existing, ok := featuresList[feature.getId()]; ok {
+		return fmt.Errorf("feature ID %s of operator %s is already used by feature %s", feature.getId(), definition.Name, existing.GetName())
+	}
+
+	declarativeFeaturesLock.Lock()
+	defer declarativeFeaturesLock.Unlock()
+	if existing, ok := declarativeFeatures[feature.getId()]; ok && existing.definition.Name != definition.Name {
+		return fmt.Errorf("feature ID %s of operator %s is already used by feature %s", feature.getId(), definition.Name, existing.GetName())
+	}
+	declarativeFeatures[feature.getId()] = feature
+	return nil
+}
+
+// getFeature returns the builtin or declarative feature with the given ID, nil when there is none
+func getFeature(featureID models.FeatureSupportLevelID) SupportLevelFeature {
+	if feature, ok := featuresList[featureID]; ok {
+		return feature
+	}
+
+	declarativeFeaturesLock.RLock()
+	defer declarativeFeaturesLock.RUnlock()
+	if feature, ok := declarativeFeatures[featureID]; ok {
+		return feature
+	}
+	return nil
+}
+
+// getFeatures returns the builtin and the declarative features
+func getFeatures() map[models.FeatureSupportLevelID]SupportLevelFeature {
+	ret := maps.Clone(featuresList)
+
+	declarativeFeaturesLock.RLock()
+	defer declarativeFeaturesLock.RUnlock()
+	for featureID, feature := range declarativeFeatures {
+		ret[featureID] = feature
+	}
+	return ret
+}
+
+// APIFeatureSupportLevelID returns the ID of the feature in the API.  The IDs of the declarative operators aren't
+// values of the API enum, so they are all reported as DECLARATIVE_OPERATOR, and the operators are told apart by name.
+func APIFeatureSupportLevelID(featureID models.FeatureSupportLevelID) models.FeatureSupportLevelID {
+	declarativeFeaturesLock.RLock()
+	defer declarativeFeaturesLock.RUnlock()
+	if _, ok := declarativeFeatures[featureID]; ok {
+		return models.FeatureSupportLevelIDDECLARATIVEOPERATOR
+	}
+	return featureID
+}
+
+// APIFeatureSupportLevelIDs returns the IDs of the features in the API, see APIFeatureSupportLevelID
+func APIFeatureSupportLevelIDs(featureIDs []models.FeatureSupportLevelID) []models.FeatureSupportLevelID {
+	if featureIDs == nil {
+		return nil
+	}
+	ret := make([]models.FeatureSupportLevelID, 0, len(featureIDs))
+	for _, featureID := range featureIDs {
+		if apiFeatureID := APIFeatureSupportLevelID(featureID); !slices.Contains(ret, apiFeatureID) {
+			ret = append(ret, apiFeatureID)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,72 @@
+package featuresupport
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Declarative operator features", func() {
+	parse := func(content string) *declarative.Definition {
+		definition, err := declarative.ParseDefinition([]byte(content))
+		Expect(err).ToNot(HaveOccurred())
+		return definition
+	}
+
+	AfterEach(func() {
+		declarativeFeaturesLock.Lock()
+		defer declarativeFeaturesLock.Unlock()
+		declarativeFeatures = map[models.FeatureSupportLevelID]*DeclarativeOperatorFeature{}
+	})
+
+	It("registers the feature of the operator", func() {
+		Expect(RegisterDeclarativeOperatorFeature(parse("name: my-operator\nnamespace: ns"))).To(Succeed())
+		Expect(GetFeatureByID("MY_OPERATOR").GetName()).To(Equal("my-operator"))
+		Expect(IsFeatureAvailable("MY_OPERATOR", "4.17", nil)).To(BeTrue())
+		Expect(GetFeatureSupportList("4.17", nil, nil, nil)).To(ContainElement(HaveField("FeatureSupportLevelID", models.FeatureSupportLevelID("MY_OPERATOR"))))
+	})
+
+	It("replaces the feature of an operator registered again", func() {
+		Expect(RegisterDeclarativeOperatorFeature(parse("name: my-operator\nnamespace: ns"))).To(Succeed())
+		Expect(RegisterDeclarativeOperatorFeature(parse("name: my-operator\nnamespace: ns\nfullName: My Operator"))).To(Succeed())
+		Expect(GetFeatureByID("MY_OPERATOR").GetName()).To(Equal("My Operator"))
+	})
+
+	It("refuses the feature ID of another feature", func() {
+		Expect(RegisterDeclarativeOperatorFeature(parse("name: my-operator\nnamespace: ns"))).To(Succeed())
+		Expect(RegisterDeclarativeOperatorFeature(parse("name: other-operator\nnamespace: ns\nfeatureSupport: {id: MY_OPERATOR}"))).ToNot(Succeed())
+		Expect(RegisterDeclarativeOperatorFeature(parse("name: other-operator\nnamespace: ns\nfeatureSupport: {id: LVM}"))).ToNot(Succeed())
+	})
+
+	It("reports the features of the declarative operators with an ID of the API", func() {
+		Expect(RegisterDeclarativeOperatorFeature(parse("name: my-operator\nnamespace: ns"))).To(Succeed())
+		Expect(RegisterDeclarativeOperatorFeature(parse("name: other-operator\nnamespace: ns"))).To(Succeed())
+		Expect(APIFeatureSupportLevelID("MY_OPERATOR")).To(Equal(models.FeatureSupportLevelIDDECLARATIVEOPERATOR))
+		Expect(APIFeatureSupportLevelID(models.FeatureSupportLevelIDLVM)).To(Equal(models.FeatureSupportLevelIDLVM))
+		Expect(APIFeatureSupportLevelIDs([]models.FeatureSupportLevelID{"MY_OPERATOR", models.FeatureSupportLevelIDLVM, "OTHER_OPERATOR"})).To(Equal(
+			[]models.FeatureSupportLevelID{models.FeatureSupportLevelIDDECLARATIVEOPERATOR, models.FeatureSupportLevelIDLVM}))
+		Expect(APIFeatureSupportLevelIDs(nil)).To(BeNil())
+	})
+
+	It("registers the features while they are read", func() {
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				_ = RegisterDeclarativeOperatorFeature(parse("name: my-operator\nnamespace: ns"))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				_ = GetFeatureSupportList("4.17", nil, nil, nil)
+			}
+		}()
+		wg.Wait()
+		Expect(GetFeatureByID("MY_OPERATOR")).ToNot(BeNil())
+	})
+})
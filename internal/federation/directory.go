@@ -0,0 +1,120 @@
+package federation
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/federation"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.FederationAPI = &Directory{}
+
+// Directory is the registry of the member instances of the federation. The members renew their registration
+// periodically, and are dropped from the directory when they didn't renew it for the member TTL.
+type Directory struct {
+	enabled bool
+	ttl     time.Duration
+	log     logrus.FieldLogger
+
+	lock    sync.Mutex
+	members map[string]*models.FederationMember
+}
+
+func NewDirectory(cfg Config, log logrus.FieldLogger) *Directory {
+	return &Directory{
+		enabled: cfg.DirectoryEnabled,
+		ttl:     cfg.MemberTTL,
+		log:     log,
+		members: make(map[string]*models.FederationMember),
+	}
+}
+
+// Register adds the member to the directory, or renews its registration
+func (d *Directory) Register(member *models.FederationMember) (*models.FederationMember, error) {
+	if err := validateMember(member); err != nil {
+		return nil, err
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	now := strfmt.DateTime(time.Now())
+	registered := &models.FederationMember{
+		ID:           member.ID,
+		URL:          member.URL,
+		Region:       member.Region,
+		RegisteredAt: now,
+		LastSeenAt:   now,
+	}
+	if existing, ok := d.members[swag.StringValue(member.ID)]; ok {
+		registered.RegisteredAt = existing.RegisteredAt
+	}
+	d.members[swag.StringValue(member.ID)] = registered
+	return registered, nil
+}
+
+// Members returns the members that renewed their registration within the member TTL, sorted by ID
+func (d *Directory) Members() models.FederationMemberList {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	members := make(models.FederationMemberList, 0, len(d.members))
+	for id, member := range d.members {
+		if time.Since(time.Time(member.LastSeenAt)) > d.ttl {
+			d.log.Infof("Federation member %s didn't renew its registration since %s, removing it",
+				id, member.LastSeenAt.String())
+			delete(d.members, id)
+			continue
+		}
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return swag.StringValue(members[i].ID) < swag.StringValue(members[j].ID)
+	})
+	return members
+}
+
+func (d *Directory) V2ListFederationMembers(ctx context.Context, params federation.V2ListFederationMembersParams) middleware.Responder {
+	if !d.enabled {
+		return common.NewApiError(http.StatusNotFound, errors.New("the federation directory is not enabled"))
+	}
+	return federation.NewV2ListFederationMembersOK().WithPayload(d.Members())
+}
+
+func (d *Directory) V2RegisterFederationMember(ctx context.Context, params federation.V2RegisterFederationMemberParams) middleware.Responder {
+	log := logutil.FromContext(ctx, d.log)
+	if !d.enabled {
+		return common.NewApiError(http.StatusNotFound, errors.New("the federation directory is not enabled"))
+	}
+	member, err := d.Register(params.Member)
+	if err != nil {
+		log.WithError(err).Warnf("failed to register federation member %s", swag.StringValue(params.Member.ID))
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	return federation.NewV2RegisterFederationMemberOK().WithPayload(member)
+}
+
+func validateMember(member *models.FederationMember) error {
+	if member == nil || swag.StringValue(member.ID) == "" {
+		return errors.New("the federation member must have an ID")
+	}
+	memberURL, err := url.Parse(swag.StringValue(member.URL))
+	if err != nil {
+		return errors.Wrapf(err, "invalid URL of federation member %s", swag.StringValue(member.ID))
+	}
+	if (memberURL.Scheme != "http" && memberURL.Scheme != "https") || memberURL.Host == "" {
+		return errors.Errorf("the URL of federation member %s must be an absolute HTTP or HTTPS URL, got %q",
+			swag.StringValue(member.ID), swag.StringValue(member.URL))
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package federation
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/restapi/operations/federation"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Directory", func() {
+	var directory *Directory
+
+	BeforeEach(func() {
+		directory = NewDirectory(Config{DirectoryEnabled: true, MemberTTL: time.Minute}, logrus.New())
+	})
+
+	member := func(id, url string) *models.FederationMember {
+		return &models.FederationMember{ID: swag.String(id), URL: swag.String(url), Region: "emea"}
+	}
+
+	It("lists the registered members sorted by ID", func() {
+		_, err := directory.Register(member("hub-b", "https://hub-b.example.com"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = directory.Register(member("hub-a", "https://hub-a.example.com"))
+		Expect(err).ToNot(HaveOccurred())
+
+		members := directory.Members()
+		Expect(members).To(HaveLen(2))
+		Expect(swag.StringValue(members[0].ID)).To(Equal("hub-a"))
+		Expect(swag.StringValue(members[1].ID)).To(Equal("hub-b"))
+		Expect(members[0].Region).To(Equal("emea"))
+	})
+
+	It("keeps the registration time when a member renews its registration", func() {
+		_, err := directory.Register(member("hub-a", "https://hub-a.example.com"))
+		Expect(err).ToNot(HaveOccurred())
+		registeredAt := strfmt.DateTime(time.Now().Add(-time.Hour))
+		directory.members["hub-a"].RegisteredAt = registeredAt
+		directory.members["hub-a"].LastSeenAt = registeredAt
+
+		renewed, err := directory.Register(member("hub-a", "https://hub-a-new.example.com"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renewed.RegisteredAt).To(Equal(registeredAt))
+		Expect(time.Time(renewed.LastSeenAt)).To(BeTemporally("~", time.Now(), time.Minute))
+		Expect(swag.StringValue(renewed.URL)).To(Equal("https://hub-a-new.example.com"))
+	})
+
+	It("drops the members that didn't renew their registration", func() {
+		_, err := directory.Register(member("hub-a", "https://hub-a.example.com"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = directory.Register(member("hub-b", "https://hub-b.example.com"))
+		Expect(err).ToNot(HaveOccurred())
+		directory.members["hub-b"].LastSeenAt = strfmt.DateTime(time.Now().Add(-2 * time.Minute))
+
+		members := directory.Members()
+		Expect(members).To(HaveLen(1))
+		Expect(swag.StringValue(members[0].ID)).To(Equal("hub-a"))
+		Expect(directory.members).ToNot(HaveKey("hub-b"))
+	})
+
+	DescribeTable("rejects invalid members",
+		func(m *models.FederationMember) {
+			_, err := directory.Register(m)
+			Expect(err).To(HaveOccurred())
+			Expect(directory.Members()).To(BeEmpty())
+		},
+		Entry("no member", nil),
+		Entry("no ID", member("", "https://hub-a.example.com")),
+		Entry("relative URL", member("hub-a", "/api/assisted-install")),
+		Entry("unsupported scheme", member("hub-a", "ftp://hub-a.example.com")),
+	)
+
+	Context("API", func() {
+		It("registers and lists the members", func() {
+			response := directory.V2RegisterFederationMember(context.Background(), federation.V2RegisterFederationMemberParams{
+				Member: member("hub-a", "https://hub-a.example.com"),
+			})
+			Expect(response).To(BeAssignableToTypeOf(&federation.V2RegisterFederationMemberOK{}))
+
+			response = directory.V2ListFederationMembers(context.Background(), federation.V2ListFederationMembersParams{})
+			Expect(response).To(BeAssignableToTypeOf(&federation.V2ListFederationMembersOK{}))
+			Expect(response.(*federation.V2ListFederationMembersOK).Payload).To(HaveLen(1))
+		})
+
+		It("rejects invalid members", func() {
+			response := directory.V2RegisterFederationMember(context.Background(), federation.V2RegisterFederationMemberParams{
+				Member: member("hub-a", "hub-a.example.com"),
+			})
+			Expect(response).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+			Expect(response.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusBadRequest))
+		})
+
+		It("is not found when the directory is disabled", func() {
+			directory = NewDirectory(Config{MemberTTL: time.Minute}, logrus.New())
+			response := directory.V2ListFederationMembers(context.Background(), federation.V2ListFederationMembersParams{})
+			Expect(response.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusNotFound))
+			response = directory.V2RegisterFederationMember(context.Background(), federation.V2RegisterFederationMemberParams{
+				Member: member("hub-a", "https://hub-a.example.com"),
+			})
+			Expect(response.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,151 @@
+package federation
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/client"
+	clientevents "github.com/openshift/assisted-service/client/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/events"
+)
+
+var _ restapi.EventsAPI = &eventsAPI{}
+
+// eventsAPI aggregates the events of the members of the federation, and retrieves the events of the clusters and
+// infra-envs owned by other members from their owner
+type eventsAPI struct {
+	restapi.EventsAPI
+	federation *Federation
+}
+
+func NewEventsAPI(inner restapi.EventsAPI, federation *Federation) restapi.EventsAPI {
+	return &eventsAPI{EventsAPI: inner, federation: federation}
+}
+
+func (a *eventsAPI) V2ListEvents(ctx context.Context, params events.V2ListEventsParams) middleware.Responder {
+	if a.federation.isForwarded(params.HTTPRequest) {
+		return a.EventsAPI.V2ListEvents(ctx, params)
+	}
+	if swag.BoolValue(params.Federated) {
+		return a.listFederatedEvents(ctx, params)
+	}
+
+	response := a.EventsAPI.V2ListEvents(ctx, params)
+	if !isNotFound(response) {
+		return response
+	}
+	member := a.owner(ctx, params)
+	if member == nil {
+		return response
+	}
+	results := fanOut(ctx, a.federation, a.federation.callerClients(ctx), []*models.FederationMember{member}, listEvents(params))
+	if len(results) == 0 {
+		return response
+	}
+	return eventsResponder(tagEvents(results[0].value.Payload, results[0].member), results[0].value)
+}
+
+// owner returns the member that owns the cluster or the infra-env of the events
+func (a *eventsAPI) owner(ctx context.Context, params events.V2ListEventsParams) *models.FederationMember {
+	switch {
+	case params.ClusterID != nil:
+		if result, found := a.federation.locateCluster(ctx, a.federation.callerClients(ctx), *params.ClusterID); found {
+			return a.federation.peer(result.member)
+		}
+	case params.InfraEnvID != nil:
+		if result, found := a.federation.locateInfraEnv(ctx, a.federation.callerClients(ctx), *params.InfraEnvID); found {
+			return a.federation.peer(result.member)
+		}
+	}
+	return nil
+}
+
+// listFederatedEvents merges the events of all the members, each member returns the events up to the requested
+// page so the page can be cut from the merged events
+func (a *eventsAPI) listFederatedEvents(ctx context.Context, params events.V2ListEventsParams) middleware.Responder {
+	offset, limit := swag.Int64Value(params.Offset), params.Limit
+	memberParams := params
+	memberParams.Offset = nil
+	if limit != nil && *limit >= 0 {
+		memberParams.Limit = swag.Int64(offset + *limit)
+	}
+
+	response := a.EventsAPI.V2ListEvents(ctx, memberParams)
+	local, ok := response.(*events.V2ListEventsOK)
+	if !ok {
+		return response
+	}
+	merged := &clientevents.V2ListEventsOK{
+		EventCount:            local.EventCount,
+		SeverityCountInfo:     local.SeverityCountInfo,
+		SeverityCountWarning:  local.SeverityCountWarning,
+		SeverityCountError:    local.SeverityCountError,
+		SeverityCountCritical: local.SeverityCountCritical,
+	}
+	evs := tagEvents(local.Payload, a.federation.cfg.InstanceID)
+	for _, result := range fanOut(ctx, a.federation, a.federation.callerClients(ctx), a.federation.peers(), listEvents(memberParams)) {
+		merged.EventCount += result.value.EventCount
+		merged.SeverityCountInfo += result.value.SeverityCountInfo
+		merged.SeverityCountWarning += result.value.SeverityCountWarning
+		merged.SeverityCountError += result.value.SeverityCountError
+		merged.SeverityCountCritical += result.value.SeverityCountCritical
+		evs = append(evs, tagEvents(result.value.Payload, result.member)...)
+	}
+
+	descending := swag.StringValue(params.Order) == "descending"
+	sort.SliceStable(evs, func(i, j int) bool {
+		if descending {
+			return time.Time(*evs[i].EventTime).After(time.Time(*evs[j].EventTime))
+		}
+		return time.Time(*evs[i].EventTime).Before(time.Time(*evs[j].EventTime))
+	})
+	if offset > int64(len(evs)) {
+		offset = int64(len(evs))
+	}
+	evs = evs[offset:]
+	if limit != nil && *limit >= 0 && *limit < int64(len(evs)) {
+		evs = evs[:*limit]
+	}
+	return eventsResponder(evs, merged)
+}
+
+func listEvents(params events.V2ListEventsParams) func(context.Context, *client.AssistedInstall) (*clientevents.V2ListEventsOK, error) {
+	return func(ctx context.Context, cli *client.AssistedInstall) (*clientevents.V2ListEventsOK, error) {
+		return cli.Events.V2ListEvents(ctx, &clientevents.V2ListEventsParams{
+			Categories:   params.Categories,
+			ClusterID:    params.ClusterID,
+			ClusterLevel: params.ClusterLevel,
+			DeletedHosts: params.DeletedHosts,
+			HostID:       params.HostID,
+			HostIds:      params.HostIds,
+			InfraEnvID:   params.InfraEnvID,
+			Limit:        params.Limit,
+			Message:      params.Message,
+			Offset:       params.Offset,
+			Order:        params.Order,
+			Severities:   params.Severities,
+		})
+	}
+}
+
+func tagEvents(evs models.EventList, member string) models.EventList {
+	for _, ev := range evs {
+		ev.FederationInstanceID = member
+	}
+	return evs
+}
+
+func eventsResponder(evs models.EventList, counts *clientevents.V2ListEventsOK) middleware.Responder {
+	return events.NewV2ListEventsOK().
+		WithSeverityCountInfo(counts.SeverityCountInfo).
+		WithSeverityCountWarning(counts.SeverityCountWarning).
+		WithSeverityCountError(counts.SeverityCountError).
+		WithSeverityCountCritical(counts.SeverityCountCritical).
+		WithEventCount(counts.EventCount).
+		WithPayload(evs)
+}
@@ -0,0 +1,404 @@
+package federation
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/security"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/client"
+	"github.com/openshift/assisted-service/client/federation"
+	"github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ForwardedHeader marks the requests sent by a member of the federation to another member, with a token signed
+	// by the sending member. The forwarded requests are served locally, they are never fanned out nor routed again.
+	ForwardedHeader = "X-Assisted-Federation-Forwarded"
+	// IdentityHeader carries the identity of the caller of a forwarded request, delegated by the sending member to
+	// the receiving member only. The credentials of the caller are never sent to the other members.
+	IdentityHeader = "X-Assisted-Federation-Identity"
+
+	userAuthHeader = "Authorization"
+
+	// forwardedTokenValidity is the time a forwarded token is accepted after it was signed
+	forwardedTokenValidity = 5 * time.Minute
+)
+
+type Config struct {
+	// Enabled makes the instance a member of the federation
+	Enabled bool `envconfig:"FEDERATION_ENABLED" default:"false"`
+	// InstanceID is the unique identifier of the instance in the federation
+	InstanceID string `envconfig:"FEDERATION_INSTANCE_ID" default:""`
+	// InstanceURL is the URL the other members use to reach the instance, the service base URL by default
+	InstanceURL string `envconfig:"FEDERATION_INSTANCE_URL" default:""`
+	Region      string `envconfig:"FEDERATION_REGION" default:""`
+	// DirectoryEnabled makes the instance serve the federation directory
+	DirectoryEnabled bool `envconfig:"FEDERATION_DIRECTORY_ENABLED" default:"false"`
+	// DirectoryURL is the URL of the instance serving the federation directory, empty when it is served locally
+	DirectoryURL string `envconfig:"FEDERATION_DIRECTORY_URL" default:""`
+	// DirectoryToken authenticates the instance to the directory
+	DirectoryToken string `envconfig:"FEDERATION_DIRECTORY_TOKEN" default:""`
+	// SharedSecret signs the requests forwarded between the members, it must be the same on all the members
+	SharedSecret      string        `envconfig:"FEDERATION_SHARED_SECRET" default:""`
+	HeartbeatInterval time.Duration `envconfig:"FEDERATION_HEARTBEAT_INTERVAL" default:"30s"`
+	MemberTTL         time.Duration `envconfig:"FEDERATION_MEMBER_TTL" default:"2m"`
+	RequestTimeout    time.Duration `envconfig:"FEDERATION_REQUEST_TIMEOUT" default:"10s"`
+	// OwnerCacheSize is the number of clusters and infra-envs whose owner is remembered
+	OwnerCacheSize int `envconfig:"FEDERATION_OWNER_CACHE_SIZE" default:"10000"`
+}
+
+// Federation locates the clusters and infra-envs across the member instances of the federation, and aggregates
+// their reads
+type Federation struct {
+	cfg       Config
+	directory *Directory
+	transport http.RoundTripper
+	log       logrus.FieldLogger
+	owners    *ownerCache
+
+	lock    sync.RWMutex
+	members []*models.FederationMember
+}
+
+func New(cfg Config, directory *Directory, log logrus.FieldLogger) (*Federation, error) {
+	if cfg.InstanceID == "" {
+		return nil, errors.New("FEDERATION_INSTANCE_ID is required to join the federation")
+	}
+	if cfg.DirectoryURL == "" && !cfg.DirectoryEnabled {
+		return nil, errors.New("either FEDERATION_DIRECTORY_URL or FEDERATION_DIRECTORY_ENABLED is required to join the federation")
+	}
+	if cfg.SharedSecret == "" {
+		return nil, errors.New("FEDERATION_SHARED_SECRET is required to join the federation")
+	}
+	if cfg.OwnerCacheSize <= 0 {
+		return nil, errors.New("FEDERATION_OWNER_CACHE_SIZE must be positive")
+	}
+	return &Federation{
+		cfg:       cfg,
+		directory: directory,
+		transport: http.DefaultTransport,
+		log:       log,
+		owners:    newOwnerCache(cfg.OwnerCacheSize),
+	}, nil
+}
+
+// Sync renews the registration of the instance in the directory and refreshes the members of the federation. The
+// last known members are kept when the directory can't be reached.
+func (f *Federation) Sync() {
+	self := &models.FederationMember{
+		ID:     swag.String(f.cfg.InstanceID),
+		URL:    swag.String(f.cfg.InstanceURL),
+		Region: f.cfg.Region,
+	}
+	var members models.FederationMemberList
+	if f.cfg.DirectoryURL == "" {
+		if _, err := f.directory.Register(self); err != nil {
+			f.log.WithError(err).Error("failed to register in the federation directory")
+			return
+		}
+		members = f.directory.Members()
+	} else {
+		cli, err := f.directoryClient()
+		if err != nil {
+			f.log.WithError(err).Error("failed to create the federation directory client")
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), f.cfg.RequestTimeout)
+		defer cancel()
+		if _, err = cli.Federation.V2RegisterFederationMember(ctx, &federation.V2RegisterFederationMemberParams{Member: self}); err != nil {
+			f.log.WithError(err).Errorf("failed to register in the federation directory %s", f.cfg.DirectoryURL)
+			return
+		}
+		response, err := cli.Federation.V2ListFederationMembers(ctx, &federation.V2ListFederationMembersParams{})
+		if err != nil {
+			f.log.WithError(err).Errorf("failed to list the members of the federation directory %s", f.cfg.DirectoryURL)
+			return
+		}
+		members = response.Payload
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.members = members
+}
+
+// peers returns the members of the federation other than this instance
+func (f *Federation) peers() []*models.FederationMember {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	peers := make([]*models.FederationMember, 0, len(f.members))
+	for _, member := range f.members {
+		if swag.StringValue(member.ID) != f.cfg.InstanceID {
+			peers = append(peers, member)
+		}
+	}
+	return peers
+}
+
+func (f *Federation) peer(id string) *models.FederationMember {
+	for _, member := range f.peers() {
+		if swag.StringValue(member.ID) == id {
+			return member
+		}
+	}
+	return nil
+}
+
+func (f *Federation) directoryClient() (*client.AssistedInstall, error) {
+	directoryURL, err := url.Parse(strings.TrimSuffix(f.cfg.DirectoryURL, "/") + client.DefaultBasePath)
+	if err != nil {
+		return nil, err
+	}
+	var token string
+	if f.cfg.DirectoryToken != "" {
+		token = "Bearer " + f.cfg.DirectoryToken
+	}
+	return client.New(client.Config{URL: directoryURL, Transport: f.transport, AuthInfo: f.authInfo(userAuthHeader, token)}), nil
+}
+
+// clients creates the clients of the members
+type clients func(member *models.FederationMember) (*client.AssistedInstall, error)
+
+// callerClients authenticates with the identity of the caller stored in the context by the authenticators, delegated
+// to each member separately, so that the members authorize the caller without receiving its credentials
+func (f *Federation) callerClients(ctx context.Context) clients {
+	payload, _ := ctx.Value(restapi.AuthKey).(*ocm.AuthPayload)
+	return f.delegatedClients(payload)
+}
+
+func (f *Federation) delegatedClients(payload *ocm.AuthPayload) clients {
+	return func(member *models.FederationMember) (*client.AssistedInstall, error) {
+		identity, err := f.identityToken(payload, swag.StringValue(member.ID), time.Now())
+		if err != nil {
+			return nil, err
+		}
+		memberURL, err := url.Parse(strings.TrimSuffix(swag.StringValue(member.URL), "/") + client.DefaultBasePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid URL of federation member %s", swag.StringValue(member.ID))
+		}
+		return client.New(client.Config{URL: memberURL, Transport: f.transport, AuthInfo: f.authInfo(IdentityHeader, identity)}), nil
+	}
+}
+
+func (f *Federation) authInfo(header, value string) runtime.ClientAuthInfoWriter {
+	return runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		if value != "" {
+			if err := r.SetHeaderParam(header, value); err != nil {
+				return err
+			}
+		}
+		return r.SetHeaderParam(ForwardedHeader, f.forwardedToken(time.Now()))
+	})
+}
+
+// delegatedIdentity is the identity of a caller delegated by a member to another member of the federation
+type delegatedIdentity struct {
+	Member   string           `json:"member"`
+	Audience string           `json:"aud"`
+	SignedAt int64            `json:"iat"`
+	Payload  *ocm.AuthPayload `json:"payload"`
+}
+
+// identityToken signs the identity of the caller for the given member only, it is empty without a caller
+func (f *Federation) identityToken(payload *ocm.AuthPayload, audience string, now time.Time) (string, error) {
+	if payload == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(&delegatedIdentity{Member: f.cfg.InstanceID, Audience: audience, SignedAt: now.Unix(), Payload: payload})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode the identity of the caller")
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return encoded + "." + f.sign(encoded), nil
+}
+
+// delegatedPayload returns the identity of the caller of a request forwarded by another member, nil when the request
+// isn't forwarded or its identity isn't signed for this instance by the forwarding member
+func (f *Federation) delegatedPayload(request *http.Request) *ocm.AuthPayload {
+	if !f.isForwarded(request) {
+		return nil
+	}
+	token := request.Header.Get(IdentityHeader)
+	sep := strings.LastIndex(token, ".")
+	if sep < 0 || !hmac.Equal([]byte(token[sep+1:]), []byte(f.sign(token[:sep]))) {
+		return nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token[:sep])
+	if err != nil {
+		return nil
+	}
+	var identity delegatedIdentity
+	if err = json.Unmarshal(data, &identity); err != nil || identity.Payload == nil {
+		return nil
+	}
+	age := time.Since(time.Unix(identity.SignedAt, 0))
+	if identity.Audience != f.cfg.InstanceID || age > forwardedTokenValidity || age < -forwardedTokenValidity ||
+		f.peer(identity.Member) == nil {
+		return nil
+	}
+	return identity.Payload
+}
+
+// Authenticator authenticates the users of the requests forwarded by the other members with the identity delegated
+// by the forwarding member, the other requests are authenticated by the given authenticator
+func (f *Federation) Authenticator(create func(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator) func(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator {
+	return func(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator {
+		inner := create(name, in, authenticate)
+		if name != userAuthHeader || in != "header" {
+			return inner
+		}
+		return runtime.AuthenticatorFunc(func(params interface{}) (bool, interface{}, error) {
+			var request *http.Request
+			switch p := params.(type) {
+			case *http.Request:
+				request = p
+			case *security.ScopedAuthRequest:
+				request = p.Request
+			}
+			if payload := f.delegatedPayload(request); payload != nil {
+				return true, payload, nil
+			}
+			return inner.Authenticate(params)
+		})
+	}
+}
+
+// forwardedToken signs the ID of the instance and the given time with the shared secret of the federation
+func (f *Federation) forwardedToken(now time.Time) string {
+	payload := fmt.Sprintf("%s.%d", f.cfg.InstanceID, now.Unix())
+	return payload + "." + f.sign(payload)
+}
+
+func (f *Federation) sign(payload string) string {
+	mac := hmac.New(sha256.New, []byte(f.cfg.SharedSecret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// isForwarded returns true when the request was sent by another member of the federation, i.e. its forwarded token
+// is signed with the shared secret by a known member and isn't expired
+func (f *Federation) isForwarded(request *http.Request) bool {
+	if request == nil {
+		return false
+	}
+	token := request.Header.Get(ForwardedHeader)
+	sep := strings.LastIndex(token, ".")
+	if sep < 0 {
+		return false
+	}
+	payload, signature := token[:sep], token[sep+1:]
+	if !hmac.Equal([]byte(signature), []byte(f.sign(payload))) {
+		return false
+	}
+	sep = strings.LastIndex(payload, ".")
+	if sep < 0 {
+		return false
+	}
+	member := payload[:sep]
+	signedAt, err := strconv.ParseInt(payload[sep+1:], 10, 64)
+	if err != nil {
+		return false
+	}
+	age := time.Since(time.Unix(signedAt, 0))
+	if age > forwardedTokenValidity || age < -forwardedTokenValidity {
+		return false
+	}
+	return f.peer(member) != nil
+}
+
+type memberResult[T any] struct {
+	member string
+	value  T
+}
+
+// fanOut calls the peers concurrently and returns the results of the peers that answered, the failures of the other
+// peers are logged
+func fanOut[T any](ctx context.Context, f *Federation, clientFor clients, peers []*models.FederationMember,
+	call func(context.Context, *client.AssistedInstall) (T, error)) []memberResult[T] {
+	ctx, cancel := context.WithTimeout(ctx, f.cfg.RequestTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	results := make([]*memberResult[T], len(peers))
+	for i := range peers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := swag.StringValue(peers[i].ID)
+			cli, err := clientFor(peers[i])
+			if err == nil {
+				var value T
+				if value, err = call(ctx, cli); err == nil {
+					results[i] = &memberResult[T]{member: id, value: value}
+					return
+				}
+			}
+			f.log.WithError(err).Warnf("federation member %s didn't answer", id)
+		}(i)
+	}
+	wg.Wait()
+
+	answered := make([]memberResult[T], 0, len(results))
+	for _, result := range results {
+		if result != nil {
+			answered = append(answered, *result)
+		}
+	}
+	return answered
+}
+
+// locate returns the result of the first peer that has the resource, trying the last known owner of the resource
+// first
+func locate[T any](ctx context.Context, f *Federation, clientFor clients, id strfmt.UUID,
+	call func(context.Context, *client.AssistedInstall) (T, error)) (*memberResult[T], bool) {
+	if member := f.peer(f.owners.get(id)); member != nil {
+		if results := fanOut(ctx, f, clientFor, []*models.FederationMember{member}, call); len(results) == 1 {
+			return &results[0], true
+		}
+	}
+
+	results := fanOut(ctx, f, clientFor, f.peers(), call)
+	if len(results) == 0 {
+		return nil, false
+	}
+	f.owners.add(id, results[0].member)
+	return &results[0], true
+}
+
+func (f *Federation) locateCluster(ctx context.Context, clientFor clients, clusterID strfmt.UUID) (*memberResult[*models.Cluster], bool) {
+	return locate(ctx, f, clientFor, clusterID, func(ctx context.Context, cli *client.AssistedInstall) (*models.Cluster, error) {
+		response, err := cli.Installer.V2GetCluster(ctx, &installer.V2GetClusterParams{ClusterID: clusterID})
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	})
+}
+
+func (f *Federation) locateInfraEnv(ctx context.Context, clientFor clients, infraEnvID strfmt.UUID) (*memberResult[*models.InfraEnv], bool) {
+	return locate(ctx, f, clientFor, infraEnvID, func(ctx context.Context, cli *client.AssistedInstall) (*models.InfraEnv, error) {
+		response, err := cli.Installer.GetInfraEnv(ctx, &installer.GetInfraEnvParams{InfraEnvID: infraEnvID})
+		if err != nil {
+			return nil, err
+		}
+		return response.Payload, nil
+	})
+}
@@ -0,0 +1,13 @@
+package federation
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFederation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Federation test Suite")
+}
@@ -0,0 +1,684 @@
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/security"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/client"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/mocks"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	localInstanceID = "hub-local"
+	callerAuth      = "Bearer caller-token"
+	sharedSecret    = "shared-secret"
+)
+
+var callerPayload = &ocm.AuthPayload{Username: "caller", Organization: "caller-org", Role: ocm.UserRole}
+
+// callerContext returns a context with the identity of the caller stored by the authenticators
+func callerContext() context.Context {
+	return context.WithValue(context.Background(), restapi.AuthKey, callerPayload)
+}
+
+// fakeMember serves the clusters, infra-envs and events of a member of the federation
+type fakeMember struct {
+	id        string
+	server    *httptest.Server
+	clusters  map[strfmt.UUID]*models.Cluster
+	infraEnvs map[strfmt.UUID]*models.InfraEnv
+	events    models.EventList
+
+	lock     sync.Mutex
+	requests []*http.Request
+}
+
+func newFakeMember(id string) *fakeMember {
+	m := &fakeMember{
+		id:        id,
+		clusters:  map[strfmt.UUID]*models.Cluster{},
+		infraEnvs: map[strfmt.UUID]*models.InfraEnv{},
+	}
+	m.server = httptest.NewServer(m)
+	return m
+}
+
+func (m *fakeMember) addCluster() strfmt.UUID {
+	id := strfmt.UUID(uuid.New().String())
+	m.clusters[id] = &models.Cluster{ID: &id, Name: m.id + "-cluster"}
+	return id
+}
+
+func (m *fakeMember) addInfraEnv() strfmt.UUID {
+	id := strfmt.UUID(uuid.New().String())
+	m.infraEnvs[id] = &models.InfraEnv{ID: &id, Name: swag.String(m.id + "-infra-env")}
+	return id
+}
+
+func (m *fakeMember) received() []*http.Request {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return append([]*http.Request{}, m.requests...)
+}
+
+func (m *fakeMember) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.lock.Lock()
+	m.requests = append(m.requests, r.Clone(context.Background()))
+	m.lock.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, client.DefaultBasePath)
+	resource := func(prefix string) (strfmt.UUID, bool) {
+		if !strings.HasPrefix(path, prefix) {
+			return "", false
+		}
+		return strfmt.UUID(strings.Split(strings.TrimPrefix(path, prefix), "/")[0]), true
+	}
+	w.Header().Set("Content-Type", "application/json")
+	var payload interface{}
+	switch {
+	case path == "/v2/clusters":
+		clusters := models.ClusterList{}
+		for _, cluster := range m.clusters {
+			clusters = append(clusters, cluster)
+		}
+		payload = clusters
+	case path == "/v2/events":
+		w.Header().Set("Event-Count", strconv.Itoa(len(m.events)))
+		payload = m.events
+	default:
+		if id, ok := resource("/v2/clusters/"); ok && m.clusters[id] != nil {
+			payload = m.clusters[id]
+		} else if id, ok := resource("/v2/infra-envs/"); ok && m.infraEnvs[id] != nil {
+			payload = m.infraEnvs[id]
+		} else {
+			common.NewApiError(http.StatusNotFound, errors.New("not found")).WriteResponse(w, runtime.JSONProducer())
+			return
+		}
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusAccepted)
+	}
+	Expect(json.NewEncoder(w).Encode(payload)).To(Succeed())
+}
+
+func newFederation(members ...*fakeMember) *Federation {
+	cfg := Config{
+		InstanceID:       localInstanceID,
+		InstanceURL:      "https://hub-local.example.com",
+		DirectoryEnabled: true,
+		SharedSecret:     sharedSecret,
+		MemberTTL:        time.Minute,
+		RequestTimeout:   5 * time.Second,
+		OwnerCacheSize:   10,
+	}
+	directory := NewDirectory(cfg, logrus.New())
+	for _, m := range members {
+		_, err := directory.Register(&models.FederationMember{ID: swag.String(m.id), URL: swag.String(m.server.URL)})
+		Expect(err).ToNot(HaveOccurred())
+	}
+	f, err := New(cfg, directory, logrus.New())
+	Expect(err).ToNot(HaveOccurred())
+	f.Sync()
+	return f
+}
+
+func callerRequest(method, path string) *http.Request {
+	request := httptest.NewRequest(method, client.DefaultBasePath+path, nil)
+	request.Header.Set(userAuthHeader, callerAuth)
+	return request
+}
+
+// memberToken returns the forwarded token of the given member signed at the given time
+func memberToken(id, secret string, signedAt time.Time) string {
+	return (&Federation{cfg: Config{InstanceID: id, SharedSecret: secret}}).forwardedToken(signedAt)
+}
+
+func forwardedRequest(method, path, token string) *http.Request {
+	request := callerRequest(method, path)
+	request.Header.Set(ForwardedHeader, token)
+	return request
+}
+
+// receivingMember returns the federation of the given member, with this instance as its peer
+func receivingMember(id string) *Federation {
+	return &Federation{
+		cfg:     Config{InstanceID: id, SharedSecret: sharedSecret},
+		members: []*models.FederationMember{{ID: swag.String(localInstanceID)}},
+	}
+}
+
+// expectForwarded checks that the request received by the member is signed by this instance, and carries the identity
+// of the caller delegated to the member instead of the credentials of the caller
+func expectForwarded(request *http.Request, member string) {
+	ExpectWithOffset(1, request.Header.Get(userAuthHeader)).To(BeEmpty())
+	token := request.Header.Get(ForwardedHeader)
+	ExpectWithOffset(1, token).To(HavePrefix(localInstanceID + "."))
+	ExpectWithOffset(1, receivingMember(member).delegatedPayload(request)).To(Equal(callerPayload))
+}
+
+var _ = Describe("Federation", func() {
+	It("requires an instance ID", func() {
+		_, err := New(Config{DirectoryEnabled: true}, nil, logrus.New())
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("requires a directory", func() {
+		_, err := New(Config{InstanceID: localInstanceID}, nil, logrus.New())
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("requires a shared secret", func() {
+		_, err := New(Config{InstanceID: localInstanceID, DirectoryEnabled: true, OwnerCacheSize: 10}, nil, logrus.New())
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("registers in the remote directory", func() {
+		directory := NewDirectory(Config{DirectoryEnabled: true, MemberTTL: time.Minute}, logrus.New())
+		var authorization string
+		h, _, err := restapi.HandlerAPI(restapi.Config{
+			AuthUserAuth: func(token string) (interface{}, error) {
+				authorization = token
+				return "member", nil
+			},
+			Authorizer:    func(*http.Request) error { return nil },
+			FederationAPI: directory,
+			Logger:        logrus.Printf,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		server := httptest.NewServer(h)
+		defer server.Close()
+
+		f, err := New(Config{
+			InstanceID:     localInstanceID,
+			InstanceURL:    "https://hub-local.example.com",
+			DirectoryURL:   server.URL,
+			DirectoryToken: "directory-token",
+			SharedSecret:   sharedSecret,
+			RequestTimeout: 5 * time.Second,
+			OwnerCacheSize: 10,
+		}, nil, logrus.New())
+		Expect(err).ToNot(HaveOccurred())
+		f.Sync()
+
+		Expect(authorization).To(Equal("Bearer directory-token"))
+		Expect(directory.Members()).To(HaveLen(1))
+		Expect(f.members).To(HaveLen(1))
+		Expect(swag.StringValue(f.members[0].URL)).To(Equal("https://hub-local.example.com"))
+		Expect(f.peers()).To(BeEmpty())
+	})
+
+	Context("with members", func() {
+		var (
+			ctrl          *gomock.Controller
+			mockInstaller *mocks.MockInstallerAPI
+			hubA, hubB    *fakeMember
+			f             *Federation
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			mockInstaller = mocks.NewMockInstallerAPI(ctrl)
+			hubA = newFakeMember("hub-a")
+			hubB = newFakeMember("hub-b")
+			f = newFederation(hubA, hubB)
+		})
+
+		AfterEach(func() {
+			hubA.server.Close()
+			hubB.server.Close()
+			ctrl.Finish()
+		})
+
+		Context("V2ListClusters", func() {
+			var localID strfmt.UUID
+
+			BeforeEach(func() {
+				localID = strfmt.UUID(uuid.New().String())
+				mockInstaller.EXPECT().V2ListClusters(gomock.Any(), gomock.Any()).
+					Return(installer.NewV2ListClustersOK().WithPayload(models.ClusterList{{ID: &localID}})).Times(1)
+			})
+
+			It("aggregates the clusters of the members", func() {
+				hubA.addCluster()
+				hubB.addCluster()
+
+				response := NewInstallerAPI(mockInstaller, f).V2ListClusters(callerContext(), installer.V2ListClustersParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/clusters"),
+					Federated:   swag.Bool(true),
+					WithHosts:   true,
+				})
+				Expect(response).To(BeAssignableToTypeOf(&installer.V2ListClustersOK{}))
+				instances := map[string]int{}
+				for _, cluster := range response.(*installer.V2ListClustersOK).Payload {
+					instances[cluster.FederationInstanceID]++
+				}
+				Expect(instances).To(Equal(map[string]int{localInstanceID: 1, "hub-a": 1, "hub-b": 1}))
+
+				Expect(hubA.received()).To(HaveLen(1))
+				expectForwarded(hubA.received()[0], "hub-a")
+				Expect(hubA.received()[0].URL.Query().Get("with_hosts")).To(Equal("true"))
+				Expect(hubB.received()).To(HaveLen(1))
+				expectForwarded(hubB.received()[0], "hub-b")
+				// The identity delegated to a member isn't accepted by the other members
+				Expect(receivingMember("hub-b").delegatedPayload(hubA.received()[0])).To(BeNil())
+			})
+
+			It("skips the members that don't answer", func() {
+				hubA.addCluster()
+				hubB.server.Close()
+
+				response := NewInstallerAPI(mockInstaller, f).V2ListClusters(callerContext(), installer.V2ListClustersParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/clusters"),
+					Federated:   swag.Bool(true),
+				})
+				Expect(response.(*installer.V2ListClustersOK).Payload).To(HaveLen(2))
+			})
+
+			It("lists the local clusters when not federated", func() {
+				hubA.addCluster()
+				response := NewInstallerAPI(mockInstaller, f).V2ListClusters(callerContext(), installer.V2ListClustersParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/clusters"),
+				})
+				Expect(response.(*installer.V2ListClustersOK).Payload).To(HaveLen(1))
+				Expect(hubA.received()).To(BeEmpty())
+			})
+
+			It("doesn't fan out forwarded requests", func() {
+				response := NewInstallerAPI(mockInstaller, f).V2ListClusters(callerContext(), installer.V2ListClustersParams{
+					HTTPRequest: forwardedRequest(http.MethodGet, "/v2/clusters", memberToken("hub-a", sharedSecret, time.Now())),
+					Federated:   swag.Bool(true),
+				})
+				Expect(response.(*installer.V2ListClustersOK).Payload).To(HaveLen(1))
+				Expect(hubA.received()).To(BeEmpty())
+				Expect(hubB.received()).To(BeEmpty())
+			})
+
+			DescribeTable("fans out the requests with an invalid forwarded token",
+				func(token string) {
+					response := NewInstallerAPI(mockInstaller, f).V2ListClusters(callerContext(), installer.V2ListClustersParams{
+						HTTPRequest: forwardedRequest(http.MethodGet, "/v2/clusters", token),
+						Federated:   swag.Bool(true),
+					})
+					Expect(response).To(BeAssignableToTypeOf(&installer.V2ListClustersOK{}))
+					Expect(hubA.received()).To(HaveLen(1))
+					Expect(hubB.received()).To(HaveLen(1))
+				},
+				Entry("member ID only", "hub-a"),
+				Entry("other secret", memberToken("hub-a", "other-secret", time.Now())),
+				Entry("expired", memberToken("hub-a", sharedSecret, time.Now().Add(-time.Hour))),
+				Entry("unknown member", memberToken("hub-z", sharedSecret, time.Now())),
+				Entry("tampered", strings.Replace(memberToken("hub-a", sharedSecret, time.Now()), "hub-a", "hub-b", 1)),
+			)
+		})
+
+		Context("V2GetCluster", func() {
+			notFound := common.NewApiError(http.StatusNotFound, errors.New("not found"))
+
+			It("retrieves the cluster from its owner and remembers the owner", func() {
+				clusterID := hubB.addCluster()
+				mockInstaller.EXPECT().V2GetCluster(gomock.Any(), gomock.Any()).Return(notFound).Times(2)
+				params := installer.V2GetClusterParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/clusters/"+clusterID.String()),
+					ClusterID:   clusterID,
+				}
+
+				for i := 0; i < 2; i++ {
+					response := NewInstallerAPI(mockInstaller, f).V2GetCluster(callerContext(), params)
+					Expect(response).To(BeAssignableToTypeOf(&installer.V2GetClusterOK{}))
+					Expect(response.(*installer.V2GetClusterOK).Payload.FederationInstanceID).To(Equal("hub-b"))
+				}
+				Expect(hubA.received()).To(HaveLen(1))
+				Expect(hubB.received()).To(HaveLen(2))
+			})
+
+			It("is not found when no member owns the cluster", func() {
+				clusterID := strfmt.UUID(uuid.New().String())
+				mockInstaller.EXPECT().V2GetCluster(gomock.Any(), gomock.Any()).Return(notFound).Times(1)
+				response := NewInstallerAPI(mockInstaller, f).V2GetCluster(callerContext(), installer.V2GetClusterParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/clusters/"+clusterID.String()),
+					ClusterID:   clusterID,
+				})
+				Expect(response).To(Equal(notFound))
+			})
+
+			It("returns the local cluster", func() {
+				clusterID := strfmt.UUID(uuid.New().String())
+				mockInstaller.EXPECT().V2GetCluster(gomock.Any(), gomock.Any()).
+					Return(installer.NewV2GetClusterOK().WithPayload(&models.Cluster{ID: &clusterID})).Times(1)
+				response := NewInstallerAPI(mockInstaller, f).V2GetCluster(callerContext(), installer.V2GetClusterParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/clusters/"+clusterID.String()),
+					ClusterID:   clusterID,
+				})
+				Expect(response.(*installer.V2GetClusterOK).Payload.FederationInstanceID).To(BeEmpty())
+				Expect(hubA.received()).To(BeEmpty())
+			})
+		})
+
+		Context("V2ListEvents", func() {
+			var local *fakeEventsAPI
+
+			event := func(minutes int) *models.Event {
+				eventTime := strfmt.DateTime(time.Date(2026, 1, 1, 0, minutes, 0, 0, time.UTC))
+				return &models.Event{EventTime: &eventTime, Message: swag.String(strconv.Itoa(minutes))}
+			}
+			messages := func(response interface{}) []string {
+				var result []string
+				for _, ev := range response.(*events.V2ListEventsOK).Payload {
+					result = append(result, ev.FederationInstanceID+":"+swag.StringValue(ev.Message))
+				}
+				return result
+			}
+
+			BeforeEach(func() {
+				local = &fakeEventsAPI{events: models.EventList{event(1), event(3)}}
+				hubA.events = models.EventList{event(2)}
+				hubB.events = models.EventList{event(4)}
+			})
+
+			It("merges the events of the members", func() {
+				response := NewEventsAPI(local, f).V2ListEvents(callerContext(), events.V2ListEventsParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/events"),
+					Federated:   swag.Bool(true),
+					Offset:      swag.Int64(1),
+					Limit:       swag.Int64(2),
+				})
+				Expect(messages(response)).To(Equal([]string{"hub-a:2", localInstanceID + ":3"}))
+				Expect(response.(*events.V2ListEventsOK).EventCount).To(BeEquivalentTo(4))
+				Expect(local.params[0].Offset).To(BeNil())
+				Expect(swag.Int64Value(local.params[0].Limit)).To(BeEquivalentTo(3))
+				Expect(hubA.received()[0].URL.Query().Get("limit")).To(Equal("3"))
+				Expect(hubA.received()[0].URL.Query().Has("offset")).To(BeFalse())
+			})
+
+			It("sorts the merged events in descending order", func() {
+				response := NewEventsAPI(local, f).V2ListEvents(callerContext(), events.V2ListEventsParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/events"),
+					Federated:   swag.Bool(true),
+					Order:       swag.String("descending"),
+				})
+				Expect(messages(response)).To(Equal([]string{"hub-b:4", localInstanceID + ":3", "hub-a:2", localInstanceID + ":1"}))
+			})
+
+			It("retrieves the events of a cluster from its owner", func() {
+				clusterID := hubA.addCluster()
+				local.notFound = true
+				response := NewEventsAPI(local, f).V2ListEvents(callerContext(), events.V2ListEventsParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/events"),
+					ClusterID:   &clusterID,
+				})
+				Expect(messages(response)).To(Equal([]string{"hub-a:2"}))
+				Expect(hubB.received()).To(HaveLen(1))
+			})
+
+			It("retrieves the events of an infra-env from its owner", func() {
+				infraEnvID := hubB.addInfraEnv()
+				local.notFound = true
+				response := NewEventsAPI(local, f).V2ListEvents(callerContext(), events.V2ListEventsParams{
+					HTTPRequest: callerRequest(http.MethodGet, "/v2/events"),
+					InfraEnvID:  &infraEnvID,
+				})
+				Expect(messages(response)).To(Equal([]string{"hub-b:4"}))
+			})
+
+			It("serves forwarded requests locally", func() {
+				response := NewEventsAPI(local, f).V2ListEvents(callerContext(), events.V2ListEventsParams{
+					HTTPRequest: forwardedRequest(http.MethodGet, "/v2/events", memberToken("hub-a", sharedSecret, time.Now())),
+					Federated:   swag.Bool(true),
+				})
+				Expect(messages(response)).To(Equal([]string{":1", ":3"}))
+				Expect(hubA.received()).To(BeEmpty())
+			})
+		})
+
+		Context("Authenticator", func() {
+			var authenticator runtime.Authenticator
+
+			BeforeEach(func() {
+				create := f.Authenticator(func(name, in string, authenticate security.TokenAuthentication) runtime.Authenticator {
+					return security.APIKeyAuth(name, in, authenticate)
+				})
+				authenticator = create(userAuthHeader, "header", func(token string) (interface{}, error) {
+					if token != callerAuth {
+						return nil, openapierrors.Unauthenticated("userAuth")
+					}
+					return "user", nil
+				})
+			})
+
+			// delegatedRequest returns a request forwarded by hub-a with the identity of the caller
+			delegatedRequest := func(secret, audience string, signedAt time.Time) *http.Request {
+				sender := &Federation{cfg: Config{InstanceID: "hub-a", SharedSecret: secret}}
+				identity, err := sender.identityToken(callerPayload, audience, signedAt)
+				Expect(err).ToNot(HaveOccurred())
+				request := httptest.NewRequest(http.MethodGet, client.DefaultBasePath+"/v2/clusters", nil)
+				request.Header.Set(ForwardedHeader, sender.forwardedToken(signedAt))
+				request.Header.Set(IdentityHeader, identity)
+				return request
+			}
+
+			It("authenticates the caller with the identity delegated by a member", func() {
+				applies, principal, err := authenticator.Authenticate(delegatedRequest(sharedSecret, localInstanceID, time.Now()))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(applies).To(BeTrue())
+				Expect(principal).To(Equal(callerPayload))
+			})
+
+			DescribeTable("doesn't accept an invalid delegated identity",
+				func(secret, audience string, signedAt time.Time) {
+					applies, principal, _ := authenticator.Authenticate(delegatedRequest(secret, audience, signedAt))
+					Expect(applies).To(BeFalse())
+					Expect(principal).To(BeNil())
+				},
+				Entry("other secret", "other-secret", localInstanceID, time.Now()),
+				Entry("other member", sharedSecret, "hub-b", time.Now()),
+				Entry("expired", sharedSecret, localInstanceID, time.Now().Add(-time.Hour)),
+			)
+
+			It("authenticates the other requests with the credentials of the caller", func() {
+				applies, principal, err := authenticator.Authenticate(callerRequest(http.MethodGet, "/v2/clusters"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(applies).To(BeTrue())
+				Expect(principal).To(Equal("user"))
+			})
+		})
+
+		Context("Router", func() {
+			var (
+				local  *fakeLocalResources
+				server *httptest.Server
+			)
+
+			BeforeEach(func() {
+				local = &fakeLocalResources{clusters: map[strfmt.UUID]bool{}, infraEnvs: map[strfmt.UUID]bool{}}
+				h, _, err := restapi.HandlerAPI(restapi.Config{
+					AuthAgentAuth: func(string) (interface{}, error) {
+						return nil, openapierrors.Unauthenticated("agentAuth")
+					},
+					AuthUserAuth: func(token string) (interface{}, error) {
+						if token != callerAuth {
+							return nil, openapierrors.Unauthenticated("userAuth")
+						}
+						return callerPayload, nil
+					},
+					Authorizer:   func(*http.Request) error { return nil },
+					InstallerAPI: mockInstaller,
+					InnerMiddleware: func(h http.Handler) http.Handler {
+						return f.Router(h, local)
+					},
+					Logger: logrus.Printf,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				server = httptest.NewServer(h)
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			send := func(request *http.Request) *http.Response {
+				request.RequestURI = ""
+				serverURL, err := url.Parse(server.URL)
+				Expect(err).ToNot(HaveOccurred())
+				request.URL.Scheme = serverURL.Scheme
+				request.URL.Host = serverURL.Host
+				response, err := http.DefaultClient.Do(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Body.Close()).To(Succeed())
+				return response
+			}
+
+			It("routes the writes of a cluster to its owner with the credentials of the caller", func() {
+				clusterID := hubB.addCluster()
+				response := send(callerRequest(http.MethodPatch, "/v2/clusters/"+clusterID.String()))
+
+				Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+				requests := hubB.received()
+				Expect(requests).To(HaveLen(2))
+				Expect(requests[0].Method).To(Equal(http.MethodGet))
+				Expect(requests[1].Method).To(Equal(http.MethodPatch))
+				Expect(requests[1].URL.Path).To(Equal(client.DefaultBasePath + "/v2/clusters/" + clusterID.String()))
+				for _, request := range requests {
+					expectForwarded(request, "hub-b")
+				}
+			})
+
+			It("routes the writes of an infra-env to its owner", func() {
+				infraEnvID := hubA.addInfraEnv()
+				response := send(callerRequest(http.MethodPatch, "/v2/infra-envs/"+infraEnvID.String()))
+
+				Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+				Expect(hubA.received()).To(HaveLen(2))
+				Expect(hubA.received()[1].Method).To(Equal(http.MethodPatch))
+			})
+
+			It("doesn't route the writes of unauthenticated callers", func() {
+				clusterID := hubB.addCluster()
+				request := callerRequest(http.MethodDelete, "/v2/clusters/"+clusterID.String())
+				request.Header.Set(userAuthHeader, "Bearer invalid-token")
+				response := send(request)
+
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(hubA.received()).To(BeEmpty())
+				Expect(hubB.received()).To(BeEmpty())
+			})
+
+			It("serves the writes of the local clusters", func() {
+				clusterID := hubB.addCluster()
+				local.clusters[clusterID] = true
+				mockInstaller.EXPECT().V2DeregisterCluster(gomock.Any(), gomock.Any()).
+					Return(installer.NewV2DeregisterClusterNoContent()).Times(1)
+				response := send(callerRequest(http.MethodDelete, "/v2/clusters/"+clusterID.String()))
+
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(hubB.received()).To(BeEmpty())
+			})
+
+			It("serves the writes of the clusters no member owns", func() {
+				mockInstaller.EXPECT().V2DeregisterCluster(gomock.Any(), gomock.Any()).
+					Return(installer.NewV2DeregisterClusterNoContent()).Times(1)
+				response := send(callerRequest(http.MethodDelete, "/v2/clusters/"+uuid.New().String()))
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("serves the reads locally", func() {
+				clusterID := hubB.addCluster()
+				mockInstaller.EXPECT().V2GetCluster(gomock.Any(), gomock.Any()).
+					Return(installer.NewV2GetClusterOK().WithPayload(&models.Cluster{ID: &clusterID})).Times(1)
+				response := send(callerRequest(http.MethodGet, "/v2/clusters/"+clusterID.String()))
+
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(hubB.received()).To(BeEmpty())
+			})
+
+			It("serves the forwarded writes locally", func() {
+				clusterID := hubB.addCluster()
+				mockInstaller.EXPECT().V2DeregisterCluster(gomock.Any(), gomock.Any()).
+					Return(installer.NewV2DeregisterClusterNoContent()).Times(1)
+				response := send(forwardedRequest(http.MethodDelete, "/v2/clusters/"+clusterID.String(),
+					memberToken("hub-a", sharedSecret, time.Now())))
+
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(hubB.received()).To(BeEmpty())
+			})
+
+			It("routes the writes with a forged forwarded header", func() {
+				clusterID := hubB.addCluster()
+				response := send(forwardedRequest(http.MethodDelete, "/v2/clusters/"+clusterID.String(), "hub-a"))
+
+				Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+				Expect(hubB.received()).To(HaveLen(2))
+				expectForwarded(hubB.received()[1], "hub-b")
+			})
+
+			It("serves the other writes locally", func() {
+				response := send(callerRequest(http.MethodPost, "/v2/clusters"))
+				Expect(response.StatusCode).To(BeNumerically(">=", http.StatusBadRequest))
+				Expect(hubA.received()).To(BeEmpty())
+				Expect(hubB.received()).To(BeEmpty())
+			})
+
+			It("fails when the owner can't be reached", func() {
+				hubB.server.Close()
+				proxy, err := f.proxy(&models.FederationMember{ID: swag.String(hubB.id), URL: swag.String(hubB.server.URL)}, callerPayload)
+				Expect(err).ToNot(HaveOccurred())
+				recorder := httptest.NewRecorder()
+				proxy.ServeHTTP(recorder, callerRequest(http.MethodPatch, "/v2/clusters/"+uuid.New().String()))
+				Expect(recorder.Code).To(Equal(http.StatusBadGateway))
+			})
+		})
+	})
+})
+
+type fakeEventsAPI struct {
+	restapi.EventsAPI
+	events   models.EventList
+	notFound bool
+	params   []events.V2ListEventsParams
+}
+
+func (a *fakeEventsAPI) V2ListEvents(_ context.Context, params events.V2ListEventsParams) middleware.Responder {
+	a.params = append(a.params, params)
+	if a.notFound {
+		return common.NewApiError(http.StatusNotFound, errors.New("not found"))
+	}
+	return events.NewV2ListEventsOK().WithEventCount(int64(len(a.events))).WithPayload(a.events)
+}
+
+type fakeLocalResources struct {
+	clusters  map[strfmt.UUID]bool
+	infraEnvs map[strfmt.UUID]bool
+}
+
+func (r *fakeLocalResources) ClusterExists(clusterID strfmt.UUID) (bool, error) {
+	return r.clusters[clusterID], nil
+}
+
+func (r *fakeLocalResources) InfraEnvExists(infraEnvID strfmt.UUID) (bool, error) {
+	return r.infraEnvs[infraEnvID], nil
+}
@@ -0,0 +1,84 @@
+package federation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/client"
+	clientinstaller "github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+)
+
+var _ restapi.InstallerAPI = &installerAPI{}
+
+// installerAPI aggregates the clusters of the members of the federation, and retrieves the clusters owned by other
+// members from their owner
+type installerAPI struct {
+	restapi.InstallerAPI
+	federation *Federation
+}
+
+func NewInstallerAPI(inner restapi.InstallerAPI, federation *Federation) restapi.InstallerAPI {
+	return &installerAPI{InstallerAPI: inner, federation: federation}
+}
+
+func (a *installerAPI) V2ListClusters(ctx context.Context, params installer.V2ListClustersParams) middleware.Responder {
+	response := a.InstallerAPI.V2ListClusters(ctx, params)
+	if !swag.BoolValue(params.Federated) || a.federation.isForwarded(params.HTTPRequest) {
+		return response
+	}
+	local, ok := response.(*installer.V2ListClustersOK)
+	if !ok {
+		return response
+	}
+
+	clusters := make(models.ClusterList, 0, len(local.Payload))
+	for _, cluster := range local.Payload {
+		cluster.FederationInstanceID = a.federation.cfg.InstanceID
+		clusters = append(clusters, cluster)
+	}
+	results := fanOut(ctx, a.federation, a.federation.callerClients(ctx), a.federation.peers(),
+		func(ctx context.Context, cli *client.AssistedInstall) (models.ClusterList, error) {
+			response, err := cli.Installer.V2ListClusters(ctx, &clientinstaller.V2ListClustersParams{
+				AmsSubscriptionIds:      params.AmsSubscriptionIds,
+				GetUnregisteredClusters: params.GetUnregisteredClusters,
+				OpenshiftClusterID:      params.OpenshiftClusterID,
+				Owner:                   params.Owner,
+				WithHosts:               params.WithHosts,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return response.Payload, nil
+		})
+	for _, result := range results {
+		for _, cluster := range result.value {
+			cluster.FederationInstanceID = result.member
+			clusters = append(clusters, cluster)
+		}
+	}
+	return installer.NewV2ListClustersOK().WithPayload(clusters)
+}
+
+func (a *installerAPI) V2GetCluster(ctx context.Context, params installer.V2GetClusterParams) middleware.Responder {
+	response := a.InstallerAPI.V2GetCluster(ctx, params)
+	if !isNotFound(response) || a.federation.isForwarded(params.HTTPRequest) {
+		return response
+	}
+	result, found := a.federation.locateCluster(ctx, a.federation.callerClients(ctx), params.ClusterID)
+	if !found {
+		return response
+	}
+	result.value.FederationInstanceID = result.member
+	return installer.NewV2GetClusterOK().WithPayload(result.value)
+}
+
+func isNotFound(response middleware.Responder) bool {
+	apiErr, ok := response.(*common.ApiErrorResponse)
+	return ok && apiErr.StatusCode() == http.StatusNotFound
+}
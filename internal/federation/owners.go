@@ -0,0 +1,64 @@
+package federation
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// ownerCache remembers the members that own the clusters and infra-envs located in the federation, bounded to the
+// given size by evicting the least recently used resources first
+type ownerCache struct {
+	lock    sync.Mutex
+	maxSize int
+	owners  map[strfmt.UUID]*list.Element
+	// lru holds the resources, the most recently used first
+	lru *list.List
+}
+
+type resourceOwner struct {
+	id     strfmt.UUID
+	member string
+}
+
+func newOwnerCache(maxSize int) *ownerCache {
+	return &ownerCache{
+		maxSize: maxSize,
+		owners:  map[strfmt.UUID]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+func (c *ownerCache) get(id strfmt.UUID) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	elem, ok := c.owners[id]
+	if !ok {
+		return ""
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*resourceOwner).member
+}
+
+func (c *ownerCache) add(id strfmt.UUID, member string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.owners[id]; ok {
+		elem.Value.(*resourceOwner).member = member
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.owners[id] = c.lru.PushFront(&resourceOwner{id: id, member: member})
+	for c.lru.Len() > c.maxSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.owners, oldest.Value.(*resourceOwner).id)
+	}
+}
+
+func (c *ownerCache) len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.lru.Len()
+}
@@ -0,0 +1,36 @@
+package federation
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ownerCache", func() {
+	It("evicts the least recently used resources", func() {
+		cache := newOwnerCache(2)
+		first := strfmt.UUID(uuid.New().String())
+		second := strfmt.UUID(uuid.New().String())
+		third := strfmt.UUID(uuid.New().String())
+
+		cache.add(first, "hub-a")
+		cache.add(second, "hub-b")
+		Expect(cache.get(first)).To(Equal("hub-a"))
+		cache.add(third, "hub-a")
+
+		Expect(cache.len()).To(Equal(2))
+		Expect(cache.get(first)).To(Equal("hub-a"))
+		Expect(cache.get(second)).To(BeEmpty())
+		Expect(cache.get(third)).To(Equal("hub-a"))
+	})
+
+	It("updates the owner of a resource", func() {
+		cache := newOwnerCache(2)
+		id := strfmt.UUID(uuid.New().String())
+		cache.add(id, "hub-a")
+		cache.add(id, "hub-b")
+		Expect(cache.len()).To(Equal(1))
+		Expect(cache.get(id)).To(Equal("hub-b"))
+	})
+})
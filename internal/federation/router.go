@@ -0,0 +1,151 @@
+package federation
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/client"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"gorm.io/gorm"
+)
+
+var routedPath = regexp.MustCompile(`^` + regexp.QuoteMeta(client.DefaultBasePath) +
+	`/v2/(clusters|infra-envs)/([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})(/|$)`)
+
+// LocalResources tells whether the clusters and infra-envs are owned by this instance
+type LocalResources interface {
+	ClusterExists(clusterID strfmt.UUID) (bool, error)
+	InfraEnvExists(infraEnvID strfmt.UUID) (bool, error)
+}
+
+type dbResources struct {
+	db *gorm.DB
+}
+
+func NewDBResources(db *gorm.DB) LocalResources {
+	return &dbResources{db: db}
+}
+
+func (r *dbResources) ClusterExists(clusterID strfmt.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *dbResources) InfraEnvExists(infraEnvID strfmt.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID.String()).Count(&count).Error
+	return count > 0, err
+}
+
+// Router proxies the writes of the clusters and infra-envs owned by other members of the federation to their owner.
+// It is an inner middleware of the API, so that the caller is authenticated with the authenticators of the matched
+// route before the write is routed. The owner is located, and the write is proxied, with the identity of the caller
+// delegated to each member so that the owner authorizes the caller, the credentials of the caller aren't forwarded.
+func (f *Federation) Router(next http.Handler, local LocalResources) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		member, payload := f.writeOwner(r, local)
+		if member == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		proxy, err := f.proxy(member, payload)
+		if err != nil {
+			f.log.WithError(err).Errorf("failed to route %s %s to federation member %s", r.Method, r.URL.Path,
+				swag.StringValue(member.ID))
+			common.NewApiError(http.StatusBadGateway, err).WriteResponse(w, runtime.JSONProducer())
+			return
+		}
+		proxy.ServeHTTP(w, r)
+	})
+}
+
+// writeOwner returns the member that owns the cluster or the infra-env written by the request and the identity of the
+// caller, a nil member when the request must be served locally
+func (f *Federation) writeOwner(r *http.Request, local LocalResources) (*models.FederationMember, *ocm.AuthPayload) {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return nil, nil
+	}
+	match := routedPath.FindStringSubmatch(r.URL.Path)
+	if match == nil || f.isForwarded(r) {
+		return nil, nil
+	}
+	payload := authenticatedPayload(r)
+	if payload == nil {
+		return nil, nil
+	}
+	id := strfmt.UUID(match[2])
+	exists := local.ClusterExists
+	if match[1] == "infra-envs" {
+		exists = local.InfraEnvExists
+	}
+	found, err := exists(id)
+	if err != nil {
+		f.log.WithError(err).Warnf("failed to check whether %s %s is owned by this instance", match[1], id)
+		return nil, nil
+	}
+	if found {
+		return nil, nil
+	}
+
+	var owner string
+	if match[1] == "infra-envs" {
+		if result, ok := f.locateInfraEnv(r.Context(), f.delegatedClients(payload), id); ok {
+			owner = result.member
+		}
+	} else if result, ok := f.locateCluster(r.Context(), f.delegatedClients(payload), id); ok {
+		owner = result.member
+	}
+	return f.peer(owner), payload
+}
+
+// authenticatedPayload returns the identity of the user authenticated by the authenticators of the matched route,
+// nil for the other requests which are served locally and rejected as usual
+func authenticatedPayload(r *http.Request) *ocm.AuthPayload {
+	route := middleware.MatchedRouteFrom(r)
+	if route == nil || !route.HasAuth() {
+		return nil
+	}
+	applies, principal, err := route.Authenticators.Authenticate(r, route)
+	if !applies || err != nil {
+		return nil
+	}
+	payload, _ := principal.(*ocm.AuthPayload)
+	return payload
+}
+
+func (f *Federation) proxy(member *models.FederationMember, payload *ocm.AuthPayload) (http.Handler, error) {
+	target, err := url.Parse(swag.StringValue(member.URL))
+	if err != nil {
+		return nil, err
+	}
+	identity, err := f.identityToken(payload, swag.StringValue(member.ID), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.Transport = f.transport
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		r.Host = target.Host
+		r.Header.Del(userAuthHeader)
+		r.Header.Set(IdentityHeader, identity)
+		r.Header.Set(ForwardedHeader, f.forwardedToken(time.Now()))
+	}
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		f.log.WithError(err).Errorf("failed to route %s %s to federation member %s", r.Method, r.URL.Path,
+			swag.StringValue(member.ID))
+		common.NewApiError(http.StatusBadGateway, err).WriteResponse(w, runtime.JSONProducer())
+	}
+	return proxy, nil
+}
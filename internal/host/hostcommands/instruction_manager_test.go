@@ -181,6 +181,24 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeInstall,
 				})
 			})
+			It("installing with vip", func() {
+				Expect(db.Save(&models.APIVip{IP: "1.2.3.4", ClusterID: clusterId}).Error).ToNot(HaveOccurred())
+				checkStep(models.HostStatusInstalling, []models.StepType{
+					models.StepTypeInstall, models.StepTypeVerifyVips,
+				})
+			})
+			It("installing-in-progress with vip", func() {
+				Expect(db.Save(&models.APIVip{IP: "1.2.3.4", ClusterID: clusterId}).Error).ToNot(HaveOccurred())
+				checkStep(models.HostStatusInstallingInProgress, []models.StepType{
+					models.StepTypeVerifyVips,
+				})
+			})
+			It("preparing-successful with vip", func() {
+				Expect(db.Save(&models.APIVip{IP: "1.2.3.4", ClusterID: clusterId}).Error).ToNot(HaveOccurred())
+				checkStep(models.HostStatusPreparingSuccessful, []models.StepType{
+					models.StepTypeVerifyVips,
+				})
+			})
 			It("reset", func() {
 				checkStep(models.HostStatusResetting, []models.StepType{})
 			})
@@ -223,6 +223,18 @@ func newValidations(v *validator) []validation {
 			id:        IsMtuValid,
 			condition: v.isMtuValid,
 		},
+		{
+			id:        AreBondMembersConsistent,
+			condition: v.areBondMembersConsistent,
+		},
+		{
+			id:        AreVlanInterfacesConsistent,
+			condition: v.areVlanInterfacesConsistent,
+		},
+		{
+			id:        AreVlanSubnetsConsistent,
+			condition: v.areVlanSubnetsConsistent,
+		},
 		{
 			id:        IsPlatformNetworkSettingsValid,
 			condition: v.isValidPlatformNetworkSettings,
@@ -1020,6 +1020,7 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(AreMetalLBRequirementsSatisfied),
 		If(AreLokiRequirementsSatisfied),
 		If(AreOpenShiftLoggingRequirementsSatisfied),
+		If(AreDeclarativeOperatorsRequirementsSatisfied),
 		/*
 					 * MGMT-15213: The release domain is not resolved correctly when there is a mirror or proxy.  In this case
 					 * validation might fail, but the installation may succeed.
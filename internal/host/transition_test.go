@@ -6676,6 +6676,7 @@ var allValidationIDs = []validationID{
 	AreMetalLBRequirementsSatisfied,
 	AreLokiRequirementsSatisfied,
 	AreOpenShiftLoggingRequirementsSatisfied,
+	AreDeclarativeOperatorsRequirementsSatisfied,
 }
 
 var allConditions = []conditionId{
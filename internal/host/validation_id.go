@@ -62,6 +62,9 @@ const (
 	AreOpenShiftAIRequirementsSatisfied            = validationID(models.HostValidationIDOpenshiftAiRequirementsSatisfied)
 	AreAuthorinoRequirementsSatisfied              = validationID(models.HostValidationIDAuthorinoRequirementsSatisfied)
 	IsMtuValid                                     = validationID(models.HostValidationIDMtuValid)
+	AreBondMembersConsistent                       = validationID(models.HostValidationIDBondMembersConsistent)
+	AreVlanInterfacesConsistent                    = validationID(models.HostValidationIDVlanInterfacesConsistent)
+	AreVlanSubnetsConsistent                       = validationID(models.HostValidationIDVlanSubnetsConsistent)
 	AreNmstateRequirementsSatisfied                = validationID(models.HostValidationIDNmstateRequirementsSatisfied)
 	AreAMDGPURequirementsSatisfied                 = validationID(models.HostValidationIDAmdGpuRequirementsSatisfied)
 	AreKMMRequirementsSatisfied                    = validationID(models.HostValidationIDKmmRequirementsSatisfied)
@@ -76,6 +79,7 @@ const (
 	AreMetalLBRequirementsSatisfied                = validationID(models.HostValidationIDMetallbRequirementsSatisfied)
 	AreLokiRequirementsSatisfied                   = validationID(models.HostValidationIDLokiRequirementsSatisfied)
 	AreOpenShiftLoggingRequirementsSatisfied       = validationID(models.HostValidationIDOpenshiftLoggingRequirementsSatisfied)
+	AreDeclarativeOperatorsRequirementsSatisfied   = validationID(models.HostValidationIDDeclarativeOperatorsRequirementsSatisfied)
 )
 
 func (v validationID) category() (string, error) {
@@ -101,6 +105,9 @@ func (v validationID) category() (string, error) {
 		IsReleaseDomainNameResolvedCorrectly,
 		NoIPCollisionsInNetwork,
 		IsMtuValid,
+		AreBondMembersConsistent,
+		AreVlanInterfacesConsistent,
+		AreVlanSubnetsConsistent,
 		NoIscsiNicBelongsToMachineCidr:
 		return "network", nil
 	case HasInventory,
@@ -146,7 +153,8 @@ func (v validationID) category() (string, error) {
 		AreOADPRequirementsSatisfied,
 		AreMetalLBRequirementsSatisfied,
 		AreLokiRequirementsSatisfied,
-		AreOpenShiftLoggingRequirementsSatisfied:
+		AreOpenShiftLoggingRequirementsSatisfied,
+		AreDeclarativeOperatorsRequirementsSatisfied:
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected validation id %s", string(v)))
@@ -2255,6 +2255,169 @@ var _ = Describe("Validations test", func() {
 		})
 	})
 
+	Context("Bond and VLAN validations", func() {
+		var hostValidator validator
+
+		BeforeEach(func() {
+			hostValidator = validator{log: common.GetTestLog()}
+		})
+
+		bondInventory := func(port2Mtu int64, port2Carrier bool) *models.Inventory {
+			return &models.Inventory{
+				Interfaces: []*models.Interface{
+					{Name: "bond0", Type: "bond", Mtu: 9000, HasCarrier: true},
+					{Name: "eth0", Controller: "bond0", Mtu: 9000, HasCarrier: true},
+					{Name: "eth1", Controller: "bond0", Mtu: port2Mtu, HasCarrier: port2Carrier},
+				},
+			}
+		}
+
+		vlanHost := func(name string, vlanID int64, address string) *models.Host {
+			id := strfmt.UUID(uuid.New().String())
+			inventory := models.Inventory{
+				Hostname: name,
+				Interfaces: []*models.Interface{
+					{Name: "eth0", Mtu: 1500},
+					{Name: fmt.Sprintf("eth0.%d", vlanID), Type: "vlan", Mtu: 1500, IPV4Addresses: []string{address}},
+				},
+			}
+			b, err := json.Marshal(&inventory)
+			Expect(err).ToNot(HaveOccurred())
+			return &models.Host{ID: &id, InfraEnvID: id, Inventory: string(b)}
+		}
+
+		It("suppresses the bond validation for hosts without bonds", func() {
+			status, _ := hostValidator.areBondMembersConsistent(&validationContext{
+				host:      &models.Host{},
+				inventory: &models.Inventory{Interfaces: []*models.Interface{{Name: "eth0"}}},
+			})
+			Expect(status).To(Equal(ValidationSuccessSuppressOutput))
+		})
+
+		It("accepts consistent bond ports", func() {
+			status, message := hostValidator.areBondMembersConsistent(&validationContext{
+				host:      &models.Host{},
+				inventory: bondInventory(9000, true),
+			})
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Bond and team ports are consistent"))
+		})
+
+		It("fails on bond ports with different MTU", func() {
+			status, message := hostValidator.areBondMembersConsistent(&validationContext{
+				host:      &models.Host{},
+				inventory: bondInventory(1500, true),
+			})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("port eth1 of bond0 has MTU 1500 while bond0 has MTU 9000"))
+		})
+
+		It("reports the bond ports without carrier while another port has carrier", func() {
+			status, message := hostValidator.areBondMembersConsistent(&validationContext{
+				host:      &models.Host{},
+				inventory: bondInventory(9000, false),
+			})
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Bond and team ports are consistent, but port eth1 of bond0 has no carrier"))
+		})
+
+		It("fails when no port of the bond has carrier", func() {
+			inventory := bondInventory(9000, false)
+			inventory.Interfaces[1].HasCarrier = false
+			status, message := hostValidator.areBondMembersConsistent(&validationContext{
+				host:      &models.Host{},
+				inventory: inventory,
+			})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("Bond and team ports are inconsistent: no port of bond0 has carrier"))
+		})
+
+		It("fails on bond ports that do not reach the same hosts", func() {
+			remoteHostID := strfmt.UUID(uuid.New().String())
+			report, err := hostutil.MarshalConnectivityReport(&models.ConnectivityReport{
+				RemoteHosts: []*models.ConnectivityRemoteHost{
+					{
+						HostID: remoteHostID,
+						L2Connectivity: []*models.L2Connectivity{
+							{OutgoingNic: "eth0", Successful: true},
+							{OutgoingNic: "eth1", Successful: false},
+						},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			status, message := hostValidator.areBondMembersConsistent(&validationContext{
+				host:      &models.Host{Connectivity: report},
+				inventory: bondInventory(9000, true),
+			})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("ports eth0 and eth1 of bond0 do not reach the same hosts"))
+		})
+
+		It("doesn't compare the reachability of the bond ports without carrier", func() {
+			report, err := hostutil.MarshalConnectivityReport(&models.ConnectivityReport{
+				RemoteHosts: []*models.ConnectivityRemoteHost{
+					{
+						HostID: strfmt.UUID(uuid.New().String()),
+						L2Connectivity: []*models.L2Connectivity{
+							{OutgoingNic: "eth0", Successful: true},
+							{OutgoingNic: "eth1", Successful: false},
+						},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			status, _ := hostValidator.areBondMembersConsistent(&validationContext{
+				host:      &models.Host{Connectivity: report},
+				inventory: bondInventory(9000, false),
+			})
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("fails on a VLAN interface with a larger MTU than its base interface", func() {
+			status, message := hostValidator.areVlanInterfacesConsistent(&validationContext{
+				inventory: &models.Inventory{
+					Interfaces: []*models.Interface{
+						{Name: "eth0", Mtu: 1500},
+						{Name: "vlan100", VlanID: 100, VlanBaseInterface: "eth0", Mtu: 9000},
+					},
+				},
+			})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("VLAN 100 interface vlan100 has MTU 9000 which exceeds the MTU 1500 of eth0"))
+		})
+
+		It("accepts a VLAN mapped to the same subnet on all hosts", func() {
+			host1 := vlanHost("host1", 100, "10.0.100.10/24")
+			host2 := vlanHost("host2", 100, "10.0.100.11/24")
+			inventory, err := common.UnmarshalInventory(host1.Inventory)
+			Expect(err).ToNot(HaveOccurred())
+			status, _ := hostValidator.areVlanSubnetsConsistent(&validationContext{
+				host:           host1,
+				inventory:      inventory,
+				inventoryCache: make(InventoryCache),
+				cluster:        &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{host1, host2}}},
+			})
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("warns about a VLAN mapped to different subnets on different hosts", func() {
+			host1 := vlanHost("host1", 100, "10.0.100.10/24")
+			host2 := vlanHost("host2", 100, "10.0.200.11/24")
+			inventory, err := common.UnmarshalInventory(host1.Inventory)
+			Expect(err).ToNot(HaveOccurred())
+			status, message := hostValidator.areVlanSubnetsConsistent(&validationContext{
+				host:           host1,
+				inventory:      inventory,
+				inventoryCache: make(InventoryCache),
+				cluster:        &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{host1, host2}}},
+			})
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(ContainSubstring("only expected when the hosts are in different L2 domains"))
+			Expect(message).To(ContainSubstring("VLAN 100 is on 10.0.100.0/24 but on 10.0.200.0/24 on host host2"))
+		})
+	})
+
 	Context("Has Min Valid Disks", func() {
 		var (
 			host    models.Host
@@ -8,6 +8,8 @@ import (
 	"math"
 	"net"
 	"net/url"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -1763,6 +1765,163 @@ func (v *validator) nonOverlappingSubnets(c *validationContext) (ValidationStatu
 	}
 }
 
+// bondMemberL2Peers returns, for each port of the bond that appears in the connectivity report of the host, the
+// sorted IDs of the remote hosts it has successful L2 connectivity with
+func bondMemberL2Peers(report *models.ConnectivityReport, members []*models.Interface) map[string][]string {
+	ret := make(map[string][]string)
+	for _, member := range members {
+		for _, remoteHost := range report.RemoteHosts {
+			for _, l2 := range remoteHost.L2Connectivity {
+				if l2.OutgoingNic != member.Name {
+					continue
+				}
+				if _, ok := ret[member.Name]; !ok {
+					ret[member.Name] = []string{}
+				}
+				if l2.Successful && !funk.ContainsString(ret[member.Name], remoteHost.HostID.String()) {
+					ret[member.Name] = append(ret[member.Name], remoteHost.HostID.String())
+				}
+			}
+		}
+		sort.Strings(ret[member.Name])
+	}
+	return ret
+}
+
+// areBondMembersConsistent checks the ports of the bonds and teams of the host. A bond keeps working while one of its
+// ports has carrier, whatever its mode, so the ports without carrier are only reported and the validation fails when
+// none of the ports has carrier. The ports are only known when the agent reports the controller of the interfaces,
+// the validation is skipped otherwise. The L2 reachability of the ports with carrier is compared when the connectivity
+// report has results sent from the ports themselves, the connectivity checks usually run from the bond.
+func (v *validator) areBondMembersConsistent(c *validationContext) (ValidationStatus, string) {
+	if c.inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	bonds := network.GetBondMembers(c.inventory)
+	if len(bonds) == 0 {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	var report *models.ConnectivityReport
+	if c.host.Connectivity != "" {
+		var err error
+		if report, err = hostutil.UnmarshalConnectivityReport(c.host.Connectivity); err != nil {
+			v.log.WithError(err).Warnf("failed to unmarshal connectivity report of host %s", c.host.ID.String())
+		}
+	}
+
+	var failures, warnings []string
+	for _, bondName := range funk.Keys(bonds).([]string) {
+		members := bonds[bondName]
+		bond := network.GetInterfaceByName(c.inventory, bondName)
+		var withoutCarrier []string
+		for _, member := range members {
+			if !member.HasCarrier {
+				withoutCarrier = append(withoutCarrier, member.Name)
+			}
+			if bond != nil && bond.Mtu != 0 && member.Mtu != 0 && member.Mtu != bond.Mtu {
+				failures = append(failures, fmt.Sprintf("port %s of %s has MTU %d while %s has MTU %d", member.Name, bondName, member.Mtu, bondName, bond.Mtu))
+			}
+		}
+		if len(withoutCarrier) == len(members) {
+			failures = append(failures, fmt.Sprintf("no port of %s has carrier", bondName))
+			continue
+		}
+		for _, name := range withoutCarrier {
+			warnings = append(warnings, fmt.Sprintf("port %s of %s has no carrier", name, bondName))
+		}
+		if report == nil {
+			continue
+		}
+		peers := bondMemberL2Peers(report, funk.Filter(members, func(member *models.Interface) bool { return member.HasCarrier }).([]*models.Interface))
+		memberNames := funk.Keys(peers).([]string)
+		sort.Strings(memberNames)
+		for i := 1; i < len(memberNames); i++ {
+			if !reflect.DeepEqual(peers[memberNames[i]], peers[memberNames[0]]) {
+				failures = append(failures, fmt.Sprintf("ports %s and %s of %s do not reach the same hosts", memberNames[0], memberNames[i], bondName))
+			}
+		}
+	}
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		return ValidationFailure, fmt.Sprintf("Bond and team ports are inconsistent: %s", strings.Join(failures, "; "))
+	}
+	if len(warnings) > 0 {
+		sort.Strings(warnings)
+		return ValidationSuccess, fmt.Sprintf("Bond and team ports are consistent, but %s", strings.Join(warnings, "; "))
+	}
+	return ValidationSuccess, "Bond and team ports are consistent"
+}
+
+func (v *validator) areVlanInterfacesConsistent(c *validationContext) (ValidationStatus, string) {
+	if c.inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	var failures []string
+	hasVlans := false
+	for _, intf := range c.inventory.Interfaces {
+		vlanID, baseName, ok := network.InterfaceVlan(intf)
+		if !ok {
+			continue
+		}
+		hasVlans = true
+		base := network.GetInterfaceByName(c.inventory, baseName)
+		if base == nil {
+			continue
+		}
+		if intf.Mtu != 0 && base.Mtu != 0 && intf.Mtu > base.Mtu {
+			failures = append(failures, fmt.Sprintf("VLAN %d interface %s has MTU %d which exceeds the MTU %d of %s", vlanID, intf.Name, intf.Mtu, base.Mtu, base.Name))
+		}
+	}
+	if !hasVlans {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if len(failures) > 0 {
+		return ValidationFailure, fmt.Sprintf("VLAN interfaces are inconsistent: %s", strings.Join(failures, "; "))
+	}
+	return ValidationSuccess, "VLAN interfaces are consistent with their base interfaces"
+}
+
+// areVlanSubnetsConsistent compares the subnets of the VLANs of the host with the subnets of the same VLAN IDs on the
+// other hosts of the cluster. VLAN IDs are only significant within an L2 domain, hosts in different racks of an L3
+// leaf-spine network can reuse a VLAN ID for different subnets, so a mismatch is only reported as a warning.
+func (v *validator) areVlanSubnetsConsistent(c *validationContext) (ValidationStatus, string) {
+	if c.infraEnv != nil {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if c.inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	vlanSubnets := network.GetVlanSubnets(c.inventory)
+	if len(vlanSubnets) == 0 {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	var warnings []string
+	for _, h := range c.cluster.Hosts {
+		if h.ID.String() == c.host.ID.String() {
+			continue
+		}
+		inventory, err := c.inventoryCache.GetOrUnmarshal(h)
+		if err != nil || inventory == nil {
+			continue
+		}
+		otherVlanSubnets := network.GetVlanSubnets(inventory)
+		for vlanID, subnets := range vlanSubnets {
+			otherSubnets, ok := otherVlanSubnets[vlanID]
+			if !ok || len(subnets) == 0 || len(otherSubnets) == 0 || len(funk.IntersectString(subnets, otherSubnets)) > 0 {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("VLAN %d is on %s but on %s on host %s", vlanID,
+				strings.Join(subnets, ", "), strings.Join(otherSubnets, ", "), hostutil.GetHostnameForMsg(h)))
+		}
+	}
+	if len(warnings) > 0 {
+		sort.Strings(warnings)
+		return ValidationSuccess, fmt.Sprintf("VLAN IDs map to different subnets on different hosts, which is only expected when the hosts are in different L2 domains: %s",
+			strings.Join(warnings, "; "))
+	}
+	return ValidationSuccess, "VLAN IDs map to the same subnets on all hosts"
+}
+
 func (v *validator) isVSphereDiskUUIDEnabled(c *validationContext) (ValidationStatus, string) {
 	if c.cluster == nil {
 		return ValidationSuccessSuppressOutput, "no cluster"
@@ -0,0 +1,76 @@
+package network
+
+import (
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/samber/lo"
+)
+
+const vlanInterfaceType = "vlan"
+
+// InterfaceVlan returns the VLAN ID and the base interface of a VLAN interface.  Agents that do not report the VLAN
+// attributes, which is the case of the released agents, are handled by parsing the conventional <base>.<id> name of
+// the interfaces of type vlan. VLAN interfaces with other names are only found with the VLAN attributes.
+func InterfaceVlan(intf *models.Interface) (vlanID int64, baseInterface string, ok bool) {
+	if intf.VlanID > 0 {
+		return intf.VlanID, intf.VlanBaseInterface, true
+	}
+	if intf.Type != vlanInterfaceType {
+		return 0, "", false
+	}
+	idx := strings.LastIndex(intf.Name, ".")
+	if idx <= 0 {
+		return 0, "", false
+	}
+	id, err := strconv.ParseInt(intf.Name[idx+1:], 10, 64)
+	if err != nil || id <= 0 {
+		return 0, "", false
+	}
+	return id, intf.Name[:idx], true
+}
+
+// GetBondMembers maps the names of the bond and team interfaces of the inventory to their ports. The ports are only
+// known when the agent reports the controller of the interfaces, the map is empty otherwise.
+func GetBondMembers(inventory *models.Inventory) map[string][]*models.Interface {
+	ret := make(map[string][]*models.Interface)
+	for _, intf := range inventory.Interfaces {
+		if intf.Controller != "" {
+			ret[intf.Controller] = append(ret[intf.Controller], intf)
+		}
+	}
+	return ret
+}
+
+// GetInterfaceByName returns the interface of the inventory with the given name, or nil if there is none
+func GetInterfaceByName(inventory *models.Inventory, name string) *models.Interface {
+	intf, _ := lo.Find(inventory.Interfaces, func(i *models.Interface) bool { return i.Name == name })
+	return intf
+}
+
+// GetVlanSubnets maps the VLAN IDs of the inventory to the subnets of the addresses of their interfaces
+func GetVlanSubnets(inventory *models.Inventory) map[int64][]string {
+	ret := make(map[int64][]string)
+	for _, intf := range inventory.Interfaces {
+		vlanID, _, ok := InterfaceVlan(intf)
+		if !ok {
+			continue
+		}
+		for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+			_, ipnet, err := net.ParseCIDR(address)
+			if err != nil {
+				continue
+			}
+			ret[vlanID] = append(ret[vlanID], ipnet.String())
+		}
+	}
+	for vlanID, subnets := range ret {
+		subnets = lo.Uniq(subnets)
+		sort.Strings(subnets)
+		ret[vlanID] = subnets
+	}
+	return ret
+}
@@ -0,0 +1,51 @@
+package network
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("interface relations", func() {
+	It("reads the reported VLAN attributes", func() {
+		vlanID, base, ok := InterfaceVlan(&models.Interface{Name: "vlan100", VlanID: 100, VlanBaseInterface: "bond0"})
+		Expect(ok).To(BeTrue())
+		Expect(vlanID).To(Equal(int64(100)))
+		Expect(base).To(Equal("bond0"))
+	})
+
+	It("falls back to the VLAN interface name", func() {
+		vlanID, base, ok := InterfaceVlan(&models.Interface{Name: "bond0.200", Type: "vlan"})
+		Expect(ok).To(BeTrue())
+		Expect(vlanID).To(Equal(int64(200)))
+		Expect(base).To(Equal("bond0"))
+
+		_, _, ok = InterfaceVlan(&models.Interface{Name: "eth0.200", Type: "physical"})
+		Expect(ok).To(BeFalse())
+		_, _, ok = InterfaceVlan(&models.Interface{Name: "vlan", Type: "vlan"})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("groups bond ports by their controller", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{
+			{Name: "bond0"},
+			{Name: "eth0", Controller: "bond0"},
+			{Name: "eth1", Controller: "bond0"},
+			{Name: "eth2"},
+		}}
+		bonds := GetBondMembers(inventory)
+		Expect(bonds).To(HaveLen(1))
+		Expect(bonds["bond0"]).To(HaveLen(2))
+		Expect(GetInterfaceByName(inventory, "eth2")).ToNot(BeNil())
+		Expect(GetInterfaceByName(inventory, "eth3")).To(BeNil())
+	})
+
+	It("maps VLAN IDs to the subnets of their addresses", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{
+			{Name: "eth0", IPV4Addresses: []string{"192.168.1.10/24"}},
+			{Name: "eth0.100", Type: "vlan", IPV4Addresses: []string{"10.0.100.10/24"}, IPV6Addresses: []string{"2001:db8::10/64"}},
+			{Name: "eth1.100", Type: "vlan", IPV4Addresses: []string{"10.0.100.11/24"}},
+		}}
+		Expect(GetVlanSubnets(inventory)).To(Equal(map[int64][]string{100: {"10.0.100.0/24", "2001:db8::/64"}}))
+	})
+})
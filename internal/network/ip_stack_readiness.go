@@ -0,0 +1,338 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/models"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// ipStackHostData holds the parts of the host reports that are needed to evaluate the readiness of its IP stacks
+type ipStackHostData struct {
+	inventory    *models.Inventory
+	resolutions  *models.DomainResolutionResponse
+	ntpSources   []*models.NtpSource
+	connectivity *models.ConnectivityReport
+	images       common.ImageStatuses
+}
+
+func newIPStackCheck(name, status, message string) *models.IPStackCheck {
+	return &models.IPStackCheck{
+		Name:    swag.String(name),
+		Status:  swag.String(status),
+		Message: message,
+	}
+}
+
+func isIPv6Address(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() == nil
+}
+
+func loadIPStackHostData(host *models.Host, log logrus.FieldLogger) *ipStackHostData {
+	ret := &ipStackHostData{}
+	if host.Inventory != "" {
+		inventory, err := common.UnmarshalInventory(host.Inventory)
+		if err != nil {
+			log.WithError(err).Warnf("failed to parse inventory of host %s", host.ID.String())
+		} else {
+			ret.inventory = inventory
+		}
+	}
+	if host.DomainNameResolutions != "" {
+		var resolutions models.DomainResolutionResponse
+		if err := json.Unmarshal([]byte(host.DomainNameResolutions), &resolutions); err != nil {
+			log.WithError(err).Warnf("failed to parse domain name resolutions of host %s", host.ID.String())
+		} else {
+			ret.resolutions = &resolutions
+		}
+	}
+	if host.NtpSources != "" {
+		if err := json.Unmarshal([]byte(host.NtpSources), &ret.ntpSources); err != nil {
+			log.WithError(err).Warnf("failed to parse NTP sources of host %s", host.ID.String())
+			ret.ntpSources = nil
+		}
+	}
+	if host.ImagesStatus != "" {
+		images, err := common.UnmarshalImageStatuses(host.ImagesStatus)
+		if err != nil {
+			log.WithError(err).Warnf("failed to parse images status of host %s", host.ID.String())
+		} else {
+			ret.images = images
+		}
+	}
+	if host.Connectivity != "" {
+		var report models.ConnectivityReport
+		if err := json.Unmarshal([]byte(host.Connectivity), &report); err != nil {
+			log.WithError(err).Warnf("failed to parse connectivity report of host %s", host.ID.String())
+		} else {
+			ret.connectivity = &report
+		}
+	}
+	return ret
+}
+
+func checkIPStackAddresses(data *ipStackHostData, ipv6 bool) (*models.IPStackCheck, []string) {
+	if data.inventory == nil {
+		return newIPStackCheck(models.IPStackCheckNameAddresses, models.IPStackCheckStatusUnknown, "Inventory was not reported yet"), nil
+	}
+	ipv4Addresses, ipv6Addresses := GetInventoryIPAddresses(data.inventory)
+	addresses := lo.Ternary(ipv6, ipv6Addresses, ipv4Addresses)
+	if len(addresses) == 0 {
+		return newIPStackCheck(models.IPStackCheckNameAddresses, models.IPStackCheckStatusFailure, "No addresses were found"), addresses
+	}
+	return newIPStackCheck(models.IPStackCheckNameAddresses, models.IPStackCheckStatusSuccess,
+		fmt.Sprintf("%d addresses were found", len(addresses))), addresses
+}
+
+func checkIPStackDefaultRoute(data *ipStackHostData, ipv6 bool) *models.IPStackCheck {
+	if data.inventory == nil {
+		return newIPStackCheck(models.IPStackCheckNameDefaultRoute, models.IPStackCheckStatusUnknown, "Inventory was not reported yet")
+	}
+	route := GetDefaultRouteByFamily(data.inventory.Routes, ipv6)
+	if route == nil {
+		return newIPStackCheck(models.IPStackCheckNameDefaultRoute, models.IPStackCheckStatusFailure, "No default route was found")
+	}
+	return newIPStackCheck(models.IPStackCheckNameDefaultRoute, models.IPStackCheckStatusSuccess,
+		fmt.Sprintf("Default route via %s on %s", route.Gateway, route.Interface))
+}
+
+// checkIPStackResolution verifies that the domain resolves to addresses of the address family.  A domain that does not
+// resolve at all is reported as unknown, since the DNS validations of the host cover this case regardless of the
+// address family.
+func checkIPStackResolution(data *ipStackHostData, name, domainName string, ipv6 bool) *models.IPStackCheck {
+	if domainName == "" {
+		return newIPStackCheck(name, models.IPStackCheckStatusUnknown, "Domain name is not known")
+	}
+	if ip := net.ParseIP(domainName); ip != nil {
+		if isIPv6Address(domainName) != ipv6 {
+			return newIPStackCheck(name, models.IPStackCheckStatusFailure, fmt.Sprintf("%s is an address of another family", domainName))
+		}
+		return newIPStackCheck(name, models.IPStackCheckStatusSuccess, fmt.Sprintf("%s is an address", domainName))
+	}
+	if data.resolutions == nil {
+		return newIPStackCheck(name, models.IPStackCheckStatusUnknown, "Domain name resolutions were not reported yet")
+	}
+	resolution, found := lo.Find(data.resolutions.Resolutions, func(r *models.DomainResolutionResponseDomain) bool {
+		return swag.StringValue(r.DomainName) == domainName
+	})
+	if !found || len(resolution.IPV4Addresses)+len(resolution.IPV6Addresses) == 0 {
+		return newIPStackCheck(name, models.IPStackCheckStatusUnknown, fmt.Sprintf("%s was not resolved", domainName))
+	}
+	var addresses []string
+	if ipv6 {
+		addresses = lo.Map(resolution.IPV6Addresses, func(a strfmt.IPv6, _ int) string { return a.String() })
+	} else {
+		addresses = lo.Map(resolution.IPV4Addresses, func(a strfmt.IPv4, _ int) string { return a.String() })
+	}
+	if len(addresses) == 0 {
+		return newIPStackCheck(name, models.IPStackCheckStatusFailure,
+			fmt.Sprintf("%s has no %s records", domainName, lo.Ternary(ipv6, "AAAA", "A")))
+	}
+	return newIPStackCheck(name, models.IPStackCheckStatusSuccess,
+		fmt.Sprintf("%s resolves to %s", domainName, strings.Join(addresses, ", ")))
+}
+
+// imageRegistryHost returns the host of the registry of an image, without its port
+func imageRegistryHost(image string) string {
+	registry := strings.SplitN(image, "/", 2)[0]
+	if host, _, err := net.SplitHostPort(registry); err == nil {
+		return host
+	}
+	return registry
+}
+
+// checkIPStackRegistryReachability verifies that the release image registry is reachable over the address family,
+// from the pulls of its images reported by the agent.  The agent doesn't report the address used by a pull, so the
+// pulls are only attributed to the family when the registry has addresses of this family only, the check is unknown
+// otherwise.
+func checkIPStackRegistryReachability(data *ipStackHostData, registryHost string, ipv6 bool) *models.IPStackCheck {
+	name := models.IPStackCheckNameRegistryReachability
+	if registryHost == "" {
+		return newIPStackCheck(name, models.IPStackCheckStatusUnknown, "Domain name is not known")
+	}
+	var hasIPv4, hasIPv6 bool
+	if ip := net.ParseIP(registryHost); ip != nil {
+		hasIPv6 = isIPv6Address(registryHost)
+		hasIPv4 = !hasIPv6
+	} else {
+		if data.resolutions == nil {
+			return newIPStackCheck(name, models.IPStackCheckStatusUnknown, "Domain name resolutions were not reported yet")
+		}
+		resolution, found := lo.Find(data.resolutions.Resolutions, func(r *models.DomainResolutionResponseDomain) bool {
+			return swag.StringValue(r.DomainName) == registryHost
+		})
+		if !found {
+			return newIPStackCheck(name, models.IPStackCheckStatusUnknown, fmt.Sprintf("%s was not resolved", registryHost))
+		}
+		hasIPv4, hasIPv6 = len(resolution.IPV4Addresses) > 0, len(resolution.IPV6Addresses) > 0
+	}
+	if !lo.Ternary(ipv6, hasIPv6, hasIPv4) {
+		return newIPStackCheck(name, models.IPStackCheckStatusFailure, fmt.Sprintf("%s has no addresses of the address family", registryHost))
+	}
+	if hasIPv4 && hasIPv6 {
+		return newIPStackCheck(name, models.IPStackCheckStatusUnknown,
+			fmt.Sprintf("%s has addresses of both address families, the address family used to pull its images is not reported", registryHost))
+	}
+	var total, pulled int
+	for image, status := range data.images {
+		if status == nil || imageRegistryHost(image) != registryHost {
+			continue
+		}
+		total++
+		if status.Result == models.ContainerImageAvailabilityResultSuccess {
+			pulled++
+		}
+	}
+	if total == 0 {
+		return newIPStackCheck(name, models.IPStackCheckStatusUnknown, fmt.Sprintf("No pulls of images of %s were reported yet", registryHost))
+	}
+	if pulled == 0 {
+		return newIPStackCheck(name, models.IPStackCheckStatusFailure, fmt.Sprintf("None of the %d images of %s could be pulled", total, registryHost))
+	}
+	return newIPStackCheck(name, models.IPStackCheckStatusSuccess, fmt.Sprintf("%d of %d images of %s were pulled", pulled, total, registryHost))
+}
+
+// checkIPStackNtp verifies that at least one of the NTP sources that are addresses of the family is reachable.  Sources
+// that are host names cannot be attributed to an address family and are ignored.
+func checkIPStackNtp(data *ipStackHostData, ipv6 bool) *models.IPStackCheck {
+	if data.ntpSources == nil {
+		return newIPStackCheck(models.IPStackCheckNameNtp, models.IPStackCheckStatusUnknown, "NTP sources were not reported yet")
+	}
+	sources := lo.Filter(data.ntpSources, func(s *models.NtpSource, _ int) bool {
+		return net.ParseIP(s.SourceName) != nil && isIPv6Address(s.SourceName) == ipv6
+	})
+	if len(sources) == 0 {
+		return newIPStackCheck(models.IPStackCheckNameNtp, models.IPStackCheckStatusUnknown, "No NTP sources of the address family were found")
+	}
+	reachable := lo.Filter(sources, func(s *models.NtpSource, _ int) bool {
+		return s.SourceState == models.SourceStateSynced || s.SourceState == models.SourceStateCombined
+	})
+	if len(reachable) == 0 {
+		return newIPStackCheck(models.IPStackCheckNameNtp, models.IPStackCheckStatusFailure,
+			fmt.Sprintf("None of the %d NTP sources of the address family is reachable", len(sources)))
+	}
+	return newIPStackCheck(models.IPStackCheckNameNtp, models.IPStackCheckStatusSuccess,
+		fmt.Sprintf("%d of %d NTP sources of the address family are reachable", len(reachable), len(sources)))
+}
+
+func checkIPStackMtu(data *ipStackHostData, ipv6 bool) *models.IPStackCheck {
+	if data.connectivity == nil {
+		return newIPStackCheck(models.IPStackCheckNameMtu, models.IPStackCheckStatusUnknown, "Connectivity was not reported yet")
+	}
+	var total int
+	var failed []string
+	for _, remoteHost := range data.connectivity.RemoteHosts {
+		for _, report := range remoteHost.MtuReport {
+			if net.ParseIP(report.RemoteIPAddress) == nil || isIPv6Address(report.RemoteIPAddress) != ipv6 {
+				continue
+			}
+			total++
+			if !report.MtuSuccessful {
+				failed = append(failed, fmt.Sprintf("%s via %s", report.RemoteIPAddress, report.OutgoingNic))
+			}
+		}
+	}
+	if total == 0 {
+		return newIPStackCheck(models.IPStackCheckNameMtu, models.IPStackCheckStatusUnknown, "No MTU reports of the address family were found")
+	}
+	if len(failed) > 0 {
+		return newIPStackCheck(models.IPStackCheckNameMtu, models.IPStackCheckStatusFailure,
+			fmt.Sprintf("MTU check failed for %s", strings.Join(failed, ", ")))
+	}
+	return newIPStackCheck(models.IPStackCheckNameMtu, models.IPStackCheckStatusSuccess,
+		fmt.Sprintf("MTU check succeeded for %d addresses", total))
+}
+
+func buildAddressFamilyReadiness(cluster *common.Cluster, data *ipStackHostData, releaseImageHost string, ipv6 bool) *models.AddressFamilyReadiness {
+	addressesCheck, addresses := checkIPStackAddresses(data, ipv6)
+	var apiDomain, appsDomain string
+	if cluster.Name != "" && cluster.BaseDNSDomain != "" {
+		apiDomain = fmt.Sprintf("api.%s.%s", cluster.Name, cluster.BaseDNSDomain)
+		appsDomain = fmt.Sprintf("%s.apps.%s.%s", constants.AppsSubDomainNameHostDNSValidation, cluster.Name, cluster.BaseDNSDomain)
+	}
+	checks := []*models.IPStackCheck{
+		addressesCheck,
+		checkIPStackDefaultRoute(data, ipv6),
+		checkIPStackResolution(data, models.IPStackCheckNameAPIDNS, apiDomain, ipv6),
+		checkIPStackResolution(data, models.IPStackCheckNameAppsDNS, appsDomain, ipv6),
+		checkIPStackNtp(data, ipv6),
+		checkIPStackResolution(data, models.IPStackCheckNameRegistryDNS, releaseImageHost, ipv6),
+		checkIPStackRegistryReachability(data, releaseImageHost, ipv6),
+		checkIPStackMtu(data, ipv6),
+	}
+	return &models.AddressFamilyReadiness{
+		AddressFamily: swag.String(lo.Ternary(ipv6, models.AddressFamilyReadinessAddressFamilyIPV6, models.AddressFamilyReadinessAddressFamilyIPV4)),
+		Addresses:     lo.Ternary(addresses == nil, []string{}, addresses),
+		Checks:        checks,
+		Ready: swag.Bool(!lo.ContainsBy(checks, func(c *models.IPStackCheck) bool {
+			return swag.StringValue(c.Status) == models.IPStackCheckStatusFailure
+		})),
+	}
+}
+
+// BuildIPStackReadiness evaluates every IP stack of every host of the cluster and tells whether the cluster can use
+// IPv6 as the primary stack of a dual-stack cluster, which needs both address families and a recent enough version,
+// and whether it can be installed as a single-stack IPv6 cluster, which only needs the IPv6 address family.  Checks
+// whose data was not reported by the agent yet are reported as unknown and do not block readiness; a cluster without
+// hosts with an inventory is never ready.
+func BuildIPStackReadiness(cluster *common.Cluster, releaseImageHost string, log logrus.FieldLogger) *models.IPStackReadiness {
+	ret := &models.IPStackReadiness{
+		ClusterID:           *cluster.ID,
+		Hosts:               []*models.HostIPStackReadiness{},
+		IPV6PrimaryBlockers: []string{},
+		IPV6OnlyBlockers:    []string{},
+	}
+	if cluster.PrimaryIPStack != nil {
+		ret.PrimaryIPStack = string(*cluster.PrimaryIPStack)
+	}
+	if !supportsIPv6PrimaryDualStack(cluster.OpenshiftVersion) {
+		ret.IPV6PrimaryBlockers = append(ret.IPV6PrimaryBlockers,
+			fmt.Sprintf("OpenShift version %s does not support IPv6 as the primary IP stack, the minimal version is %s",
+				cluster.OpenshiftVersion, common.MinimalVersionForIPV6PrimaryWithDualStack))
+	}
+	hostsWithInventory := 0
+	for _, host := range cluster.Hosts {
+		data := loadIPStackHostData(host, log)
+		if data.inventory != nil {
+			hostsWithInventory++
+		}
+		hostReadiness := &models.HostIPStackReadiness{
+			HostID:   *host.ID,
+			Hostname: topologyHostLabel(host, data.inventory),
+			AddressFamilies: []*models.AddressFamilyReadiness{
+				buildAddressFamilyReadiness(cluster, data, releaseImageHost, false),
+				buildAddressFamilyReadiness(cluster, data, releaseImageHost, true),
+			},
+		}
+		for _, family := range hostReadiness.AddressFamilies {
+			for _, check := range family.Checks {
+				if swag.StringValue(check.Status) != models.IPStackCheckStatusFailure {
+					continue
+				}
+				blocker := fmt.Sprintf("Host %s: %s %s check failed: %s",
+					hostReadiness.Hostname, swag.StringValue(family.AddressFamily), swag.StringValue(check.Name), check.Message)
+				ret.IPV6PrimaryBlockers = append(ret.IPV6PrimaryBlockers, blocker)
+				if swag.StringValue(family.AddressFamily) == models.AddressFamilyReadinessAddressFamilyIPV6 {
+					ret.IPV6OnlyBlockers = append(ret.IPV6OnlyBlockers, blocker)
+				}
+			}
+		}
+		ret.Hosts = append(ret.Hosts, hostReadiness)
+	}
+	if hostsWithInventory == 0 {
+		ret.IPV6PrimaryBlockers = append(ret.IPV6PrimaryBlockers, "No hosts have reported their inventory yet")
+		ret.IPV6OnlyBlockers = append(ret.IPV6OnlyBlockers, "No hosts have reported their inventory yet")
+	}
+	ret.IPV6PrimaryReady = swag.Bool(len(ret.IPV6PrimaryBlockers) == 0)
+	ret.IPV6OnlyReady = swag.Bool(len(ret.IPV6OnlyBlockers) == 0)
+	return ret
+}
@@ -0,0 +1,286 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+var _ = Describe("IP stack readiness", func() {
+	const releaseImageHost = "quay.io"
+
+	var (
+		log     logrus.FieldLogger
+		cluster *common.Cluster
+		host    *models.Host
+	)
+
+	marshal := func(obj interface{}) string {
+		b, err := json.Marshal(obj)
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	resolution := func(name string, ipv4 []strfmt.IPv4, ipv6 []strfmt.IPv6) *models.DomainResolutionResponseDomain {
+		return &models.DomainResolutionResponseDomain{
+			DomainName:    swag.String(name),
+			IPV4Addresses: ipv4,
+			IPV6Addresses: ipv6,
+		}
+	}
+
+	findFamily := func(readiness *models.IPStackReadiness, family string) *models.AddressFamilyReadiness {
+		Expect(readiness.Hosts).To(HaveLen(1))
+		for _, f := range readiness.Hosts[0].AddressFamilies {
+			if swag.StringValue(f.AddressFamily) == family {
+				return f
+			}
+		}
+		Fail("address family " + family + " was not reported")
+		return nil
+	}
+
+	checkStatus := func(family *models.AddressFamilyReadiness, name string) string {
+		for _, c := range family.Checks {
+			if swag.StringValue(c.Name) == name {
+				return swag.StringValue(c.Status)
+			}
+		}
+		Fail("check " + name + " was not reported")
+		return ""
+	}
+
+	BeforeEach(func() {
+		log = logrus.New()
+		clusterID := strfmt.UUID(uuid.New().String())
+		hostID := strfmt.UUID(uuid.New().String())
+		host = &models.Host{
+			ID:        &hostID,
+			ClusterID: &clusterID,
+			Inventory: marshal(&models.Inventory{
+				Hostname: "master-0",
+				Interfaces: []*models.Interface{
+					{
+						Name:          "eth0",
+						IPV4Addresses: []string{"192.168.127.10/24"},
+						IPV6Addresses: []string{"1001:db8::10/120"},
+					},
+				},
+				Routes: []*models.Route{
+					{Interface: "eth0", Gateway: "192.168.127.1", Destination: "0.0.0.0", Family: unix.AF_INET},
+					{Interface: "eth0", Gateway: "1001:db8::1", Destination: "::", Family: unix.AF_INET6},
+				},
+			}),
+			DomainNameResolutions: marshal(&models.DomainResolutionResponse{
+				Resolutions: []*models.DomainResolutionResponseDomain{
+					resolution("api.test-cluster.example.com", []strfmt.IPv4{"192.168.127.100"}, []strfmt.IPv6{"1001:db8::100"}),
+					resolution("console-openshift-console.apps.test-cluster.example.com", []strfmt.IPv4{"192.168.127.101"}, []strfmt.IPv6{"1001:db8::101"}),
+					resolution(releaseImageHost, []strfmt.IPv4{"10.0.0.1"}, []strfmt.IPv6{"2620:52::1"}),
+				},
+			}),
+			NtpSources: marshal([]*models.NtpSource{
+				{SourceName: "192.168.127.1", SourceState: models.SourceStateSynced},
+				{SourceName: "1001:db8::1", SourceState: models.SourceStateCombined},
+				{SourceName: "clock.example.com", SourceState: models.SourceStateUnreachable},
+			}),
+			Connectivity: marshal(&models.ConnectivityReport{
+				RemoteHosts: []*models.ConnectivityRemoteHost{
+					{
+						MtuReport: []*models.MtuReport{
+							{RemoteIPAddress: "192.168.127.11", OutgoingNic: "eth0", MtuSuccessful: true},
+							{RemoteIPAddress: "1001:db8::11", OutgoingNic: "eth0", MtuSuccessful: true},
+						},
+					},
+				},
+			}),
+		}
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Name:             "test-cluster",
+			BaseDNSDomain:    "example.com",
+			OpenshiftVersion: "4.18",
+			Hosts:            []*models.Host{host},
+		}}
+	})
+
+	It("reports a fully prepared dual-stack host as ready", func() {
+		readiness := BuildIPStackReadiness(cluster, releaseImageHost, log)
+		Expect(readiness.ClusterID).To(Equal(*cluster.ID))
+		Expect(swag.BoolValue(readiness.IPV6PrimaryReady)).To(BeTrue())
+		Expect(readiness.IPV6PrimaryBlockers).To(BeEmpty())
+		Expect(swag.BoolValue(readiness.IPV6OnlyReady)).To(BeTrue())
+		Expect(readiness.IPV6OnlyBlockers).To(BeEmpty())
+		Expect(readiness.Hosts[0].Hostname).To(Equal("master-0"))
+		for _, family := range readiness.Hosts[0].AddressFamilies {
+			Expect(swag.BoolValue(family.Ready)).To(BeTrue())
+			for _, check := range family.Checks {
+				if swag.StringValue(check.Name) == models.IPStackCheckNameRegistryReachability {
+					// The registry has addresses of both families, the pulls can't be attributed to one of them
+					Expect(swag.StringValue(check.Status)).To(Equal(models.IPStackCheckStatusUnknown))
+					continue
+				}
+				Expect(swag.StringValue(check.Status)).To(Equal(models.IPStackCheckStatusSuccess), swag.StringValue(check.Name))
+			}
+		}
+		Expect(findFamily(readiness, models.AddressFamilyReadinessAddressFamilyIPV6).Addresses).To(ConsistOf("1001:db8::10/120"))
+	})
+
+	It("reports the missing IPv6 default route and AAAA records as blockers", func() {
+		host.Inventory = marshal(&models.Inventory{
+			Interfaces: []*models.Interface{
+				{
+					Name:          "eth0",
+					IPV4Addresses: []string{"192.168.127.10/24"},
+					IPV6Addresses: []string{"1001:db8::10/120"},
+				},
+			},
+			Routes: []*models.Route{
+				{Interface: "eth0", Gateway: "192.168.127.1", Destination: "0.0.0.0", Family: unix.AF_INET},
+			},
+		})
+		host.DomainNameResolutions = marshal(&models.DomainResolutionResponse{
+			Resolutions: []*models.DomainResolutionResponseDomain{
+				resolution("api.test-cluster.example.com", []strfmt.IPv4{"192.168.127.100"}, nil),
+			},
+		})
+		readiness := BuildIPStackReadiness(cluster, releaseImageHost, log)
+		Expect(swag.BoolValue(readiness.IPV6PrimaryReady)).To(BeFalse())
+		Expect(readiness.IPV6PrimaryBlockers).To(HaveLen(2))
+		ipv4 := findFamily(readiness, models.AddressFamilyReadinessAddressFamilyIPV4)
+		Expect(swag.BoolValue(ipv4.Ready)).To(BeTrue())
+		ipv6 := findFamily(readiness, models.AddressFamilyReadinessAddressFamilyIPV6)
+		Expect(swag.BoolValue(ipv6.Ready)).To(BeFalse())
+		Expect(checkStatus(ipv6, models.IPStackCheckNameDefaultRoute)).To(Equal(models.IPStackCheckStatusFailure))
+		Expect(checkStatus(ipv6, models.IPStackCheckNameAPIDNS)).To(Equal(models.IPStackCheckStatusFailure))
+		Expect(checkStatus(ipv6, models.IPStackCheckNameAppsDNS)).To(Equal(models.IPStackCheckStatusUnknown))
+		Expect(checkStatus(ipv6, models.IPStackCheckNameRegistryDNS)).To(Equal(models.IPStackCheckStatusUnknown))
+	})
+
+	It("evaluates NTP and MTU per address family", func() {
+		host.NtpSources = marshal([]*models.NtpSource{
+			{SourceName: "192.168.127.1", SourceState: models.SourceStateSynced},
+			{SourceName: "1001:db8::1", SourceState: models.SourceStateUnreachable},
+		})
+		host.Connectivity = marshal(&models.ConnectivityReport{
+			RemoteHosts: []*models.ConnectivityRemoteHost{
+				{
+					MtuReport: []*models.MtuReport{
+						{RemoteIPAddress: "1001:db8::11", OutgoingNic: "eth0", MtuSuccessful: false},
+					},
+				},
+			},
+		})
+		readiness := BuildIPStackReadiness(cluster, releaseImageHost, log)
+		ipv4 := findFamily(readiness, models.AddressFamilyReadinessAddressFamilyIPV4)
+		Expect(checkStatus(ipv4, models.IPStackCheckNameNtp)).To(Equal(models.IPStackCheckStatusSuccess))
+		Expect(checkStatus(ipv4, models.IPStackCheckNameMtu)).To(Equal(models.IPStackCheckStatusUnknown))
+		ipv6 := findFamily(readiness, models.AddressFamilyReadinessAddressFamilyIPV6)
+		Expect(checkStatus(ipv6, models.IPStackCheckNameNtp)).To(Equal(models.IPStackCheckStatusFailure))
+		Expect(checkStatus(ipv6, models.IPStackCheckNameMtu)).To(Equal(models.IPStackCheckStatusFailure))
+		Expect(swag.BoolValue(readiness.IPV6PrimaryReady)).To(BeFalse())
+	})
+
+	It("is not ready for versions that do not support IPv6 as the primary stack", func() {
+		cluster.OpenshiftVersion = "4.11"
+		readiness := BuildIPStackReadiness(cluster, releaseImageHost, log)
+		Expect(swag.BoolValue(readiness.IPV6PrimaryReady)).To(BeFalse())
+		Expect(readiness.IPV6PrimaryBlockers).To(ConsistOf(ContainSubstring("4.11")))
+		Expect(swag.BoolValue(readiness.IPV6OnlyReady)).To(BeTrue())
+	})
+
+	Context("registry reachability", func() {
+		setRegistryResolution := func(ipv4 []strfmt.IPv4, ipv6 []strfmt.IPv6) {
+			host.DomainNameResolutions = marshal(&models.DomainResolutionResponse{
+				Resolutions: []*models.DomainResolutionResponseDomain{
+					resolution("api.test-cluster.example.com", []strfmt.IPv4{"192.168.127.100"}, []strfmt.IPv6{"1001:db8::100"}),
+					resolution(releaseImageHost, ipv4, ipv6),
+				},
+			})
+		}
+
+		setImageResult := func(result models.ContainerImageAvailabilityResult) {
+			host.ImagesStatus = marshal(common.ImageStatuses{
+				"quay.io/openshift-release-dev/ocp-release:4.18.0-x86_64": {Name: "quay.io/openshift-release-dev/ocp-release:4.18.0-x86_64", Result: result},
+				"registry.example.com:5000/other:latest":                  {Name: "registry.example.com:5000/other:latest", Result: models.ContainerImageAvailabilityResultFailure},
+			})
+		}
+
+		It("attributes the pulls to the only address family of the registry", func() {
+			setRegistryResolution(nil, []strfmt.IPv6{"2620:52::1"})
+			setImageResult(models.ContainerImageAvailabilityResultSuccess)
+			readiness := BuildIPStackReadiness(cluster, releaseImageHost, log)
+			ipv6 := findFamily(readiness, models.AddressFamilyReadinessAddressFamilyIPV6)
+			Expect(checkStatus(ipv6, models.IPStackCheckNameRegistryReachability)).To(Equal(models.IPStackCheckStatusSuccess))
+			ipv4 := findFamily(readiness, models.AddressFamilyReadinessAddressFamilyIPV4)
+			Expect(checkStatus(ipv4, models.IPStackCheckNameRegistryReachability)).To(Equal(models.IPStackCheckStatusFailure))
+		})
+
+		It("fails when none of the images of the registry could be pulled", func() {
+			setRegistryResolution(nil, []strfmt.IPv6{"2620:52::1"})
+			setImageResult(models.ContainerImageAvailabilityResultFailure)
+			readiness := BuildIPStackReadiness(cluster, releaseImageHost, log)
+			ipv6 := findFamily(readiness, models.AddressFamilyReadinessAddressFamilyIPV6)
+			Expect(checkStatus(ipv6, models.IPStackCheckNameRegistryReachability)).To(Equal(models.IPStackCheckStatusFailure))
+			Expect(swag.BoolValue(readiness.IPV6OnlyReady)).To(BeFalse())
+		})
+
+		It("is unknown when the registry has addresses of both families", func() {
+			setImageResult(models.ContainerImageAvailabilityResultSuccess)
+			readiness := BuildIPStackReadiness(cluster, releaseImageHost, log)
+			for _, family := range readiness.Hosts[0].AddressFamilies {
+				Expect(checkStatus(family, models.IPStackCheckNameRegistryReachability)).To(Equal(models.IPStackCheckStatusUnknown))
+			}
+		})
+
+		It("is unknown when no pulls were reported", func() {
+			setRegistryResolution(nil, []strfmt.IPv6{"2620:52::1"})
+			readiness := BuildIPStackReadiness(cluster, releaseImageHost, log)
+			ipv6 := findFamily(readiness, models.AddressFamilyReadinessAddressFamilyIPV6)
+			Expect(checkStatus(ipv6, models.IPStackCheckNameRegistryReachability)).To(Equal(models.IPStackCheckStatusUnknown))
+		})
+	})
+
+	It("evaluates the IPv6-only readiness without the IPv4 checks", func() {
+		host.Inventory = marshal(&models.Inventory{
+			Hostname: "master-0",
+			Interfaces: []*models.Interface{
+				{
+					Name:          "eth0",
+					IPV6Addresses: []string{"1001:db8::10/120"},
+				},
+			},
+			Routes: []*models.Route{
+				{Interface: "eth0", Gateway: "1001:db8::1", Destination: "::", Family: unix.AF_INET6},
+			},
+		})
+		readiness := BuildIPStackReadiness(cluster, releaseImageHost, log)
+		Expect(swag.BoolValue(readiness.IPV6OnlyReady)).To(BeTrue())
+		Expect(readiness.IPV6OnlyBlockers).To(BeEmpty())
+		Expect(swag.BoolValue(readiness.IPV6PrimaryReady)).To(BeFalse())
+		Expect(readiness.IPV6PrimaryBlockers).To(ContainElement(ContainSubstring("ipv4 addresses check failed")))
+	})
+
+	It("reports unknown checks for hosts that did not report their data yet", func() {
+		host.Inventory = ""
+		host.DomainNameResolutions = ""
+		host.NtpSources = ""
+		host.Connectivity = ""
+		readiness := BuildIPStackReadiness(cluster, "", log)
+		Expect(swag.BoolValue(readiness.IPV6PrimaryReady)).To(BeFalse())
+		Expect(readiness.IPV6PrimaryBlockers).To(ConsistOf(ContainSubstring("inventory")))
+		for _, family := range readiness.Hosts[0].AddressFamilies {
+			Expect(swag.BoolValue(family.Ready)).To(BeTrue())
+			for _, check := range family.Checks {
+				Expect(swag.StringValue(check.Status)).To(Equal(models.IPStackCheckStatusUnknown), swag.StringValue(check.Name))
+			}
+		}
+	})
+})
@@ -26,6 +26,17 @@ func FormatLease(lease string) string {
 	return c.ReplaceAllString(lease, "${1}${2} never;")
 }
 
+var leaseFixedAddressRegex = regexp.MustCompile(`fixed-address\s+([^;\s]+)\s*;`)
+
+// LeaseFixedAddress returns the address that a DHCP lease was given for, or an empty string if the lease has no address
+func LeaseFixedAddress(lease string) string {
+	matches := leaseFixedAddressRegex.FindStringSubmatch(lease)
+	if len(matches) != 2 {
+		return ""
+	}
+	return matches[1]
+}
+
 func getEncoded(input string) string {
 	if input == "" {
 		return ""
@@ -84,6 +84,11 @@ var _ = Describe("dhcp param file", func() {
 			Expect(VerifyLease("l" + apiLease)).To(HaveOccurred())
 		})
 	})
+	It("LeaseFixedAddress", func() {
+		Expect(LeaseFixedAddress(apiLease)).To(Equal("10.0.0.16"))
+		Expect(LeaseFixedAddress(ingressLease)).To(Equal("10.0.0.17"))
+		Expect(LeaseFixedAddress("")).To(BeEmpty())
+	})
 	It("Encoded", func() {
 		cluster := &common.Cluster{
 			ApiVipLease:     apiLease,
@@ -0,0 +1,439 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// topologyBuilder accumulates the nodes and edges of a network topology graph while making sure
+// that every node is added only once
+type topologyBuilder struct {
+	nodes     map[string]*models.NetworkTopologyNode
+	edges     []*models.NetworkTopologyEdge
+	edgeIndex map[string]bool
+}
+
+func newTopologyBuilder() *topologyBuilder {
+	return &topologyBuilder{
+		nodes:     make(map[string]*models.NetworkTopologyNode),
+		edgeIndex: make(map[string]bool),
+	}
+}
+
+func hostNodeID(hostID strfmt.UUID) string {
+	return "host:" + hostID.String()
+}
+
+func interfaceNodeID(hostID strfmt.UUID, name string) string {
+	return fmt.Sprintf("interface:%s/%s", hostID.String(), name)
+}
+
+func subnetNodeID(cidr string) string {
+	return "subnet:" + cidr
+}
+
+func vipNodeID(ip string) string {
+	return "vip:" + ip
+}
+
+func gatewayNodeID(ip string) string {
+	return "gateway:" + ip
+}
+
+func (t *topologyBuilder) addNode(id, kind, label string, hostID *strfmt.UUID, attributes map[string]string) {
+	if _, ok := t.nodes[id]; ok {
+		return
+	}
+	node := &models.NetworkTopologyNode{
+		ID:    swag.String(id),
+		Kind:  swag.String(kind),
+		Label: label,
+	}
+	if hostID != nil {
+		node.HostID = *hostID
+	}
+	if len(attributes) > 0 {
+		node.Attributes = attributes
+	}
+	t.nodes[id] = node
+}
+
+func (t *topologyBuilder) addEdge(source, target, kind string, successful *bool, attributes map[string]string) {
+	key := strings.Join([]string{source, target, kind, attributes["address"], attributes["outgoing_nic"], attributes["remote_ip_address"]}, "|")
+	if t.edgeIndex[key] {
+		return
+	}
+	t.edgeIndex[key] = true
+	edge := &models.NetworkTopologyEdge{
+		Source: swag.String(source),
+		Target: swag.String(target),
+		Kind:   swag.String(kind),
+	}
+	if successful != nil {
+		edge.Successful = *successful
+	}
+	if len(attributes) > 0 {
+		edge.Attributes = attributes
+	}
+	t.edges = append(t.edges, edge)
+}
+
+func (t *topologyBuilder) build() *models.NetworkTopology {
+	ret := &models.NetworkTopology{
+		Nodes: make([]*models.NetworkTopologyNode, 0, len(t.nodes)),
+		Edges: t.edges,
+	}
+	for _, node := range t.nodes {
+		ret.Nodes = append(ret.Nodes, node)
+	}
+	sort.Slice(ret.Nodes, func(i, j int) bool {
+		return swag.StringValue(ret.Nodes[i].ID) < swag.StringValue(ret.Nodes[j].ID)
+	})
+	if ret.Edges == nil {
+		ret.Edges = []*models.NetworkTopologyEdge{}
+	}
+	return ret
+}
+
+func topologyHostLabel(host *models.Host, inventory *models.Inventory) string {
+	if host.RequestedHostname != "" {
+		return host.RequestedHostname
+	}
+	if inventory != nil && inventory.Hostname != "" {
+		return inventory.Hostname
+	}
+	return host.ID.String()
+}
+
+func (t *topologyBuilder) addHost(host *models.Host) (*models.Inventory, error) {
+	var inventory *models.Inventory
+	if host.Inventory != "" {
+		var err error
+		if inventory, err = common.UnmarshalInventory(host.Inventory); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse inventory of host %s", host.ID.String())
+		}
+	}
+	attributes := map[string]string{}
+	if host.Role != "" {
+		attributes["role"] = string(host.Role)
+	}
+	if host.Status != nil {
+		attributes["status"] = swag.StringValue(host.Status)
+	}
+	t.addNode(hostNodeID(*host.ID), models.NetworkTopologyNodeKindHost, topologyHostLabel(host, inventory), host.ID, attributes)
+	if inventory == nil {
+		return nil, nil
+	}
+
+	for _, intf := range inventory.Interfaces {
+		intfID := interfaceNodeID(*host.ID, intf.Name)
+		intfAttributes := map[string]string{}
+		if intf.MacAddress != "" {
+			intfAttributes["mac_address"] = intf.MacAddress
+		}
+		if intf.Mtu > 0 {
+			intfAttributes["mtu"] = strconv.FormatInt(intf.Mtu, 10)
+		}
+		if intf.SpeedMbps > 0 {
+			intfAttributes["speed_mbps"] = strconv.FormatInt(intf.SpeedMbps, 10)
+		}
+		if intf.Type != "" {
+			intfAttributes["type"] = intf.Type
+		}
+		intfAttributes["has_carrier"] = strconv.FormatBool(intf.HasCarrier)
+		t.addNode(intfID, models.NetworkTopologyNodeKindInterface, intf.Name, host.ID, intfAttributes)
+		t.addEdge(hostNodeID(*host.ID), intfID, models.NetworkTopologyEdgeKindInterface, nil, nil)
+
+		for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+			_, ipnet, err := net.ParseCIDR(address)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse address %s of interface %s on host %s", address, intf.Name, host.ID.String())
+			}
+			cidr := ipnet.String()
+			t.addNode(subnetNodeID(cidr), models.NetworkTopologyNodeKindSubnet, cidr, nil, nil)
+			t.addEdge(intfID, subnetNodeID(cidr), models.NetworkTopologyEdgeKindAddress, nil, map[string]string{"address": address})
+		}
+	}
+
+	for _, ipv6 := range []bool{false, true} {
+		route := GetDefaultRouteByFamily(inventory.Routes, ipv6)
+		if route == nil || route.Interface == "" {
+			continue
+		}
+		// The default route can go through an interface that isn't in the inventory, e.g. a bridge created by the agent
+		if _, ok := t.nodes[interfaceNodeID(*host.ID, route.Interface)]; !ok {
+			continue
+		}
+		t.addNode(gatewayNodeID(route.Gateway), models.NetworkTopologyNodeKindGateway, route.Gateway, nil, nil)
+		t.addEdge(interfaceNodeID(*host.ID, route.Interface), gatewayNodeID(route.Gateway), models.NetworkTopologyEdgeKindDefaultRoute,
+			nil, map[string]string{"metric": strconv.FormatInt(int64(route.Metric), 10)})
+	}
+	return inventory, nil
+}
+
+func (t *topologyBuilder) addVip(vip, vipType string, subnets []*net.IPNet) {
+	if vip == "" {
+		return
+	}
+	t.addNode(vipNodeID(vip), models.NetworkTopologyNodeKindVip, vip, nil, map[string]string{"type": vipType})
+	ip := net.ParseIP(vip)
+	if ip == nil {
+		return
+	}
+	for _, subnet := range subnets {
+		if subnet.Contains(ip) {
+			t.addEdge(vipNodeID(vip), subnetNodeID(subnet.String()), models.NetworkTopologyEdgeKindVip, nil, nil)
+		}
+	}
+}
+
+// findInterfaceNodeByIP returns the interface node of the given host that owns the given IP address
+func findInterfaceNodeByIP(hostID strfmt.UUID, inventory *models.Inventory, ip string) string {
+	if inventory == nil {
+		return ""
+	}
+	intf, err := FindInterfaceByIPString(ip, inventory.Interfaces)
+	if err != nil || intf == nil {
+		return ""
+	}
+	return interfaceNodeID(hostID, intf.Name)
+}
+
+// outgoingNodeID returns the node of the interface a connectivity check went out of, or the node of the host when the
+// interface is unknown or isn't in the inventory
+func (t *topologyBuilder) outgoingNodeID(hostID strfmt.UUID, nic string) string {
+	if nic != "" {
+		if _, ok := t.nodes[interfaceNodeID(hostID, nic)]; ok {
+			return interfaceNodeID(hostID, nic)
+		}
+	}
+	return hostNodeID(hostID)
+}
+
+func (t *topologyBuilder) addConnectivity(host *models.Host, inventories map[strfmt.UUID]*models.Inventory) error {
+	if host.Connectivity == "" {
+		return nil
+	}
+	var report models.ConnectivityReport
+	if err := json.Unmarshal([]byte(host.Connectivity), &report); err != nil {
+		return errors.Wrapf(err, "failed to parse connectivity report of host %s", host.ID.String())
+	}
+	for _, remote := range report.RemoteHosts {
+		if remote.HostID == *host.ID {
+			continue
+		}
+		if _, ok := inventories[remote.HostID]; !ok {
+			// The remote host is not part of the cluster anymore
+			continue
+		}
+		for _, l2 := range remote.L2Connectivity {
+			source := t.outgoingNodeID(*host.ID, l2.OutgoingNic)
+			target := findInterfaceNodeByIP(remote.HostID, inventories[remote.HostID], l2.RemoteIPAddress)
+			if target == "" {
+				target = hostNodeID(remote.HostID)
+			}
+			t.addEdge(source, target, models.NetworkTopologyEdgeKindL2Connectivity, swag.Bool(l2.Successful), map[string]string{
+				"outgoing_nic":      l2.OutgoingNic,
+				"remote_ip_address": l2.RemoteIPAddress,
+				"remote_mac":        l2.RemoteMac,
+			})
+		}
+		for _, l3 := range remote.L3Connectivity {
+			source := t.outgoingNodeID(*host.ID, l3.OutgoingNic)
+			target := findInterfaceNodeByIP(remote.HostID, inventories[remote.HostID], l3.RemoteIPAddress)
+			if target == "" {
+				target = hostNodeID(remote.HostID)
+			}
+			attributes := map[string]string{
+				"outgoing_nic":      l3.OutgoingNic,
+				"remote_ip_address": l3.RemoteIPAddress,
+			}
+			if l3.Successful {
+				attributes["average_rtt_ms"] = strconv.FormatFloat(l3.AverageRTTMs, 'f', -1, 64)
+				attributes["packet_loss_percentage"] = strconv.FormatFloat(l3.PacketLossPercentage, 'f', -1, 64)
+			}
+			t.addEdge(source, target, models.NetworkTopologyEdgeKindL3Connectivity, swag.Bool(l3.Successful), attributes)
+		}
+	}
+	return nil
+}
+
+// BuildNetworkTopology creates a graph of the hosts, interfaces, subnets, default gateways and VIPs of a cluster together with
+// the L2 and L3 reachability reported by the connectivity checks of the hosts
+func BuildNetworkTopology(cluster *common.Cluster) (*models.NetworkTopology, error) {
+	t := newTopologyBuilder()
+	hosts := make([]*models.Host, 0, len(cluster.Hosts))
+	for _, h := range cluster.Hosts {
+		if h != nil && h.ID != nil {
+			hosts = append(hosts, h)
+		}
+	}
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].ID.String() < hosts[j].ID.String() })
+
+	inventories := make(map[strfmt.UUID]*models.Inventory)
+	for _, h := range hosts {
+		inventory, err := t.addHost(h)
+		if err != nil {
+			return nil, err
+		}
+		inventories[*h.ID] = inventory
+	}
+
+	var subnets []*net.IPNet
+	for id, node := range t.nodes {
+		if swag.StringValue(node.Kind) != models.NetworkTopologyNodeKindSubnet {
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(strings.TrimPrefix(id, "subnet:"))
+		if err == nil {
+			subnets = append(subnets, ipnet)
+		}
+	}
+	sort.Slice(subnets, func(i, j int) bool { return subnets[i].String() < subnets[j].String() })
+	for _, vip := range GetApiVips(cluster) {
+		t.addVip(vip, "api", subnets)
+	}
+	for _, vip := range GetIngressVips(cluster) {
+		t.addVip(vip, "ingress", subnets)
+	}
+
+	for _, h := range hosts {
+		if err := t.addConnectivity(h, inventories); err != nil {
+			return nil, err
+		}
+	}
+
+	topology := t.build()
+	topology.Dot = RenderNetworkTopologyDOT(topology)
+	return topology, nil
+}
+
+// dotEscaper escapes the characters that can't appear as is in a double-quoted DOT string
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
+
+// connectivityEdgeKey identifies the L2 or L3 connectivity between two nodes regardless of the host that reported it
+func connectivityEdgeKey(edge *models.NetworkTopologyEdge) string {
+	endpoints := []string{swag.StringValue(edge.Source), swag.StringValue(edge.Target)}
+	sort.Strings(endpoints)
+	return strings.Join(append(endpoints, swag.StringValue(edge.Kind)), "|")
+}
+
+var dotNodeShapes = map[string]string{
+	models.NetworkTopologyNodeKindHost:      "box3d",
+	models.NetworkTopologyNodeKindInterface: "ellipse",
+	models.NetworkTopologyNodeKindSubnet:    "box",
+	models.NetworkTopologyNodeKindVip:       "diamond",
+	models.NetworkTopologyNodeKindGateway:   "house",
+}
+
+// RenderNetworkTopologyDOT renders a network topology graph in the Graphviz DOT language. Interfaces are grouped with their
+// host in a cluster sub-graph, and failed connectivity checks are drawn as dashed red edges. The graph is undirected, so
+// the connectivity checks between the same nodes in both directions are drawn as a single edge, which is failed when
+// any of the checks failed.
+func RenderNetworkTopologyDOT(topology *models.NetworkTopology) string {
+	var b strings.Builder
+	b.WriteString("graph network_topology {\n")
+	b.WriteString("  node [fontname=\"Helvetica\"];\n")
+
+	hostChildren := make(map[string][]*models.NetworkTopologyNode)
+	for _, node := range topology.Nodes {
+		if swag.StringValue(node.Kind) == models.NetworkTopologyNodeKindInterface {
+			hostID := hostNodeID(node.HostID)
+			hostChildren[hostID] = append(hostChildren[hostID], node)
+		}
+	}
+
+	writeNode := func(indent string, node *models.NetworkTopologyNode) {
+		label := dotQuote(node.Label)
+		if mac, ok := node.Attributes["mac_address"]; ok {
+			// \n is the DOT escape for a centered line break
+			label = fmt.Sprintf(`"%s\n%s"`, dotEscaper.Replace(node.Label), dotEscaper.Replace(mac))
+		}
+		fmt.Fprintf(&b, "%s%s [label=%s, shape=%s];\n", indent, dotQuote(swag.StringValue(node.ID)),
+			label, dotNodeShapes[swag.StringValue(node.Kind)])
+	}
+
+	clusterIndex := 0
+	for _, node := range topology.Nodes {
+		switch swag.StringValue(node.Kind) {
+		case models.NetworkTopologyNodeKindInterface:
+			continue
+		case models.NetworkTopologyNodeKindHost:
+			fmt.Fprintf(&b, "  subgraph cluster_%d {\n", clusterIndex)
+			fmt.Fprintf(&b, "    label=%s;\n", dotQuote(node.Label))
+			clusterIndex++
+			writeNode("    ", node)
+			for _, child := range hostChildren[swag.StringValue(node.ID)] {
+				writeNode("    ", child)
+			}
+			b.WriteString("  }\n")
+		default:
+			writeNode("  ", node)
+		}
+	}
+
+	// Only the edges between nodes that were drawn are rendered, and a failed check in any direction marks a connectivity
+	// edge as failed
+	nodeIDs := make(map[string]bool, len(topology.Nodes))
+	for _, node := range topology.Nodes {
+		nodeIDs[swag.StringValue(node.ID)] = true
+	}
+	connectivitySuccessful := make(map[string]bool)
+	for _, edge := range topology.Edges {
+		switch swag.StringValue(edge.Kind) {
+		case models.NetworkTopologyEdgeKindL2Connectivity, models.NetworkTopologyEdgeKindL3Connectivity:
+			key := connectivityEdgeKey(edge)
+			successful, ok := connectivitySuccessful[key]
+			connectivitySuccessful[key] = edge.Successful && (successful || !ok)
+		}
+	}
+
+	renderedConnectivity := make(map[string]bool)
+	for _, edge := range topology.Edges {
+		if !nodeIDs[swag.StringValue(edge.Source)] || !nodeIDs[swag.StringValue(edge.Target)] {
+			continue
+		}
+		var attrs []string
+		switch swag.StringValue(edge.Kind) {
+		case models.NetworkTopologyEdgeKindAddress:
+			attrs = append(attrs, "label="+dotQuote(edge.Attributes["address"]))
+		case models.NetworkTopologyEdgeKindDefaultRoute:
+			attrs = append(attrs, "label=\"default\"", "style=bold")
+		case models.NetworkTopologyEdgeKindVip:
+			attrs = append(attrs, "style=dotted")
+		case models.NetworkTopologyEdgeKindL2Connectivity, models.NetworkTopologyEdgeKindL3Connectivity:
+			key := connectivityEdgeKey(edge)
+			if renderedConnectivity[key] {
+				continue
+			}
+			renderedConnectivity[key] = true
+			attrs = append(attrs, "label="+dotQuote(strings.TrimSuffix(swag.StringValue(edge.Kind), "-connectivity")))
+			if connectivitySuccessful[key] {
+				attrs = append(attrs, "color=darkgreen")
+			} else {
+				attrs = append(attrs, "color=red", "style=dashed")
+			}
+		}
+		fmt.Fprintf(&b, "  %s -- %s", dotQuote(swag.StringValue(edge.Source)), dotQuote(swag.StringValue(edge.Target)))
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(attrs, ", "))
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
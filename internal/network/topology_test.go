@@ -0,0 +1,239 @@
+package network
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"golang.org/x/sys/unix"
+)
+
+var _ = Describe("network topology", func() {
+	var (
+		hostID1 strfmt.UUID
+		hostID2 strfmt.UUID
+	)
+
+	createHost := func(id strfmt.UUID, hostname string, nicName string, address string, connectivity *models.ConnectivityReport) *models.Host {
+		inventory := models.Inventory{
+			Hostname: hostname,
+			Interfaces: []*models.Interface{
+				{
+					Name:          nicName,
+					MacAddress:    "52:54:00:00:00:01",
+					Mtu:           1500,
+					IPV4Addresses: []string{address},
+				},
+			},
+			Routes: []*models.Route{
+				{
+					Interface:   nicName,
+					Gateway:     "192.168.127.1",
+					Destination: "0.0.0.0",
+					Family:      unix.AF_INET,
+					Metric:      100,
+				},
+			},
+		}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ToNot(HaveOccurred())
+		host := &models.Host{
+			ID:        &id,
+			Inventory: string(b),
+			Role:      models.HostRoleMaster,
+		}
+		if connectivity != nil {
+			c, err := json.Marshal(connectivity)
+			Expect(err).ToNot(HaveOccurred())
+			host.Connectivity = string(c)
+		}
+		return host
+	}
+
+	findNode := func(topology *models.NetworkTopology, id string) *models.NetworkTopologyNode {
+		for _, n := range topology.Nodes {
+			if swag.StringValue(n.ID) == id {
+				return n
+			}
+		}
+		return nil
+	}
+
+	countEdges := func(topology *models.NetworkTopology, kind string) int {
+		ret := 0
+		for _, e := range topology.Edges {
+			if swag.StringValue(e.Kind) == kind {
+				ret++
+			}
+		}
+		return ret
+	}
+
+	BeforeEach(func() {
+		hostID1 = strfmt.UUID(uuid.New().String())
+		hostID2 = strfmt.UUID(uuid.New().String())
+	})
+
+	It("builds hosts, interfaces, subnets, gateways and vips", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{
+				createHost(hostID1, "master-0", "eth0", "192.168.127.10/24", nil),
+				createHost(hostID2, "master-1", "eth0", "192.168.127.11/24", nil),
+			},
+			APIVips:     []*models.APIVip{{IP: "192.168.127.100"}},
+			IngressVips: []*models.IngressVip{{IP: "192.168.127.101"}},
+		}}
+		topology, err := BuildNetworkTopology(cluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		host := findNode(topology, hostNodeID(hostID1))
+		Expect(host).ToNot(BeNil())
+		Expect(host.Label).To(Equal("master-0"))
+		Expect(host.Attributes["role"]).To(Equal(string(models.HostRoleMaster)))
+
+		nic := findNode(topology, interfaceNodeID(hostID2, "eth0"))
+		Expect(nic).ToNot(BeNil())
+		Expect(nic.HostID).To(Equal(hostID2))
+		Expect(nic.Attributes["mtu"]).To(Equal("1500"))
+
+		Expect(findNode(topology, subnetNodeID("192.168.127.0/24"))).ToNot(BeNil())
+		Expect(findNode(topology, gatewayNodeID("192.168.127.1"))).ToNot(BeNil())
+		Expect(findNode(topology, vipNodeID("192.168.127.100")).Attributes["type"]).To(Equal("api"))
+		Expect(findNode(topology, vipNodeID("192.168.127.101")).Attributes["type"]).To(Equal("ingress"))
+		Expect(topology.Nodes).To(HaveLen(8))
+
+		Expect(countEdges(topology, models.NetworkTopologyEdgeKindInterface)).To(Equal(2))
+		Expect(countEdges(topology, models.NetworkTopologyEdgeKindAddress)).To(Equal(2))
+		Expect(countEdges(topology, models.NetworkTopologyEdgeKindDefaultRoute)).To(Equal(2))
+		Expect(countEdges(topology, models.NetworkTopologyEdgeKindVip)).To(Equal(2))
+		Expect(topology.Dot).To(HavePrefix("graph network_topology {"))
+		Expect(topology.Dot).To(ContainSubstring(`"vip:192.168.127.100" -- "subnet:192.168.127.0/24"`))
+	})
+
+	It("adds reachability edges between interfaces", func() {
+		report := &models.ConnectivityReport{
+			RemoteHosts: []*models.ConnectivityRemoteHost{
+				{
+					HostID: hostID2,
+					L2Connectivity: []*models.L2Connectivity{
+						{OutgoingNic: "eth0", RemoteIPAddress: "192.168.127.11", Successful: true},
+					},
+					L3Connectivity: []*models.L3Connectivity{
+						{OutgoingNic: "eth0", RemoteIPAddress: "192.168.127.11", Successful: false},
+					},
+				},
+				{
+					HostID: strfmt.UUID(uuid.New().String()),
+					L3Connectivity: []*models.L3Connectivity{
+						{OutgoingNic: "eth0", RemoteIPAddress: "192.168.127.12", Successful: true},
+					},
+				},
+			},
+		}
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{
+				createHost(hostID1, "master-0", "eth0", "192.168.127.10/24", report),
+				createHost(hostID2, "master-1", "ens3", "192.168.127.11/24", nil),
+			},
+		}}
+		topology, err := BuildNetworkTopology(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(countEdges(topology, models.NetworkTopologyEdgeKindL2Connectivity)).To(Equal(1))
+		Expect(countEdges(topology, models.NetworkTopologyEdgeKindL3Connectivity)).To(Equal(1))
+		for _, e := range topology.Edges {
+			switch swag.StringValue(e.Kind) {
+			case models.NetworkTopologyEdgeKindL2Connectivity:
+				Expect(swag.StringValue(e.Source)).To(Equal(interfaceNodeID(hostID1, "eth0")))
+				Expect(swag.StringValue(e.Target)).To(Equal(interfaceNodeID(hostID2, "ens3")))
+				Expect(e.Successful).To(BeTrue())
+			case models.NetworkTopologyEdgeKindL3Connectivity:
+				Expect(e.Successful).To(BeFalse())
+			}
+		}
+		Expect(topology.Dot).To(ContainSubstring("color=red, style=dashed"))
+	})
+
+	It("draws the checks between the same interfaces in both directions as a single edge", func() {
+		report := func(remoteHostID strfmt.UUID, remoteAddress string, successful bool) *models.ConnectivityReport {
+			return &models.ConnectivityReport{
+				RemoteHosts: []*models.ConnectivityRemoteHost{
+					{
+						HostID: remoteHostID,
+						L2Connectivity: []*models.L2Connectivity{
+							{OutgoingNic: "eth0", RemoteIPAddress: remoteAddress, Successful: true},
+						},
+						L3Connectivity: []*models.L3Connectivity{
+							{OutgoingNic: "eth0", RemoteIPAddress: remoteAddress, Successful: successful},
+						},
+					},
+				},
+			}
+		}
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{
+				createHost(hostID1, "master-0", "eth0", "192.168.127.10/24", report(hostID2, "192.168.127.11", true)),
+				createHost(hostID2, "master-1", "eth0", "192.168.127.11/24", report(hostID1, "192.168.127.10", false)),
+			},
+		}}
+		topology, err := BuildNetworkTopology(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(countEdges(topology, models.NetworkTopologyEdgeKindL2Connectivity)).To(Equal(2))
+		Expect(countEdges(topology, models.NetworkTopologyEdgeKindL3Connectivity)).To(Equal(2))
+		Expect(strings.Count(topology.Dot, `label="l2", color=darkgreen`)).To(Equal(1))
+		Expect(strings.Count(topology.Dot, `label="l3"`)).To(Equal(1))
+		Expect(topology.Dot).To(ContainSubstring(`label="l3", color=red, style=dashed`))
+	})
+
+	It("skips the default route through an interface missing from the inventory", func() {
+		host := createHost(hostID1, "master-0", "eth0", "192.168.127.10/24", nil)
+		var inventory models.Inventory
+		Expect(json.Unmarshal([]byte(host.Inventory), &inventory)).To(Succeed())
+		inventory.Routes[0].Interface = "br-ex"
+		b, err := json.Marshal(&inventory)
+		Expect(err).ToNot(HaveOccurred())
+		host.Inventory = string(b)
+		cluster := &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{host}}}
+		topology, err := BuildNetworkTopology(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(countEdges(topology, models.NetworkTopologyEdgeKindDefaultRoute)).To(BeZero())
+		Expect(findNode(topology, gatewayNodeID("192.168.127.1"))).To(BeNil())
+		Expect(topology.Dot).ToNot(ContainSubstring("br-ex"))
+	})
+
+	It("escapes the labels in the DOT rendering", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{
+				createHost(hostID1, `master-"0"\x`, "eth0", "192.168.127.10/24", nil),
+			},
+		}}
+		topology, err := BuildNetworkTopology(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(topology.Dot).To(ContainSubstring(`[label="master-\"0\"\\x"`))
+		Expect(topology.Dot).To(ContainSubstring(`[label="eth0\n52:54:00:00:00:01"`))
+	})
+
+	It("handles hosts without inventory", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{{ID: &hostID1}},
+		}}
+		topology, err := BuildNetworkTopology(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(topology.Nodes).To(HaveLen(1))
+		Expect(topology.Nodes[0].Label).To(Equal(hostID1.String()))
+		Expect(topology.Edges).To(BeEmpty())
+	})
+
+	It("fails on a malformed inventory", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{{ID: &hostID1, Inventory: "{"}},
+		}}
+		_, err := BuildNetworkTopology(cluster)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,278 @@
+package network
+
+import (
+	"math/big"
+	"net/netip"
+	"sort"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Upper bound of the addresses that are examined when the candidates are not taken from the free addresses scan
+	maxScannedVipCandidates = 4096
+
+	// Candidates that were reported free by all the hosts are always ranked above unverified candidates
+	verifiedVipCandidateScore = 1000
+
+	// The distance from the nearest host address is added to the score up to this value.  Hosts commonly get their
+	// addresses from a DHCP pool, so addresses far away from them are less likely to be leased in the future.
+	maxVipDistanceScore = 255
+)
+
+type vipCandidate struct {
+	addr     netip.Addr
+	verified bool
+	score    int64
+}
+
+// vipSuggestionNetworks returns the networks to suggest VIPs for.  These are the machine networks when they are set,
+// otherwise the networks that all the hosts with an inventory have an address in.
+func vipSuggestionNetworks(cluster *common.Cluster, log logrus.FieldLogger) []netip.Prefix {
+	var cidrs []string
+	if IsMachineCidrAvailable(cluster) {
+		cidrs = GetMachineNetworkCidrs(cluster)
+	} else {
+		counts := make(map[string]int)
+		hostsWithInventory := 0
+		for _, h := range cluster.Hosts {
+			if h.Inventory == "" {
+				continue
+			}
+			hostsWithInventory++
+			for _, cidr := range GetInventoryNetworks([]*models.Host{h}, log) {
+				counts[cidr]++
+			}
+		}
+		for cidr, count := range counts {
+			if count == hostsWithInventory {
+				cidrs = append(cidrs, cidr)
+			}
+		}
+	}
+
+	var ret []netip.Prefix
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			log.WithError(err).Warnf("failed to parse network %s", cidr)
+			continue
+		}
+		ret = append(ret, prefix.Masked())
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Addr().Is4() != ret[j].Addr().Is4() {
+			return ret[i].Addr().Is4()
+		}
+		return ret[i].String() < ret[j].String()
+	})
+	return lo.Uniq(ret)
+}
+
+// usedVipAddresses collects the addresses that must not be suggested: the addresses and default gateways of the hosts,
+// the addresses leased for the cluster VIPs and the given reserved addresses
+func usedVipAddresses(cluster *common.Cluster, reserved []string) (used map[netip.Addr]bool, hostAddresses []netip.Addr) {
+	used = make(map[netip.Addr]bool)
+	add := func(address string) *netip.Addr {
+		addr, err := netip.ParseAddr(address)
+		if err != nil {
+			return nil
+		}
+		used[addr] = true
+		return &addr
+	}
+	for _, h := range cluster.Hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			continue
+		}
+		v4Addresses, v6Addresses := GetInventoryIPAddresses(inventory)
+		for _, address := range append(v4Addresses, v6Addresses...) {
+			prefix, err := netip.ParsePrefix(address)
+			if err != nil {
+				continue
+			}
+			if addr := add(prefix.Addr().String()); addr != nil {
+				hostAddresses = append(hostAddresses, *addr)
+			}
+		}
+		for _, route := range inventory.Routes {
+			add(route.Gateway)
+		}
+	}
+	add(LeaseFixedAddress(cluster.ApiVipLease))
+	add(LeaseFixedAddress(cluster.IngressVipLease))
+	for _, address := range reserved {
+		add(address)
+	}
+	return used, hostAddresses
+}
+
+// ReservedVipAddresses returns the addresses of the other clusters that must not be suggested as VIPs of the cluster.
+// Only the other clusters that share a network with the cluster are considered, and their VIPs, VIP leases, gateways
+// and host addresses, which are usually leased from the same DHCP server, are reserved.  The other clusters are
+// expected to be loaded with their hosts and VIPs.
+func ReservedVipAddresses(cluster *common.Cluster, others []*common.Cluster, log logrus.FieldLogger) []string {
+	networks := vipSuggestionNetworks(cluster, log)
+	reserved := make(map[netip.Addr]bool)
+	for _, other := range others {
+		if other.ID != nil && cluster.ID != nil && *other.ID == *cluster.ID {
+			continue
+		}
+		shared := lo.SomeBy(vipSuggestionNetworks(other, log), func(prefix netip.Prefix) bool {
+			return lo.SomeBy(networks, prefix.Overlaps)
+		})
+		if !shared {
+			continue
+		}
+		var vips []string
+		for _, vip := range other.APIVips {
+			vips = append(vips, string(vip.IP))
+		}
+		for _, vip := range other.IngressVips {
+			vips = append(vips, string(vip.IP))
+		}
+		used, _ := usedVipAddresses(other, vips)
+		for addr := range used {
+			reserved[addr] = true
+		}
+	}
+
+	ret := make([]string, 0, len(reserved))
+	for _, addr := range lo.Keys(reserved) {
+		ret = append(ret, addr.String())
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func lastAddress(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Addr().AsSlice()
+	bits := prefix.Bits()
+	for i := range addr {
+		for bit := 0; bit < 8; bit++ {
+			if i*8+bit >= bits {
+				addr[i] |= 0x80 >> bit
+			}
+		}
+	}
+	ret, _ := netip.AddrFromSlice(addr)
+	return ret
+}
+
+func addressDistance(a, b netip.Addr) *big.Int {
+	aInt := new(big.Int).SetBytes(a.AsSlice())
+	bInt := new(big.Int).SetBytes(b.AsSlice())
+	return new(big.Int).Abs(new(big.Int).Sub(aInt, bInt))
+}
+
+func scoreVipCandidate(candidate *vipCandidate, hostAddresses []netip.Addr) {
+	var score int64
+	if candidate.verified {
+		score += verifiedVipCandidateScore
+	}
+	distance := big.NewInt(maxVipDistanceScore)
+	for _, hostAddr := range hostAddresses {
+		if d := addressDistance(candidate.addr, hostAddr); d.Cmp(distance) < 0 {
+			distance = d
+		}
+	}
+	candidate.score = score + distance.Int64()
+}
+
+func isUsableVipAddress(prefix netip.Prefix, addr netip.Addr, used map[netip.Addr]bool) bool {
+	if !prefix.Contains(addr) || used[addr] || addr == prefix.Addr() {
+		return false
+	}
+	// Broadcast addresses exist only in IPv4
+	return !addr.Is4() || addr != lastAddress(prefix)
+}
+
+func collectVipCandidates(prefix netip.Prefix, hosts []*models.Host, used map[netip.Addr]bool, log logrus.FieldLogger) []*vipCandidate {
+	var ret []*vipCandidate
+	if prefix.Addr().Is4() {
+		for freeAddress := range MakeFreeAddressesSet(hosts, prefix.String(), nil, log) {
+			addr, err := netip.ParseAddr(freeAddress.String())
+			if err != nil || !isUsableVipAddress(prefix, addr, used) {
+				continue
+			}
+			ret = append(ret, &vipCandidate{addr: addr, verified: true})
+		}
+		if len(ret) > 0 {
+			return ret
+		}
+	}
+
+	// No free addresses scan is available for the network, fall back to the unused addresses at the top of the range
+	addr := lastAddress(prefix)
+	for i := 0; i < maxScannedVipCandidates && prefix.Contains(addr); i++ {
+		if isUsableVipAddress(prefix, addr, used) {
+			ret = append(ret, &vipCandidate{addr: addr})
+		}
+		addr = addr.Prev()
+	}
+	return ret
+}
+
+func toVipCandidates(candidates []*vipCandidate) []*models.VipCandidate {
+	ret := make([]*models.VipCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		ret = append(ret, &models.VipCandidate{
+			IP:       models.IP(c.addr.String()),
+			Score:    c.score,
+			Verified: c.verified,
+		})
+	}
+	return ret
+}
+
+// SuggestVips proposes API and ingress VIPs for every machine network of the cluster.  Candidates reported free by the
+// free addresses scan of all the hosts are preferred, addresses used by hosts, gateways, VIP leases or the given reserved
+// addresses are never suggested, and the API and ingress candidates of a network never overlap.
+func SuggestVips(cluster *common.Cluster, reserved []string, maxCandidates int, log logrus.FieldLogger) models.VipSuggestions {
+	used, hostAddresses := usedVipAddresses(cluster, reserved)
+	ret := models.VipSuggestions{}
+	for _, prefix := range vipSuggestionNetworks(cluster, log) {
+		candidates := collectVipCandidates(prefix, cluster.Hosts, used, log)
+		for _, c := range candidates {
+			scoreVipCandidate(c, hostAddresses)
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].score != candidates[j].score {
+				return candidates[i].score > candidates[j].score
+			}
+			// Prefer the top of the range since DHCP pools commonly start at its bottom
+			return candidates[j].addr.Less(candidates[i].addr)
+		})
+
+		var apiCandidates, ingressCandidates []*vipCandidate
+		for i, c := range candidates {
+			if i%2 == 0 && len(apiCandidates) < maxCandidates {
+				apiCandidates = append(apiCandidates, c)
+			} else if i%2 == 1 && len(ingressCandidates) < maxCandidates {
+				ingressCandidates = append(ingressCandidates, c)
+			}
+			if len(apiCandidates) == maxCandidates && len(ingressCandidates) == maxCandidates {
+				break
+			}
+		}
+
+		family := models.VipSuggestionAddressFamilyIPV4
+		if prefix.Addr().Is6() {
+			family = models.VipSuggestionAddressFamilyIPV6
+		}
+		ret = append(ret, &models.VipSuggestion{
+			AddressFamily:        family,
+			MachineNetworkCidr:   models.Subnet(prefix.String()),
+			APIVipCandidates:     toVipCandidates(apiCandidates),
+			IngressVipCandidates: toVipCandidates(ingressCandidates),
+		})
+	}
+	return ret
+}
@@ -0,0 +1,149 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+var _ = Describe("VIP suggestions", func() {
+	log := logrus.New()
+
+	createHost := func(ipv4Addresses []string, ipv6Addresses []string, freeAddresses models.FreeNetworksAddresses) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		inventory := models.Inventory{
+			Interfaces: []*models.Interface{
+				{
+					Name:          "eth0",
+					IPV4Addresses: ipv4Addresses,
+					IPV6Addresses: ipv6Addresses,
+				},
+			},
+			Routes: []*models.Route{
+				{Interface: "eth0", Gateway: "192.168.127.1", Destination: "0.0.0.0", Family: unix.AF_INET},
+			},
+		}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ToNot(HaveOccurred())
+		host := &models.Host{ID: &id, Inventory: string(b)}
+		if freeAddresses != nil {
+			f, err := json.Marshal(&freeAddresses)
+			Expect(err).ToNot(HaveOccurred())
+			host.FreeAddresses = string(f)
+		}
+		return host
+	}
+
+	candidateIPs := func(candidates []*models.VipCandidate) []string {
+		return lo.Map(candidates, func(c *models.VipCandidate, _ int) string { return string(c.IP) })
+	}
+
+	It("prefers addresses reported free by all the hosts", func() {
+		free1 := models.FreeNetworksAddresses{{Network: "192.168.127.0/24", FreeAddresses: []strfmt.IPv4{"192.168.127.50", "192.168.127.200", "192.168.127.201", "192.168.127.202"}}}
+		free2 := models.FreeNetworksAddresses{{Network: "192.168.127.0/24", FreeAddresses: []strfmt.IPv4{"192.168.127.200", "192.168.127.201", "192.168.127.202"}}}
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{
+				createHost([]string{"192.168.127.10/24"}, nil, free1),
+				createHost([]string{"192.168.127.11/24"}, nil, free2),
+			},
+		}}
+		suggestions := SuggestVips(cluster, nil, 3, log)
+		Expect(suggestions).To(HaveLen(1))
+		Expect(suggestions[0].AddressFamily).To(Equal(models.VipSuggestionAddressFamilyIPV4))
+		Expect(suggestions[0].MachineNetworkCidr).To(Equal(models.Subnet("192.168.127.0/24")))
+		Expect(candidateIPs(suggestions[0].APIVipCandidates)).To(Equal([]string{"192.168.127.202", "192.168.127.200"}))
+		Expect(candidateIPs(suggestions[0].IngressVipCandidates)).To(Equal([]string{"192.168.127.201"}))
+		for _, c := range append(suggestions[0].APIVipCandidates, suggestions[0].IngressVipCandidates...) {
+			Expect(c.Verified).To(BeTrue())
+		}
+	})
+
+	It("never suggests used, leased or reserved addresses", func() {
+		cluster := &common.Cluster{
+			Cluster: models.Cluster{
+				MachineNetworks: []*models.MachineNetwork{{Cidr: "192.168.127.248/29"}},
+				Hosts: []*models.Host{
+					createHost([]string{"192.168.127.249/29"}, nil, nil),
+				},
+			},
+			ApiVipLease: "lease {\n  interface \"api\";\n  fixed-address 192.168.127.254;\n}",
+		}
+		suggestions := SuggestVips(cluster, []string{"192.168.127.253"}, 5, log)
+		Expect(suggestions).To(HaveLen(1))
+		ips := append(candidateIPs(suggestions[0].APIVipCandidates), candidateIPs(suggestions[0].IngressVipCandidates)...)
+		Expect(ips).To(ConsistOf("192.168.127.252", "192.168.127.251", "192.168.127.250"))
+		Expect(suggestions[0].APIVipCandidates[0].Verified).To(BeFalse())
+	})
+
+	It("suggests addresses for each address family", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{
+				createHost([]string{"192.168.127.10/24"}, []string{"2001:db8::10/120"}, nil),
+				createHost([]string{"192.168.127.11/24"}, []string{"2001:db8::11/120"}, nil),
+			},
+		}}
+		suggestions := SuggestVips(cluster, nil, 1, log)
+		Expect(suggestions).To(HaveLen(2))
+		Expect(suggestions[0].AddressFamily).To(Equal(models.VipSuggestionAddressFamilyIPV4))
+		Expect(candidateIPs(suggestions[0].APIVipCandidates)).To(Equal([]string{"192.168.127.254"}))
+		Expect(candidateIPs(suggestions[0].IngressVipCandidates)).To(Equal([]string{"192.168.127.253"}))
+		Expect(suggestions[1].AddressFamily).To(Equal(models.VipSuggestionAddressFamilyIPV6))
+		Expect(suggestions[1].MachineNetworkCidr).To(Equal(models.Subnet("2001:db8::/120")))
+		Expect(candidateIPs(suggestions[1].APIVipCandidates)).To(Equal([]string{"2001:db8::ff"}))
+		Expect(candidateIPs(suggestions[1].IngressVipCandidates)).To(Equal([]string{"2001:db8::fe"}))
+	})
+
+	It("ignores networks not shared by all the hosts", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			Hosts: []*models.Host{
+				createHost([]string{"192.168.127.10/24", "10.0.0.10/24"}, nil, nil),
+				createHost([]string{"192.168.127.11/24"}, nil, nil),
+			},
+		}}
+		suggestions := SuggestVips(cluster, nil, 1, log)
+		Expect(suggestions).To(HaveLen(1))
+		Expect(suggestions[0].MachineNetworkCidr).To(Equal(models.Subnet("192.168.127.0/24")))
+	})
+
+	It("returns no suggestions without hosts", func() {
+		Expect(SuggestVips(&common.Cluster{}, nil, 3, log)).To(BeEmpty())
+	})
+
+	Context("reserved addresses of the other clusters", func() {
+		newCluster := func(machineNetwork string, hosts ...*models.Host) *common.Cluster {
+			id := strfmt.UUID(uuid.New().String())
+			return &common.Cluster{Cluster: models.Cluster{
+				ID:              &id,
+				MachineNetworks: []*models.MachineNetwork{{Cidr: models.Subnet(machineNetwork)}},
+				Hosts:           hosts,
+			}}
+		}
+
+		It("reserves the VIPs, leases and host addresses of the clusters sharing a network", func() {
+			cluster := newCluster("192.168.127.0/24", createHost([]string{"192.168.127.10/24"}, nil, nil))
+			shared := newCluster("192.168.127.0/25", createHost([]string{"192.168.127.20/25"}, nil, nil))
+			shared.APIVips = []*models.APIVip{{IP: "192.168.127.100"}}
+			shared.IngressVips = []*models.IngressVip{{IP: "192.168.127.101"}}
+			shared.IngressVipLease = "lease {\n  interface \"ingress\";\n  fixed-address 192.168.127.102;\n}"
+			other := newCluster("10.0.0.0/24", createHost([]string{"10.0.0.20/24"}, nil, nil))
+			other.APIVips = []*models.APIVip{{IP: "10.0.0.100"}}
+
+			Expect(ReservedVipAddresses(cluster, []*common.Cluster{shared, other}, log)).To(Equal(
+				[]string{"192.168.127.1", "192.168.127.100", "192.168.127.101", "192.168.127.102", "192.168.127.20"}))
+		})
+
+		It("ignores the cluster itself", func() {
+			cluster := newCluster("192.168.127.0/24", createHost([]string{"192.168.127.10/24"}, nil, nil))
+			cluster.APIVips = []*models.APIVip{{IP: "192.168.127.100"}}
+			Expect(ReservedVipAddresses(cluster, []*common.Cluster{cluster}, log)).To(BeEmpty())
+		})
+	})
+})
@@ -64,5 +64,26 @@ type Operator interface {
 // Storage Operator provide a generic API for storage operators
 type StorageOperator interface {
 	Operator
-	StorageClassName() string
+	// StorageClassName returns the name of the storage class that the operator creates for the cluster
+	StorageClassName(cluster *common.Cluster) string
+}
+
+// StorageSizingOperator is implemented by the storage operators that use the disks of the hosts. It is used to
+// calculate the capacity that the operator provides.
+type StorageSizingOperator interface {
+	Operator
+	// GetStorageSizingDefaults returns the replication factor, the reserved percentage and the device paths that the
+	// operator uses for the cluster
+	GetStorageSizingDefaults(cluster *common.Cluster) (*models.StorageSizingParams, error)
+	// GetStorageDisks returns the disks of the host that the operator can use, nil when the operator doesn't use the
+	// disks of the host. The given disks don't include the installation disk.
+	GetStorageDisks(cluster *common.Cluster, host *models.Host, disks []*models.Disk) []*models.Disk
+}
+
+// ChannelsOperator is implemented by the operators that know the subscription channels available for each OpenShift
+// version. Only the subscriptions of these operators can be pinned to a channel.
+type ChannelsOperator interface {
+	Operator
+	// GetChannels returns the subscription channels of the operator for the given OpenShift version
+	GetChannels(openshiftVersion string) ([]string, error)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/authorino"
 	"github.com/openshift/assisted-service/internal/operators/clusterobservability"
 	"github.com/openshift/assisted-service/internal/operators/cnv"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
 	"github.com/openshift/assisted-service/internal/operators/fenceagentsremediation"
 	"github.com/openshift/assisted-service/internal/operators/kmm"
 	"github.com/openshift/assisted-service/internal/operators/kubedescheduler"
@@ -51,6 +52,24 @@ var OperatorConsole = models.MonitoredOperator{
 type Options struct {
 	CheckClusterVersion bool
 	CNVConfig           cnv.Config
+
+	// DeclarativeOperatorsDir is the directory, usually a mounted config map, that contains the YAML definitions of
+	// additional operators that don't need custom logic
+	DeclarativeOperatorsDir string `envconfig:"DECLARATIVE_OPERATORS_DIR" default:""`
+
+	// BundlesDir is the directory, usually a mounted config map, that contains the YAML definitions of the bundles
+	// added to the builtin ones
+	BundlesDir string `envconfig:"OPERATOR_BUNDLES_DIR" default:""`
+
+	// HiddenBundles are the identifiers of the bundles that aren't offered, usually builtin bundles replaced by
+	// bundles of the BundlesDir
+	HiddenBundles []string `envconfig:"OPERATOR_HIDDEN_BUNDLES" default:""`
+
+	// The catalog source used by the subscriptions of the clusters that don't have their own, usually the index image
+	// of the operators in a mirror registry. The default catalogs of OpenShift are used when there is no index image.
+	CatalogSourceName       string   `envconfig:"OPERATORS_CATALOG_SOURCE_NAME" default:"mirrored-operators"`
+	CatalogSourceIndexImage string   `envconfig:"OPERATORS_CATALOG_SOURCE_INDEX_IMAGE" default:""`
+	CatalogSourcePackages   []string `envconfig:"OPERATORS_CATALOG_SOURCE_PACKAGES" default:""`
 }
 
 // NewManager creates new instance of an Operator Manager
@@ -58,8 +77,7 @@ func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, o
 	nvidiaGPUOperator := nvidiagpu.NewNvidiaGPUOperator(log)
 	amdGPUOperator := amdgpu.NewAMDGPUOperator(log)
 
-	return NewManagerWithOperators(
-		log, manifestAPI, options, objectHandler,
+	olmOperators := []api.Operator{
 		lso.NewLSOperator(),
 		odf.NewOcsOperator(log),
 		odf.NewOdfOperator(log),
@@ -89,7 +107,36 @@ func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, o
 		numaresources.NewNumaResourcesOperator(log),
 		oadp.NewOadpOperator(log),
 		metallb.NewMetalLBOperator(log),
-	)
+	}
+	// The bundles are registered before the declarative operators are loaded, as they can be part of them
+	var bundleDefinitions []*declarative.BundleDefinition
+	if options.BundlesDir != "" {
+		var err error
+		bundleDefinitions, err = loadBundles(log, options.BundlesDir)
+		if err != nil {
+			log.WithError(err).Fatal("failed to load operator bundles")
+		}
+	}
+	if options.DeclarativeOperatorsDir != "" {
+		declarativeOperators, err := loadDeclarativeOperators(log, options.DeclarativeOperatorsDir, olmOperators)
+		if err != nil {
+			log.WithError(err).Fatal("failed to load declarative operators")
+		}
+		olmOperators = append(olmOperators, declarativeOperators...)
+	}
+	if err := ValidateCatalogSource(defaultCatalogSource(options)); err != nil {
+		log.WithError(err).Fatal("invalid default operators catalog source")
+	}
+
+	if err := validateBundles(bundleDefinitions, olmOperators); err != nil {
+		log.WithError(err).Fatal("invalid operator bundles")
+	}
+
+	manager := NewManagerWithOperators(log, manifestAPI, options, objectHandler, olmOperators...)
+	for _, definition := range bundleDefinitions {
+		manager.bundleDefinitions[definition.ID] = definition
+	}
+	return manager
 }
 
 // NewManagerWithOperators creates new instance of an Operator Manager and configures it with given operators
@@ -113,10 +160,24 @@ func NewManagerWithOperators(log logrus.FieldLogger, manifestAPI manifestsapi.Ma
 	}
 
 	return &Manager{
-		log:                log,
-		olmOperators:       nameToOperator,
-		monitoredOperators: monitoredOperators,
-		manifestsAPI:       manifestAPI,
-		objectHandler:      objectHandler,
+		log:                  log,
+		olmOperators:         nameToOperator,
+		monitoredOperators:   monitoredOperators,
+		manifestsAPI:         manifestAPI,
+		objectHandler:        objectHandler,
+		defaultCatalogSource: defaultCatalogSource(options),
+		bundleDefinitions:    make(map[string]*declarative.BundleDefinition),
+		hiddenBundles:        options.HiddenBundles,
+	}
+}
+
+func defaultCatalogSource(options Options) *models.OperatorsCatalogSource {
+	if options.CatalogSourceIndexImage == "" {
+		return nil
+	}
+	return &models.OperatorsCatalogSource{
+		Name:       options.CatalogSourceName,
+		IndexImage: options.CatalogSourceIndexImage,
+		Packages:   options.CatalogSourcePackages,
 	}
 }
@@ -0,0 +1,65 @@
+package operators
+
+import (
+	"fmt"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
+	"github.com/openshift/assisted-service/models"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// loadBundles loads the bundles defined in the given directory and registers them next to the builtin bundles.  The
+// bundles can't replace the builtin ones, those can be hidden instead.
+func loadBundles(log logrus.FieldLogger, dir string) ([]*declarative.BundleDefinition, error) {
+	definitions, err := declarative.LoadBundleDefinitions(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, definition := range definitions {
+		err = operatorscommon.RegisterBundle(&models.Bundle{
+			ID:          definition.ID,
+			Title:       definition.Title,
+			Description: definition.Description,
+		})
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("Loaded operator bundle %s from %s", definition.ID, dir)
+	}
+	return definitions, nil
+}
+
+// validateBundles checks that the operators of the bundles exist, including the declarative operators
+func validateBundles(definitions []*declarative.BundleDefinition, operators []api.Operator) error {
+	for _, definition := range definitions {
+		for _, operatorName := range definition.Operators {
+			if !lo.ContainsBy(operators, func(operator api.Operator) bool { return operator.GetName() == operatorName }) {
+				return fmt.Errorf("bundle %s contains unknown operator %s", definition.ID, operatorName)
+			}
+		}
+	}
+	return nil
+}
+
+// isBundleDefinitionSupported checks the OpenShift version and the CPU architecture of the filters against the ones
+// where a bundle defined by the administrator is available
+func isBundleDefinitionSupported(definition *declarative.BundleDefinition, filters featuresupport.SupportLevelFilters) bool {
+	if definition.FeatureSupport.MinOpenshiftVersion != "" {
+		supported, err := common.BaseVersionGreaterOrEqual(definition.FeatureSupport.MinOpenshiftVersion, filters.OpenshiftVersion)
+		if err != nil || !supported {
+			return false
+		}
+	}
+	if len(definition.FeatureSupport.Architectures) > 0 && filters.CPUArchitecture != nil {
+		architecture := common.NormalizeCPUArchitecture(*filters.CPUArchitecture)
+		if !lo.Contains(definition.FeatureSupport.Architectures, architecture) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const catalogSourceNamespace = "openshift-marketplace"
+
+// isCatalogSourceSet checks if the catalog source has an index image, the catalog source of the clusters is embedded
+// so it is never nil once read from the database
+func isCatalogSourceSet(catalogSource *models.OperatorsCatalogSource) bool {
+	return catalogSource != nil && catalogSource.IndexImage != ""
+}
+
+// getCatalogSource returns the catalog source of the cluster or, when the cluster doesn't have one, the default
+// catalog source of the service. Nil means that the operators use the default catalogs of OpenShift.
+func (mgr *Manager) getCatalogSource(catalogSource *models.OperatorsCatalogSource) *models.OperatorsCatalogSource {
+	if isCatalogSourceSet(catalogSource) {
+		return catalogSource
+	}
+	if isCatalogSourceSet(mgr.defaultCatalogSource) {
+		return mgr.defaultCatalogSource
+	}
+	return nil
+}
+
+// ValidateCatalogSource verifies the name and the index image of a catalog source
+func ValidateCatalogSource(catalogSource *models.OperatorsCatalogSource) error {
+	if !isCatalogSourceSet(catalogSource) {
+		return nil
+	}
+	if errs := validation.IsDNS1123Subdomain(catalogSource.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid operators catalog source name '%s': %s", catalogSource.Name, strings.Join(errs, ", "))
+	}
+	if _, err := reference.ParseNormalizedNamed(strings.TrimSpace(catalogSource.IndexImage)); err != nil {
+		return errors.Wrapf(err, "invalid operators catalog source index image '%s'", catalogSource.IndexImage)
+	}
+	return nil
+}
+
+// EnsureOperatorsCatalogSource verifies that the catalog source used by the cluster is valid and that it contains
+// the packages of the OLM operators. The packages can't be verified when the catalog source doesn't list them.
+func (mgr *Manager) EnsureOperatorsCatalogSource(catalogSource *models.OperatorsCatalogSource, operators []*models.MonitoredOperator) error {
+	catalogSource = mgr.getCatalogSource(catalogSource)
+	if catalogSource == nil {
+		return nil
+	}
+	if err := ValidateCatalogSource(catalogSource); err != nil {
+		return err
+	}
+	if len(catalogSource.Packages) == 0 {
+		return nil
+	}
+	var missingPackages []string
+	for _, operator := range operators {
+		if operator.OperatorType != models.OperatorTypeOlm {
+			continue
+		}
+		if !funk.ContainsString(catalogSource.Packages, operator.SubscriptionName) {
+			missingPackages = append(missingPackages, operator.SubscriptionName)
+		}
+	}
+	if len(missingPackages) > 0 {
+		return fmt.Errorf("operators catalog source %s doesn't contain the packages %s", catalogSource.Name, strings.Join(missingPackages, ", "))
+	}
+	return nil
+}
+
+// useCatalogSource makes the subscriptions of the operator use the catalog source
+func useCatalogSource(manifests map[string][]byte, monitoredOperator *models.MonitoredOperator, catalogSource *models.OperatorsCatalogSource) error {
+	return updateSubscriptions(manifests, monitoredOperator.Name, func(subscription *unstructured.Unstructured) error {
+		if err := unstructured.SetNestedField(subscription.Object, catalogSource.Name, "spec", "source"); err != nil {
+			return err
+		}
+		return unstructured.SetNestedField(subscription.Object, catalogSourceNamespace, "spec", "sourceNamespace")
+	})
+}
+
+// catalogSourceManifest generates the CatalogSource that serves the operators from the index image
+func catalogSourceManifest(catalogSource *models.OperatorsCatalogSource) ([]byte, error) {
+	displayName := catalogSource.DisplayName
+	if displayName == "" {
+		displayName = catalogSource.Name
+	}
+	manifest := map[string]interface{}{
+		"apiVersion": "operators.coreos.com/v1alpha1",
+		"kind":       "CatalogSource",
+		"metadata": map[string]interface{}{
+			"name":      catalogSource.Name,
+			"namespace": catalogSourceNamespace,
+		},
+		"spec": map[string]interface{}{
+			"sourceType":  "grpc",
+			"image":       strings.TrimSpace(catalogSource.IndexImage),
+			"displayName": displayName,
+			"publisher":   "Assisted Installer",
+		},
+	}
+	return k8syaml.Marshal(manifest)
+}
+
+// createCatalogSourceManifest adds the CatalogSource to the installation manifests of the cluster
+func (mgr *Manager) createCatalogSourceManifest(ctx context.Context, cluster *common.Cluster, catalogSource *models.OperatorsCatalogSource) error {
+	content, err := catalogSourceManifest(catalogSource)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate catalog source %s", catalogSource.Name)
+	}
+	return mgr.createInstallManifests(ctx, cluster, catalogSourceFileName(catalogSource), content, models.ManifestFolderOpenshift)
+}
+
+func catalogSourceFileName(catalogSource *models.OperatorsCatalogSource) string {
+	return fmt.Sprintf("50_openshift-marketplace_%s_catalog_source.yaml", catalogSource.Name)
+}
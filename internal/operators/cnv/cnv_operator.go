@@ -182,9 +182,9 @@ func (o *operator) GenerateManifests(c *common.Cluster) (map[string][]byte, []by
 	return Manifests(o.config, c)
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return propertiesDescription
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the CNV Operator
@@ -361,3 +361,8 @@ func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
 func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
 	return []string(Operator.Bundles)
 }
+
+// GetChannels returns the subscription channels of the CNV operator
+func (o *operator) GetChannels(openshiftVersion string) ([]string, error) {
+	return []string{"stable"}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-version"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/lso"
 )
 
 const (
@@ -57,7 +58,11 @@ func Manifests(config Config, cluster *common.Cluster) (map[string][]byte, []byt
 	if err != nil {
 		return nil, nil, err
 	}
-	cnvHco, err := hco(configSource)
+	properties, err := getProperties(cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	cnvHco, err := hco(configSource, properties)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -65,7 +70,12 @@ func Manifests(config Config, cluster *common.Cluster) (map[string][]byte, []byt
 	openshiftManifests := make(map[string][]byte)
 
 	if shouldInstallHPP(config, cluster) {
-		cnvHpp, err := hpp(config.SNOPoolSizeRequestHPPGib)
+		var storageClassName string
+		storageClassName, err = lso.StorageClassName(cluster)
+		if err != nil {
+			return nil, nil, err
+		}
+		cnvHpp, err := hpp(config.SNOPoolSizeRequestHPPGib, storageClassName)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -107,16 +117,19 @@ func group(config manifestConfig) ([]byte, error) {
 	return executeTemplate(data, "cnvGroup", cnvGroup)
 }
 
-func hco(config manifestConfig) ([]byte, error) {
+func hco(config manifestConfig, properties cnvProperties) ([]byte, error) {
 	data := map[string]string{
-		"OPERATOR_NAMESPACE": config.Namespace,
+		"OPERATOR_NAMESPACE":          config.Namespace,
+		"SCRATCH_SPACE_STORAGE_CLASS": properties.ScratchSpaceStorageClass,
+		"VM_STATE_STORAGE_CLASS":      properties.VMStateStorageClass,
 	}
 	return executeTemplate(data, "cnvHCO", cnvHCOManifestTemplate)
 }
 
-func hpp(diskThresholdGi int64) ([]byte, error) {
+func hpp(diskThresholdGi int64, storageClassName string) ([]byte, error) {
 	data := map[string]string{
-		"STORAGE_SIZE": fmt.Sprintf("%dGi", diskThresholdGi),
+		"STORAGE_SIZE":       fmt.Sprintf("%dGi", diskThresholdGi),
+		"STORAGE_CLASS_NAME": storageClassName,
 	}
 	return executeTemplate(data, "cnvHPP", cnvHPPManifestTemplate)
 }
@@ -193,7 +206,13 @@ metadata:
   name: kubevirt-hyperconverged
   namespace: "{{.OPERATOR_NAMESPACE}}"
 spec:
-  BareMetalPlatform: true`
+  BareMetalPlatform: true
+{{- if .SCRATCH_SPACE_STORAGE_CLASS}}
+  scratchSpaceStorageClass: "{{.SCRATCH_SPACE_STORAGE_CLASS}}"
+{{- end}}
+{{- if .VM_STATE_STORAGE_CLASS}}
+  vmStateStorageClass: "{{.VM_STATE_STORAGE_CLASS}}"
+{{- end}}`
 
 const cnvHPPManifestTemplate = `apiVersion: hostpathprovisioner.kubevirt.io/v1beta1
 kind: HostPathProvisioner
@@ -204,7 +223,7 @@ spec:
   storagePools:
     - name: sno
       pvcTemplate:
-        storageClassName: localblock-sc
+        storageClassName: "{{.STORAGE_CLASS_NAME}}"
         volumeMode: Block
         accessModes:
         - ReadWriteOnce
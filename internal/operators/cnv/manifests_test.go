@@ -13,6 +13,39 @@ import (
 var _ = Describe("CNV manifest generation", func() {
 	operator := NewCNVOperator(common.GetTestLog(), Config{Mode: true, SNOInstallHPP: true})
 
+	Context("CNV properties", func() {
+		It("sets the storage classes of the HyperConverged", func() {
+			monitoredOperator := Operator
+			monitoredOperator.Properties = `{"scratch_space_storage_class": "lvms-vg1", "vm_state_storage_class": "ocs-storagecluster-ceph-rbd"}`
+			cluster := common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion:   "4.16.0",
+				ControlPlaneCount:  common.MinMasterHostsNeededForInstallationInHaMode,
+				MonitoredOperators: []*models.MonitoredOperator{&monitoredOperator},
+			}}
+
+			_, manifest, err := operator.GenerateManifests(&cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var hco map[string]interface{}
+			Expect(yaml.Unmarshal(manifest, &hco)).To(Succeed())
+			Expect(hco["spec"]).To(And(
+				HaveKeyWithValue("scratchSpaceStorageClass", "lvms-vg1"),
+				HaveKeyWithValue("vmStateStorageClass", "ocs-storagecluster-ceph-rbd"),
+			))
+		})
+
+		It("keeps the default storage classes when the properties aren't set", func() {
+			cluster := common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion:  "4.16.0",
+				ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode,
+			}}
+
+			_, manifest, err := operator.GenerateManifests(&cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(manifest)).ToNot(ContainSubstring("StorageClass"))
+		})
+	})
+
 	Context("CNV Manifest", func() {
 		table.DescribeTable("Should create manifestes", func(cluster common.Cluster, isSno bool, cfg Config) {
 			cnvOperator := NewCNVOperator(common.GetTestLog(), cfg)
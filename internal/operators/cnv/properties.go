@@ -0,0 +1,35 @@
+package cnv
+
+import (
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+// cnvProperties are the values that the user can set to configure the HyperConverged created for the cluster
+type cnvProperties struct {
+	ScratchSpaceStorageClass string `json:"scratch_space_storage_class"`
+	VMStateStorageClass      string `json:"vm_state_storage_class"`
+}
+
+var propertiesDescription = models.OperatorProperties{
+	{
+		Name:        "scratch_space_storage_class",
+		DataType:    models.OperatorPropertyDataTypeString,
+		Description: "Storage class used for the scratch space needed to import virtual machine images, the default storage class when empty",
+		Pattern:     "^$|" + operatorscommon.ResourceNamePattern,
+	},
+	{
+		Name:        "vm_state_storage_class",
+		DataType:    models.OperatorPropertyDataTypeString,
+		Description: "Storage class used for the persistent state of the virtual machines, like their TPM and EFI, the default storage class when empty",
+		Pattern:     "^$|" + operatorscommon.ResourceNamePattern,
+	},
+}
+
+// getProperties returns the properties set by the user for the cluster
+func getProperties(cluster *common.Cluster) (cnvProperties, error) {
+	ret := cnvProperties{}
+	err := operatorscommon.UnmarshalProperties(cluster.MonitoredOperators, Operator.Name, &ret)
+	return ret, err
+}
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"fmt"
+
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -25,3 +27,41 @@ var Bundles = []*models.Bundle{
 	BundleVirtualization,
 	BundleOpenShiftAI,
 }
+
+// customBundles are the bundles defined by the service administrator, in addition to the builtin ones
+var customBundles []*models.Bundle
+
+// RegisterBundle adds a bundle defined by the service administrator to the valid bundles, replacing the one
+// registered before with the same identifier. The builtin bundles can't be replaced.
+func RegisterBundle(bundle *models.Bundle) error {
+	for _, builtinBundle := range Bundles {
+		if builtinBundle.ID == bundle.ID {
+			return fmt.Errorf("bundle %s conflicts with a builtin bundle", bundle.ID)
+		}
+	}
+	for i, customBundle := range customBundles {
+		if customBundle.ID == bundle.ID {
+			customBundles[i] = bundle
+			return nil
+		}
+	}
+	customBundles = append(customBundles, bundle)
+	return nil
+}
+
+// AllBundles returns the builtin bundles followed by the bundles defined by the service administrator
+func AllBundles() []*models.Bundle {
+	ret := make([]*models.Bundle, 0, len(Bundles)+len(customBundles))
+	ret = append(ret, Bundles...)
+	return append(ret, customBundles...)
+}
+
+// IsValidBundle checks if the given identifier is the one of a builtin bundle or of a registered bundle
+func IsValidBundle(bundleID string) bool {
+	for _, bundle := range AllBundles() {
+		if bundle.ID == bundleID {
+			return true
+		}
+	}
+	return false
+}
@@ -30,6 +30,19 @@ func NonInstallationDiskCount(disks []*models.Disk, installationDiskID string, m
 	return eligibleDisks, availableDisks
 }
 
+// StorageDisks returns the SSD and HDD disks of at least the given size, the installation disk must already be
+// excluded from the disks. The returned list is empty, not nil, when there are no such disks.
+func StorageDisks(disks []*models.Disk, minSizeGB int64) []*models.Disk {
+	ret := []*models.Disk{}
+	for _, disk := range disks {
+		if (disk.DriveType == models.DriveTypeSSD || disk.DriveType == models.DriveTypeHDD) && disk.SizeBytes != 0 &&
+			disk.SizeBytes >= conversions.GbToBytes(minSizeGB) {
+			ret = append(ret, disk)
+		}
+	}
+	return ret
+}
+
 func HasOperator(operators []*models.MonitoredOperator, operatorName string) bool {
 	for _, o := range operators {
 		if o.Name == operatorName {
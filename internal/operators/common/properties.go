@@ -0,0 +1,75 @@
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// ResourceNamePattern matches the names of the Kubernetes resources, like storage classes, i.e. DNS-1123 subdomains
+const ResourceNamePattern = `^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+
+// PropertiesSchema returns the JSON schema of the properties of an operator. The properties are set as a JSON object
+// that can only contain the described properties, within their bounds and matching their pattern.
+func PropertiesSchema(properties models.OperatorProperties) *spec.Schema {
+	schema := &spec.Schema{}
+	schema.Typed("object", "")
+	schema.AdditionalProperties = &spec.SchemaOrBool{Allows: false}
+	schema.Properties = make(map[string]spec.Schema)
+	for _, property := range properties {
+		var propertySchema *spec.Schema
+		switch property.DataType {
+		case models.OperatorPropertyDataTypeBoolean:
+			propertySchema = spec.BooleanProperty()
+		case models.OperatorPropertyDataTypeInteger:
+			propertySchema = spec.Int64Property()
+			propertySchema.Minimum, propertySchema.Maximum = property.Minimum, property.Maximum
+		case models.OperatorPropertyDataTypeFloat:
+			propertySchema = spec.Float64Property()
+			propertySchema.Minimum, propertySchema.Maximum = property.Minimum, property.Maximum
+		case models.OperatorPropertyDataTypeArray:
+			propertySchema = spec.ArrayProperty(spec.StringProperty().WithPattern(property.Pattern))
+		default:
+			propertySchema = spec.StringProperty().WithPattern(property.Pattern)
+			for _, option := range property.Options {
+				propertySchema.Enum = append(propertySchema.Enum, option)
+			}
+		}
+		schema.Properties[property.Name] = *propertySchema.WithDescription(property.Description)
+		if property.Mandatory {
+			schema.Required = append(schema.Required, property.Name)
+		}
+	}
+	return schema
+}
+
+// ValidateProperties verifies that the properties set by the user match the properties described by the operator
+func ValidateProperties(properties models.OperatorProperties, value string) error {
+	if value == "" {
+		value = "{}"
+	}
+	var data interface{}
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return errors.Wrap(err, "properties must be a JSON object")
+	}
+	return validate.AgainstSchema(PropertiesSchema(properties), data, strfmt.Default)
+}
+
+// UnmarshalProperties reads the properties that the user set for an operator into target. The target is left
+// untouched when the operator isn't part of the given operators or when its properties aren't set, so it should
+// contain the default values.
+func UnmarshalProperties(operators []*models.MonitoredOperator, operatorName string, target interface{}) error {
+	for _, operator := range operators {
+		if operator.Name != operatorName || operator.Properties == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(operator.Properties), target); err != nil {
+			return errors.Wrapf(err, "failed to parse the properties of operator %s", operatorName)
+		}
+	}
+	return nil
+}
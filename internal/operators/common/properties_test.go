@@ -0,0 +1,82 @@
+package common_test
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Operator properties", func() {
+	properties := models.OperatorProperties{
+		{Name: "name", DataType: models.OperatorPropertyDataTypeString, Mandatory: true},
+		{Name: "mode", DataType: models.OperatorPropertyDataTypeString, Options: []string{"fast", "safe"}},
+		{Name: "enabled", DataType: models.OperatorPropertyDataTypeBoolean},
+		{Name: "count", DataType: models.OperatorPropertyDataTypeInteger, Minimum: swag.Float64(1), Maximum: swag.Float64(10)},
+		{Name: "ratio", DataType: models.OperatorPropertyDataTypeFloat, Minimum: swag.Float64(0)},
+		{Name: "paths", DataType: models.OperatorPropertyDataTypeArray, Pattern: `^/dev/[a-z]+$`},
+		{Name: "class", DataType: models.OperatorPropertyDataTypeString, Pattern: common.ResourceNamePattern},
+	}
+
+	DescribeTable("validation",
+		func(value string, valid bool) {
+			err := common.ValidateProperties(properties, value)
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("all the properties", `{"name": "a", "mode": "safe", "enabled": true, "count": 3, "ratio": 0.5, "paths": ["/dev/sdb"]}`, true),
+		Entry("only the mandatory properties", `{"name": "a"}`, true),
+		Entry("missing mandatory property", `{"count": 3}`, false),
+		Entry("empty value with a mandatory property", "", false),
+		Entry("not a JSON object", "blob-info", false),
+		Entry("unknown property", `{"name": "a", "other": 1}`, false),
+		Entry("value that isn't one of the options", `{"name": "a", "mode": "slow"}`, false),
+		Entry("wrong type", `{"name": "a", "count": "3"}`, false),
+		Entry("float instead of an integer", `{"name": "a", "count": 3.5}`, false),
+		Entry("array items that aren't strings", `{"name": "a", "paths": [1]}`, false),
+		Entry("integer below the minimum", `{"name": "a", "count": 0}`, false),
+		Entry("integer above the maximum", `{"name": "a", "count": 11}`, false),
+		Entry("integer at the bounds", `{"name": "a", "count": 10}`, true),
+		Entry("negative float", `{"name": "a", "ratio": -0.5}`, false),
+		Entry("array item that doesn't match the pattern", `{"name": "a", "paths": ["/dev/sdb", "/dev/sdc'\n"]}`, false),
+		Entry("resource name", `{"name": "a", "class": "local-disks.example"}`, true),
+		Entry("resource name with a quote", `{"name": "a", "class": "local'disks"}`, false),
+		Entry("resource name with a new line", `{"name": "a", "class": "local\ndisks: x"}`, false),
+		Entry("resource name with capital letters", `{"name": "a", "class": "Local"}`, false),
+	)
+
+	Context("unmarshal", func() {
+		type target struct {
+			Name  string `json:"name"`
+			Count int64  `json:"count"`
+		}
+
+		It("reads the properties of the operator", func() {
+			result := target{Count: 1}
+			err := common.UnmarshalProperties([]*models.MonitoredOperator{
+				{Name: "other", Properties: `{"name": "b"}`},
+				{Name: "operator", Properties: `{"name": "a"}`},
+			}, "operator", &result)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(target{Name: "a", Count: 1}))
+		})
+
+		It("keeps the defaults when the properties aren't set", func() {
+			result := target{Count: 1}
+			err := common.UnmarshalProperties([]*models.MonitoredOperator{{Name: "operator"}}, "operator", &result)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(target{Count: 1}))
+		})
+
+		It("fails with invalid properties", func() {
+			result := target{}
+			err := common.UnmarshalProperties([]*models.MonitoredOperator{{Name: "operator", Properties: "blob-info"}}, "operator", &result)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,22 @@
+package day2
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+func TestDay2(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Day2 operators Suite")
+}
+
+var _ = BeforeSuite(func() {
+	common.InitializeDBTest()
+})
+
+var _ = AfterSuite(func() {
+	common.TerminateDBTest()
+})
@@ -0,0 +1,221 @@
+package day2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clusterVersionName = "version"
+
+	conditionAvailable   = "Available"
+	conditionDegraded    = "Degraded"
+	conditionFailing     = "Failing"
+	conditionProgressing = "Progressing"
+)
+
+var (
+	clusterOperatorGVK = schema.GroupVersionKind{Group: "config.openshift.io", Version: "v1", Kind: "ClusterOperator"}
+	clusterVersionGVK  = schema.GroupVersionKind{Group: "config.openshift.io", Version: "v1", Kind: "ClusterVersion"}
+)
+
+// MonitorOperatorsHealth checks the operators of the clusters that were installed less than the configured health
+// monitor duration ago. The OLM operators are checked with their cluster service versions and the builtin operators
+// with the conditions of their cluster operators. The status changes are saved in the health history of the
+// operators and a degradation sends a warning event.
+func (i *Installer) MonitorOperatorsHealth() {
+	if !i.leaderElector.IsLeader() {
+		i.log.Debugf("Not a leader, exiting MonitorOperatorsHealth")
+		return
+	}
+	if i.config.HealthMonitorDuration <= 0 {
+		return
+	}
+	ctx := context.Background()
+
+	var clusterIDs []strfmt.UUID
+	if err := i.db.Model(&common.Cluster{}).
+		Where("status = ? AND install_completed_at > ?", models.ClusterStatusInstalled, time.Now().Add(-i.config.HealthMonitorDuration)).
+		Pluck("id", &clusterIDs).Error; err != nil {
+		i.log.WithError(err).Error("failed to get the recently installed clusters")
+		return
+	}
+	if len(clusterIDs) == 0 {
+		return
+	}
+	var monitoredOperators []*models.MonitoredOperator
+	if err := i.db.Where("cluster_id IN ?", clusterIDs).Find(&monitoredOperators).Error; err != nil {
+		i.log.WithError(err).Error("failed to get the operators of the recently installed clusters")
+		return
+	}
+	// The day-2 operators that are still being installed are checked by MonitorOperators
+	monitoredOperators = lo.Filter(monitoredOperators, func(operator *models.MonitoredOperator, _ int) bool {
+		return !operator.Day2 || operator.Status != models.OperatorStatusProgressing
+	})
+	for clusterID, clusterOperators := range lo.GroupBy(monitoredOperators, func(operator *models.MonitoredOperator) strfmt.UUID {
+		return operator.ClusterID
+	}) {
+		client, err := i.getSpokeClient(ctx, clusterID)
+		if err != nil {
+			i.log.WithError(err).Warnf("failed to check the health of the operators of cluster %s", clusterID)
+			continue
+		}
+		for _, operator := range clusterOperators {
+			if err = i.checkOperatorHealth(ctx, client, operator); err != nil {
+				i.log.WithError(err).Warnf("failed to check the health of operator %s of cluster %s", operator.Name, clusterID)
+			}
+		}
+		client.Close()
+	}
+}
+
+func (i *Installer) checkOperatorHealth(ctx context.Context, client ctrlclient.Client, operator *models.MonitoredOperator) error {
+	var (
+		status     models.OperatorStatus
+		statusInfo string
+		err        error
+	)
+	if operator.OperatorType == models.OperatorTypeOlm {
+		status, statusInfo, err = getCSVHealth(ctx, client, operator)
+	} else {
+		status, statusInfo, err = getClusterOperatorHealth(ctx, client, operator)
+	}
+	if err != nil || status == "" || status == operator.Status {
+		return err
+	}
+
+	now := strfmt.DateTime(time.Now())
+	err = i.db.Transaction(func(tx *gorm.DB) error {
+		txErr := tx.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", operator.ClusterID, operator.Name).
+			Updates(map[string]interface{}{
+				"status":            status,
+				"status_info":       statusInfo,
+				"status_updated_at": now,
+			}).Error
+		if txErr != nil {
+			return errors.Wrapf(txErr, "failed to update the status of operator %s", operator.Name)
+		}
+		record := &models.MonitoredOperatorHealthRecord{
+			ClusterID:    operator.ClusterID,
+			OperatorName: operator.Name,
+			Status:       status,
+			StatusInfo:   statusInfo,
+			ReportedAt:   now,
+		}
+		if txErr = tx.Create(record).Error; txErr != nil {
+			return errors.Wrapf(txErr, "failed to save the health history of operator %s", operator.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if status == models.OperatorStatusAvailable {
+		eventgen.SendClusterOperatorStatusEvent(ctx, i.eventsHandler, operator.ClusterID, operator.Name, string(status), statusInfo)
+	} else {
+		eventgen.SendClusterOperatorDegradedEvent(ctx, i.eventsHandler, operator.ClusterID, operator.Name, string(status), statusInfo)
+	}
+	return nil
+}
+
+// getCSVHealth returns the status of an OLM operator from the phase of its cluster service version, the status is
+// empty when the cluster service version isn't installed
+func getCSVHealth(ctx context.Context, client ctrlclient.Client, operator *models.MonitoredOperator) (models.OperatorStatus, string, error) {
+	csv, err := getInstalledCSV(ctx, client, operator)
+	if err != nil || csv == nil {
+		return "", "", err
+	}
+	phase, _, _ := unstructured.NestedString(csv.Object, "status", "phase")
+	message, _, _ := unstructured.NestedString(csv.Object, "status", "message")
+	switch phase {
+	case csvPhaseSucceeded:
+		return models.OperatorStatusAvailable, message, nil
+	case csvPhaseFailed:
+		return models.OperatorStatusFailed, message, nil
+	default:
+		return models.OperatorStatusProgressing, fmt.Sprintf("cluster service version is %s: %s", phase, message), nil
+	}
+}
+
+// getClusterOperatorHealth returns the status of a builtin operator from the conditions of its cluster operator, the
+// cluster version operator is reported by the cluster version
+func getClusterOperatorHealth(ctx context.Context, client ctrlclient.Client, operator *models.MonitoredOperator) (models.OperatorStatus, string, error) {
+	object := &unstructured.Unstructured{}
+	key := types.NamespacedName{Name: operator.Name}
+	degradedCondition := conditionDegraded
+	if operator.Name == operators.OperatorCVO.Name {
+		object.SetGroupVersionKind(clusterVersionGVK)
+		key.Name = clusterVersionName
+		degradedCondition = conditionFailing
+	} else {
+		object.SetGroupVersionKind(clusterOperatorGVK)
+	}
+	if err := client.Get(ctx, key, object); err != nil {
+		return "", "", errors.Wrapf(err, "failed to get the %s %s", object.GetKind(), key.Name)
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+	if status, message := findCondition(conditions, degradedCondition); status == "True" {
+		return models.OperatorStatusFailed, message, nil
+	}
+	if status, message := findCondition(conditions, conditionAvailable); status == "False" {
+		return models.OperatorStatusFailed, message, nil
+	}
+	if status, message := findCondition(conditions, conditionProgressing); status == "True" {
+		return models.OperatorStatusProgressing, message, nil
+	}
+	_, message := findCondition(conditions, conditionAvailable)
+	return models.OperatorStatusAvailable, message, nil
+}
+
+// findCondition returns the status and the message of a condition of a cluster operator, both empty when the
+// condition isn't set
+func findCondition(conditions []interface{}, conditionType string) (string, string) {
+	for _, condition := range conditions {
+		fields, ok := condition.(map[string]interface{})
+		if !ok || fields["type"] != conditionType {
+			continue
+		}
+		status, _ := fields["status"].(string)
+		message, _ := fields["message"].(string)
+		return status, message
+	}
+	return "", ""
+}
+
+// GetOperatorsHealthHistory returns the health history of the operators of a cluster, oldest first, optionally only
+// of a single operator
+func (i *Installer) GetOperatorsHealthHistory(ctx context.Context, clusterID strfmt.UUID, operatorName string) (models.MonitoredOperatorHealthHistory, error) {
+	if _, err := common.GetClusterFromDB(i.db, clusterID, common.SkipEagerLoading); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	query := i.db.Where("cluster_id = ?", clusterID)
+	if operatorName != "" {
+		query = query.Where("operator_name = ?", operatorName)
+	}
+	history := models.MonitoredOperatorHealthHistory{}
+	if err := query.Order("reported_at, id").Find(&history).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to get the health history of the operators of cluster %s", clusterID))
+	}
+	return history, nil
+}
@@ -0,0 +1,284 @@
+package day2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/constants"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	// customManifestsFolder is the folder of the cluster, in the object storage, that contains the custom manifests
+	// of the operators that are applied once the operators are available
+	customManifestsFolder = "day2-operators"
+
+	installingStatusInfo = "Installing"
+)
+
+type Config struct {
+	MonitorInterval time.Duration `envconfig:"DAY2_OPERATORS_MONITOR_INTERVAL" default:"30s"`
+	// HealthMonitorInterval is the interval between the checks of the health of the operators of installed clusters
+	HealthMonitorInterval time.Duration `envconfig:"OPERATORS_HEALTH_MONITOR_INTERVAL" default:"5m"`
+	// HealthMonitorDuration is how long the health of the operators is checked after the installation of the
+	// cluster, the health isn't checked when it is zero
+	HealthMonitorDuration time.Duration `envconfig:"OPERATORS_HEALTH_MONITOR_DURATION" default:"0s"`
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=day2 -destination=mock_day2_api.go . API
+type API interface {
+	// InstallOperators installs operators, and the operators they depend on, in an installed cluster
+	InstallOperators(ctx context.Context, clusterID strfmt.UUID, params []*models.OperatorCreateParams) (models.MonitoredOperatorsList, error)
+	// MonitorOperators updates the status of the operators that are being installed in installed clusters
+	MonitorOperators()
+	// MonitorOperatorsHealth updates the status of the operators of recently installed clusters and records the
+	// changes in their health history
+	MonitorOperatorsHealth()
+	// GetOperatorsHealthHistory returns the changes of the status of the operators of a cluster that were observed
+	// after its installation
+	GetOperatorsHealthHistory(ctx context.Context, clusterID strfmt.UUID, operatorName string) (models.MonitoredOperatorHealthHistory, error)
+}
+
+// Installer installs OLM operators in clusters that are already installed. The manifests are applied with the
+// kubeconfig of the cluster and the progress is tracked in the status of the monitored operators. It also keeps
+// tracking the health of all the operators of the cluster for a while after the installation of the cluster.
+type Installer struct {
+	config             Config
+	log                logrus.FieldLogger
+	db                 *gorm.DB
+	operatorsAPI       operators.API
+	hwValidator        hardware.Validator
+	objectHandler      s3wrapper.API
+	spokeClientFactory spoke_k8s_client.SpokeK8sClientFactory
+	eventsHandler      eventsapi.Handler
+	leaderElector      leader.Leader
+}
+
+// NewInstaller creates a new day-2 operators installer
+func NewInstaller(config Config, log logrus.FieldLogger, db *gorm.DB, operatorsAPI operators.API, hwValidator hardware.Validator,
+	objectHandler s3wrapper.API, spokeClientFactory spoke_k8s_client.SpokeK8sClientFactory, eventsHandler eventsapi.Handler,
+	leaderElector leader.Leader) *Installer {
+	return &Installer{
+		config:             config,
+		log:                log,
+		db:                 db,
+		operatorsAPI:       operatorsAPI,
+		hwValidator:        hwValidator,
+		objectHandler:      objectHandler,
+		spokeClientFactory: spokeClientFactory,
+		eventsHandler:      eventsHandler,
+		leaderElector:      leaderElector,
+	}
+}
+
+// InstallOperators installs the requested operators, and the operators they depend on, in an installed cluster.
+// The host requirements of the operators are validated against the inventories of the hosts of the cluster before
+// the manifests are applied.
+func (i *Installer) InstallOperators(ctx context.Context, clusterID strfmt.UUID, params []*models.OperatorCreateParams) (models.MonitoredOperatorsList, error) {
+	log := logutil.FromContext(ctx, i.log)
+
+	cluster, err := common.GetClusterFromDB(i.db, clusterID, common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if swag.StringValue(cluster.Status) != models.ClusterStatusInstalled {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("operators can only be added to installed clusters, cluster %s is %s", clusterID, swag.StringValue(cluster.Status)))
+	}
+
+	newOperators, err := i.getNewOperators(cluster, params)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	// The operators are validated together with the operators that are already installed
+	day2Cluster := *cluster
+	day2Cluster.MonitoredOperators = append(append([]*models.MonitoredOperator{}, cluster.MonitoredOperators...), newOperators...)
+	if err = i.operatorsAPI.EnsureOperatorPrerequisite(&day2Cluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, day2Cluster.MonitoredOperators); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = i.validateHosts(ctx, &day2Cluster, newOperators); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	openshiftManifests, customManifests, err := i.operatorsAPI.GenerateDay2Manifests(&day2Cluster, newOperators)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	client, err := i.getSpokeClient(ctx, clusterID)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	defer client.Close()
+	for name, content := range customManifests {
+		if err = i.objectHandler.Upload(ctx, content, customManifestsObjectName(clusterID, name)); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError,
+				errors.Wrapf(err, "failed to upload the custom manifests of operator %s", name))
+		}
+	}
+
+	now := strfmt.DateTime(time.Now())
+	for _, operator := range newOperators {
+		operator.ClusterID = clusterID
+		operator.Status = models.OperatorStatusProgressing
+		operator.StatusInfo = installingStatusInfo
+		operator.StatusUpdatedAt = now
+		operator.Day2 = true
+	}
+	// The manifests are applied before the operators are saved, so the operators are only monitored once their
+	// manifests are applied. Applying the manifests is idempotent, the installation can be retried when it fails.
+	if err = applyManifests(ctx, client, openshiftManifests); err != nil {
+		log.WithError(err).Errorf("failed to install operators in cluster %s", clusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = i.db.Create(&newOperators).Error; err != nil {
+		log.WithError(err).Errorf("failed to save the operators of cluster %s", clusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to save the operators of cluster %s", clusterID))
+	}
+
+	names := operatorNames(newOperators)
+	log.Infof("Started the installation of operators %s in cluster %s", strings.Join(names, ", "), clusterID)
+	eventgen.SendClusterDay2OperatorsInstallationStartedEvent(ctx, i.eventsHandler, clusterID, strings.Join(names, ", "))
+	return newOperators, nil
+}
+
+// getNewOperators returns the requested operators and the dependencies that aren't installed in the cluster yet
+func (i *Installer) getNewOperators(cluster *common.Cluster, params []*models.OperatorCreateParams) ([]*models.MonitoredOperator, error) {
+	if len(params) == 0 {
+		return nil, errors.New("no operators to install")
+	}
+	requested := make([]*models.MonitoredOperator, 0, len(params))
+	for _, param := range params {
+		if operatorscommon.HasOperator(cluster.MonitoredOperators, param.Name) {
+			return nil, errors.Errorf("operator %s is already installed in cluster %s", param.Name, cluster.ID)
+		}
+		if operatorscommon.HasOperator(requested, param.Name) {
+			return nil, errors.Errorf("operator %s is requested more than once", param.Name)
+		}
+		operator, err := i.operatorsAPI.GetOperatorByName(param.Name)
+		if err != nil {
+			return nil, err
+		}
+		if operator.OperatorType != models.OperatorTypeOlm {
+			return nil, errors.Errorf("operator %s can't be installed in an installed cluster", param.Name)
+		}
+		operator.Properties = param.Properties
+		operator.Channel = param.Channel
+		operator.StartingCsv = param.StartingCsv
+		operator.InstallPlanApproval = param.InstallPlanApproval
+		requested = append(requested, operator)
+	}
+
+	resolved, err := i.operatorsAPI.ResolveDependencies(cluster, requested)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Filter(resolved, func(operator *models.MonitoredOperator, _ int) bool {
+		return !operatorscommon.HasOperator(cluster.MonitoredOperators, operator.Name)
+	}), nil
+}
+
+// validateHosts checks that the hosts of the cluster have the resources needed by all the operators of the cluster
+// and that they pass the host validations of the new operators
+func (i *Installer) validateHosts(ctx context.Context, cluster *common.Cluster, newOperators []*models.MonitoredOperator) error {
+	// Only the new operators are validated, the validations of the installed operators may no longer pass because
+	// the operators already use the resources of the hosts, the disks for example
+	newOperatorsCluster := *cluster
+	newOperatorsCluster.MonitoredOperators = newOperators
+
+	var failures []string
+	for _, h := range cluster.Hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the inventory of host %s", h.ID)
+		}
+		hostname := hostutil.GetHostnameForMsg(h)
+
+		requirements, err := i.hwValidator.GetClusterHostRequirements(ctx, cluster, h)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the requirements of host %s", hostname)
+		}
+		if inventory.CPU != nil && inventory.CPU.Count < requirements.Total.CPUCores {
+			failures = append(failures, fmt.Sprintf("host %s requires at least %d CPU cores, found only %d",
+				hostname, requirements.Total.CPUCores, inventory.CPU.Count))
+		}
+		requiredBytes := conversions.MibToBytes(requirements.Total.RAMMib)
+		if inventory.Memory != nil && inventory.Memory.PhysicalBytes < requiredBytes-conversions.MibToBytes(host.HostMemoryRequirementToleranceMiB) {
+			failures = append(failures, fmt.Sprintf("host %s requires at least %s RAM, found only %s", hostname,
+				conversions.BytesToString(requiredBytes), conversions.BytesToString(inventory.Memory.PhysicalBytes)))
+		}
+
+		results, err := i.operatorsAPI.ValidateHost(ctx, &newOperatorsCluster, h)
+		if err != nil {
+			return errors.Wrapf(err, "failed to validate host %s", hostname)
+		}
+		for _, result := range results {
+			if result.Status == api.Failure {
+				failures = append(failures, fmt.Sprintf("host %s: %s", hostname, strings.Join(result.Reasons, ", ")))
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return errors.Errorf("the hosts of the cluster don't satisfy the requirements of the operators: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// getSpokeClient creates a client for the installed cluster from the kubeconfig that was stored when the cluster
+// was installed
+func (i *Installer) getSpokeClient(ctx context.Context, clusterID strfmt.UUID) (spoke_k8s_client.SpokeK8sClient, error) {
+	reader, _, err := i.objectHandler.Download(ctx, path.Join(clusterID.String(), constants.KubeconfigNoIngress))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download the kubeconfig of cluster %s", clusterID)
+	}
+	defer reader.Close()
+	kubeconfig, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the kubeconfig of cluster %s", clusterID)
+	}
+	client, err := i.spokeClientFactory.CreateFromKubeconfig(kubeconfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create a client for cluster %s", clusterID)
+	}
+	return client, nil
+}
+
+func customManifestsObjectName(clusterID strfmt.UUID, operatorName string) string {
+	return path.Join(clusterID.String(), customManifestsFolder, operatorName+".yaml")
+}
+
+func operatorNames(operators []*models.MonitoredOperator) []string {
+	return lo.Map(operators, func(operator *models.MonitoredOperator, _ int) string { return operator.Name })
+}
@@ -0,0 +1,480 @@
+package day2
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/lso"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const subscriptionManifest = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: local-storage-operator
+  namespace: openshift-local-storage
+spec:
+  name: local-storage-operator
+`
+
+const namespaceManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: openshift-local-storage
+`
+
+var _ = Describe("Day2 operators installer", func() {
+	var (
+		ctx                    = context.Background()
+		db                     *gorm.DB
+		dbName                 string
+		ctrl                   *gomock.Controller
+		mockOperatorsAPI       *operators.MockAPI
+		mockHwValidator        *hardware.MockValidator
+		mockObjectHandler      *s3wrapper.MockAPI
+		mockSpokeClientFactory *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockSpokeClient        *spoke_k8s_client.MockSpokeK8sClient
+		mockEvents             *eventsapi.MockHandler
+		installer              *Installer
+		cluster                *common.Cluster
+		clusterID              strfmt.UUID
+	)
+
+	newLSO := func() *models.MonitoredOperator {
+		return &models.MonitoredOperator{
+			Name:             lso.Operator.Name,
+			Namespace:        lso.Operator.Namespace,
+			SubscriptionName: lso.Operator.SubscriptionName,
+			OperatorType:     models.OperatorTypeOlm,
+			TimeoutSeconds:   lso.Operator.TimeoutSeconds,
+		}
+	}
+
+	mockKubeconfig := func() {
+		mockObjectHandler.EXPECT().Download(gomock.Any(), clusterID.String()+"/kubeconfig-noingress").
+			Return(io.NopCloser(strings.NewReader("kubeconfig")), int64(10), nil).Times(1)
+		mockSpokeClientFactory.EXPECT().CreateFromKubeconfig([]byte("kubeconfig")).Return(mockSpokeClient, nil).Times(1)
+		mockSpokeClient.EXPECT().Close().Times(1)
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockOperatorsAPI = operators.NewMockAPI(ctrl)
+		mockHwValidator = hardware.NewMockValidator(ctrl)
+		mockObjectHandler = s3wrapper.NewMockAPI(ctrl)
+		mockSpokeClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(ctrl)
+		mockSpokeClient = spoke_k8s_client.NewMockSpokeK8sClient(ctrl)
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		installer = NewInstaller(Config{HealthMonitorDuration: time.Hour}, logrus.New(), db, mockOperatorsAPI, mockHwValidator, mockObjectHandler, mockSpokeClientFactory,
+			mockEvents, &leader.DummyElector{})
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Status:           swag.String(models.ClusterStatusInstalled),
+			OpenshiftVersion: "4.16.0",
+			CPUArchitecture:  models.ClusterCPUArchitectureX8664,
+		}}
+		Expect(db.Create(cluster).Error).ToNot(HaveOccurred())
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Host{
+			ID:         &hostID,
+			ClusterID:  &clusterID,
+			InfraEnvID: clusterID,
+			Role:       models.HostRoleMaster,
+			Inventory:  common.GenerateTestInventory(),
+		}).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("InstallOperators", func() {
+		var params []*models.OperatorCreateParams
+
+		BeforeEach(func() {
+			params = []*models.OperatorCreateParams{{Name: lso.Operator.Name}}
+		})
+
+		expectApiError := func(err error, status int32, message string) {
+			ExpectWithOffset(1, err).To(HaveOccurred())
+			apiErr, ok := err.(*common.ApiErrorResponse)
+			ExpectWithOffset(1, ok).To(BeTrue())
+			ExpectWithOffset(1, apiErr.StatusCode()).To(Equal(status))
+			ExpectWithOffset(1, apiErr.Error()).To(ContainSubstring(message))
+		}
+
+		mockRequirements := func(cpuCores, ramMib int64) {
+			mockHwValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).Return(&models.ClusterHostRequirements{
+				Total: &models.ClusterHostRequirementsDetails{CPUCores: cpuCores, RAMMib: ramMib},
+			}, nil).Times(1)
+		}
+
+		It("installs the operator in the cluster", func() {
+			mockOperatorsAPI.EXPECT().GetOperatorByName(lso.Operator.Name).Return(newLSO(), nil).Times(1)
+			mockOperatorsAPI.EXPECT().ResolveDependencies(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
+					return operators, nil
+				}).Times(1)
+			mockOperatorsAPI.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), "4.16.0", models.ClusterCPUArchitectureX8664, gomock.Any()).Return(nil).Times(1)
+			mockRequirements(8, 8192)
+			mockOperatorsAPI.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).Return([]api.ValidationResult{{Status: api.Success}}, nil).Times(1)
+			mockOperatorsAPI.EXPECT().GenerateDay2Manifests(gomock.Any(), gomock.Any()).Return(
+				map[string][]byte{
+					"50_openshift-lso_subscription.yaml": []byte(subscriptionManifest),
+					"50_openshift-lso_ns.yaml":           []byte(namespaceManifest),
+				},
+				map[string][]byte{lso.Operator.Name: []byte("local volume set")},
+				nil,
+			).Times(1)
+			mockKubeconfig()
+			mockObjectHandler.EXPECT().Upload(gomock.Any(), []byte("local volume set"), clusterID.String()+"/day2-operators/lso.yaml").Return(nil).Times(1)
+
+			var kinds []string
+			mockSpokeClient.EXPECT().Patch(gomock.Any(), gomock.Any(), ctrlclient.Apply, gomock.Any()).DoAndReturn(
+				func(_ context.Context, object ctrlclient.Object, _ ctrlclient.Patch, _ ...ctrlclient.PatchOption) error {
+					kinds = append(kinds, object.GetObjectKind().GroupVersionKind().Kind)
+					return nil
+				}).Times(2)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterDay2OperatorsInstallationStartedEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+
+			installed, err := installer.InstallOperators(ctx, clusterID, params)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(installed).To(HaveLen(1))
+			Expect(installed[0].Status).To(Equal(models.OperatorStatusProgressing))
+			Expect(installed[0].Day2).To(BeTrue())
+			Expect(kinds).To(Equal([]string{"Namespace", "Subscription"}))
+
+			var saved models.MonitoredOperator
+			Expect(db.First(&saved, "cluster_id = ? and name = ?", clusterID, lso.Operator.Name).Error).ToNot(HaveOccurred())
+			Expect(saved.Day2).To(BeTrue())
+			Expect(saved.Status).To(Equal(models.OperatorStatusProgressing))
+		})
+
+		It("doesn't save the operators when the manifests can't be applied", func() {
+			mockOperatorsAPI.EXPECT().GetOperatorByName(lso.Operator.Name).Return(newLSO(), nil).Times(1)
+			mockOperatorsAPI.EXPECT().ResolveDependencies(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
+					return operators, nil
+				}).Times(1)
+			mockOperatorsAPI.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockRequirements(8, 8192)
+			mockOperatorsAPI.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			mockOperatorsAPI.EXPECT().GenerateDay2Manifests(gomock.Any(), gomock.Any()).Return(
+				map[string][]byte{"50_openshift-lso_subscription.yaml": []byte(subscriptionManifest)}, nil, nil).Times(1)
+			mockKubeconfig()
+			mockSpokeClient.EXPECT().Patch(gomock.Any(), gomock.Any(), ctrlclient.Apply, gomock.Any()).Return(errors.New("connection refused")).Times(1)
+
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+
+			expectApiError(err, http.StatusInternalServerError, "connection refused")
+			var count int64
+			Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ?", clusterID).Count(&count).Error).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
+		It("fails when the cluster isn't installed", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).Update("status", models.ClusterStatusInstalling).Error).ToNot(HaveOccurred())
+
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+
+			expectApiError(err, http.StatusConflict, "operators can only be added to installed clusters")
+		})
+
+		It("fails when the operator is already installed", func() {
+			operator := newLSO()
+			operator.ClusterID = clusterID
+			Expect(db.Create(operator).Error).ToNot(HaveOccurred())
+
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+
+			expectApiError(err, http.StatusBadRequest, "operator lso is already installed")
+		})
+
+		It("fails when the operator isn't an OLM operator", func() {
+			mockOperatorsAPI.EXPECT().GetOperatorByName("console").Return(&models.MonitoredOperator{
+				Name: "console", OperatorType: models.OperatorTypeBuiltin}, nil).Times(1)
+
+			_, err := installer.InstallOperators(ctx, clusterID, []*models.OperatorCreateParams{{Name: "console"}})
+
+			expectApiError(err, http.StatusBadRequest, "operator console can't be installed in an installed cluster")
+		})
+
+		It("fails when the hosts don't have enough resources", func() {
+			mockOperatorsAPI.EXPECT().GetOperatorByName(lso.Operator.Name).Return(newLSO(), nil).Times(1)
+			mockOperatorsAPI.EXPECT().ResolveDependencies(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
+					return operators, nil
+				}).Times(1)
+			mockOperatorsAPI.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockRequirements(32, 64*1024)
+			mockOperatorsAPI.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).Return([]api.ValidationResult{{
+				Status:  api.Failure,
+				Reasons: []string{"no eligible disks"},
+			}}, nil).Times(1)
+
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+
+			expectApiError(err, http.StatusBadRequest, "requires at least 32 CPU cores, found only 16")
+			Expect(err.Error()).To(ContainSubstring("requires at least " + conversions.BytesToString(conversions.MibToBytes(64*1024)) + " RAM"))
+			Expect(err.Error()).To(ContainSubstring("no eligible disks"))
+		})
+	})
+
+	Context("MonitorOperators", func() {
+		var operator *models.MonitoredOperator
+
+		BeforeEach(func() {
+			operator = newLSO()
+			operator.ClusterID = clusterID
+			operator.Status = models.OperatorStatusProgressing
+			operator.StatusUpdatedAt = strfmt.DateTime(time.Now())
+			operator.Day2 = true
+			Expect(db.Create(operator).Error).ToNot(HaveOccurred())
+		})
+
+		mockCSV := func(installedCSV, phase string) {
+			mockSpokeClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, list ctrlclient.ObjectList, _ ...ctrlclient.ListOption) error {
+					subscription := unstructured.Unstructured{Object: map[string]interface{}{}}
+					Expect(unstructured.SetNestedField(subscription.Object, lso.Operator.SubscriptionName, "spec", "name")).To(Succeed())
+					Expect(unstructured.SetNestedField(subscription.Object, installedCSV, "status", "installedCSV")).To(Succeed())
+					list.(*unstructured.UnstructuredList).Items = []unstructured.Unstructured{subscription}
+					return nil
+				}).Times(1)
+			if installedCSV == "" {
+				return
+			}
+			mockSpokeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ ctrlclient.ObjectKey, object ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+					csv := object.(*unstructured.Unstructured)
+					Expect(unstructured.SetNestedField(csv.Object, phase, "status", "phase")).To(Succeed())
+					Expect(unstructured.SetNestedField(csv.Object, "4.16.0", "spec", "version")).To(Succeed())
+					return nil
+				}).Times(1)
+		}
+
+		expectStatus := func(status models.OperatorStatus) *models.MonitoredOperator {
+			var saved models.MonitoredOperator
+			ExpectWithOffset(1, db.First(&saved, "cluster_id = ? and name = ?", clusterID, operator.Name).Error).ToNot(HaveOccurred())
+			ExpectWithOffset(1, saved.Status).To(Equal(status))
+			return &saved
+		}
+
+		expectStatusEvent := func() {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+		}
+
+		It("marks the operator available and applies its custom manifests", func() {
+			mockKubeconfig()
+			mockCSV("local-storage-operator.v4.16.0", "Succeeded")
+			objectName := clusterID.String() + "/day2-operators/lso.yaml"
+			mockObjectHandler.EXPECT().DoesObjectExist(gomock.Any(), objectName).Return(true, nil).Times(1)
+			mockObjectHandler.EXPECT().Download(gomock.Any(), objectName).
+				Return(io.NopCloser(strings.NewReader(namespaceManifest)), int64(len(namespaceManifest)), nil).Times(1)
+			mockSpokeClient.EXPECT().Patch(gomock.Any(), gomock.Any(), ctrlclient.Apply, gomock.Any()).Return(nil).Times(1)
+			expectStatusEvent()
+
+			installer.MonitorOperators()
+
+			saved := expectStatus(models.OperatorStatusAvailable)
+			Expect(saved.Version).To(Equal("4.16.0"))
+		})
+
+		It("keeps the operator progressing while the subscription didn't install it", func() {
+			mockKubeconfig()
+			mockCSV("", "")
+
+			installer.MonitorOperators()
+
+			expectStatus(models.OperatorStatusProgressing)
+		})
+
+		It("marks the operator failed when its cluster service version failed", func() {
+			mockKubeconfig()
+			mockCSV("local-storage-operator.v4.16.0", "Failed")
+			expectStatusEvent()
+
+			installer.MonitorOperators()
+
+			expectStatus(models.OperatorStatusFailed)
+		})
+
+		It("marks the operator failed when it isn't available before its timeout", func() {
+			Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ?", clusterID).
+				Update("status_updated_at", strfmt.DateTime(time.Now().Add(-2*time.Duration(lso.Operator.TimeoutSeconds)*time.Second))).Error).
+				ToNot(HaveOccurred())
+			mockKubeconfig()
+			mockCSV("", "")
+			expectStatusEvent()
+
+			installer.MonitorOperators()
+
+			saved := expectStatus(models.OperatorStatusFailed)
+			Expect(saved.StatusInfo).To(ContainSubstring("wasn't available after"))
+		})
+	})
+
+	Context("MonitorOperatorsHealth", func() {
+		var operator *models.MonitoredOperator
+
+		BeforeEach(func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).
+				Update("install_completed_at", strfmt.DateTime(time.Now().Add(-10*time.Minute))).Error).ToNot(HaveOccurred())
+			operator = newLSO()
+			operator.ClusterID = clusterID
+			operator.Status = models.OperatorStatusAvailable
+			Expect(db.Create(operator).Error).ToNot(HaveOccurred())
+		})
+
+		mockCSV := func(phase, message string) {
+			mockSpokeClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, list ctrlclient.ObjectList, _ ...ctrlclient.ListOption) error {
+					subscription := unstructured.Unstructured{Object: map[string]interface{}{}}
+					Expect(unstructured.SetNestedField(subscription.Object, lso.Operator.SubscriptionName, "spec", "name")).To(Succeed())
+					Expect(unstructured.SetNestedField(subscription.Object, "local-storage-operator.v4.16.0", "status", "installedCSV")).To(Succeed())
+					list.(*unstructured.UnstructuredList).Items = []unstructured.Unstructured{subscription}
+					return nil
+				}).Times(1)
+			mockSpokeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ ctrlclient.ObjectKey, object ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+					csv := object.(*unstructured.Unstructured)
+					Expect(unstructured.SetNestedField(csv.Object, phase, "status", "phase")).To(Succeed())
+					Expect(unstructured.SetNestedField(csv.Object, message, "status", "message")).To(Succeed())
+					return nil
+				}).Times(1)
+		}
+
+		mockClusterOperator := func(name string, conditions ...map[string]interface{}) {
+			mockSpokeClient.EXPECT().Get(gomock.Any(), ctrlclient.ObjectKey{Name: name}, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ ctrlclient.ObjectKey, object ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+					values := make([]interface{}, 0, len(conditions))
+					for _, condition := range conditions {
+						values = append(values, condition)
+					}
+					Expect(unstructured.SetNestedSlice(object.(*unstructured.Unstructured).Object, values, "status", "conditions")).To(Succeed())
+					return nil
+				}).Times(1)
+		}
+
+		expectEvent := func(name string) {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(name),
+				eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+		}
+
+		expectHistory := func(operatorName string, statuses ...models.OperatorStatus) {
+			history, err := installer.GetOperatorsHealthHistory(ctx, clusterID, operatorName)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			ExpectWithOffset(1, history).To(HaveLen(len(statuses)))
+			for index, status := range statuses {
+				ExpectWithOffset(1, history[index].OperatorName).To(Equal(operatorName))
+				ExpectWithOffset(1, history[index].Status).To(Equal(status))
+			}
+		}
+
+		It("marks the operator failed when its cluster service version fails after the installation", func() {
+			mockKubeconfig()
+			mockCSV("Failed", "deployment has no available replicas")
+			expectEvent(eventgen.ClusterOperatorDegradedEventName)
+
+			installer.MonitorOperatorsHealth()
+
+			var saved models.MonitoredOperator
+			Expect(db.First(&saved, "cluster_id = ? and name = ?", clusterID, operator.Name).Error).ToNot(HaveOccurred())
+			Expect(saved.Status).To(Equal(models.OperatorStatusFailed))
+			Expect(saved.StatusInfo).To(Equal("deployment has no available replicas"))
+			expectHistory(operator.Name, models.OperatorStatusFailed)
+		})
+
+		It("records the recovery of the operator", func() {
+			mockKubeconfig()
+			mockCSV("Failed", "deployment has no available replicas")
+			expectEvent(eventgen.ClusterOperatorDegradedEventName)
+			installer.MonitorOperatorsHealth()
+
+			mockKubeconfig()
+			mockCSV("Succeeded", "install strategy completed with no errors")
+			expectEvent(eventgen.ClusterOperatorStatusEventName)
+			installer.MonitorOperatorsHealth()
+
+			expectHistory(operator.Name, models.OperatorStatusFailed, models.OperatorStatusAvailable)
+		})
+
+		It("doesn't record anything while the operator stays healthy", func() {
+			mockKubeconfig()
+			mockCSV("Succeeded", "install strategy completed with no errors")
+
+			installer.MonitorOperatorsHealth()
+
+			expectHistory(operator.Name)
+		})
+
+		It("checks the builtin operators with their cluster operators", func() {
+			Expect(db.Create(&models.MonitoredOperator{
+				ClusterID:    clusterID,
+				Name:         operators.OperatorConsole.Name,
+				OperatorType: models.OperatorTypeBuiltin,
+				Status:       models.OperatorStatusAvailable,
+			}).Error).ToNot(HaveOccurred())
+			mockKubeconfig()
+			mockCSV("Succeeded", "install strategy completed with no errors")
+			mockClusterOperator(operators.OperatorConsole.Name,
+				map[string]interface{}{"type": "Available", "status": "True"},
+				map[string]interface{}{"type": "Degraded", "status": "True", "message": "route isn't reachable"})
+			expectEvent(eventgen.ClusterOperatorDegradedEventName)
+
+			installer.MonitorOperatorsHealth()
+
+			expectHistory(operators.OperatorConsole.Name, models.OperatorStatusFailed)
+		})
+
+		It("doesn't check the clusters installed before the health monitor duration", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).
+				Update("install_completed_at", strfmt.DateTime(time.Now().Add(-2*time.Hour))).Error).ToNot(HaveOccurred())
+
+			installer.MonitorOperatorsHealth()
+
+			expectHistory(operator.Name)
+		})
+
+		It("fails to get the health history of an unknown cluster", func() {
+			_, err := installer.GetOperatorsHealthHistory(ctx, strfmt.UUID(uuid.New().String()), "")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/operators/day2 (interfaces: API)
+
+// Package day2 is a generated GoMock package.
+package day2
+
+import (
+	context "context"
+	reflect "reflect"
+
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// GetOperatorsHealthHistory mocks base method.
+func (m *MockAPI) GetOperatorsHealthHistory(arg0 context.Context, arg1 strfmt.UUID, arg2 string) (models.MonitoredOperatorHealthHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperatorsHealthHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.MonitoredOperatorHealthHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperatorsHealthHistory indicates an expected call of GetOperatorsHealthHistory.
+func (mr *MockAPIMockRecorder) GetOperatorsHealthHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperatorsHealthHistory", reflect.TypeOf((*MockAPI)(nil).GetOperatorsHealthHistory), arg0, arg1, arg2)
+}
+
+// InstallOperators mocks base method.
+func (m *MockAPI) InstallOperators(arg0 context.Context, arg1 strfmt.UUID, arg2 []*models.OperatorCreateParams) (models.MonitoredOperatorsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallOperators", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.MonitoredOperatorsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallOperators indicates an expected call of InstallOperators.
+func (mr *MockAPIMockRecorder) InstallOperators(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallOperators", reflect.TypeOf((*MockAPI)(nil).InstallOperators), arg0, arg1, arg2)
+}
+
+// MonitorOperators mocks base method.
+func (m *MockAPI) MonitorOperators() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MonitorOperators")
+}
+
+// MonitorOperators indicates an expected call of MonitorOperators.
+func (mr *MockAPIMockRecorder) MonitorOperators() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitorOperators", reflect.TypeOf((*MockAPI)(nil).MonitorOperators))
+}
+
+// MonitorOperatorsHealth mocks base method.
+func (m *MockAPI) MonitorOperatorsHealth() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MonitorOperatorsHealth")
+}
+
+// MonitorOperatorsHealth indicates an expected call of MonitorOperatorsHealth.
+func (mr *MockAPIMockRecorder) MonitorOperatorsHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitorOperatorsHealth", reflect.TypeOf((*MockAPI)(nil).MonitorOperatorsHealth))
+}
@@ -0,0 +1,183 @@
+package day2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	fieldOwner = "assisted-service"
+
+	csvPhaseSucceeded = "Succeeded"
+	csvPhaseFailed    = "Failed"
+)
+
+var (
+	subscriptionListGVK = schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "SubscriptionList"}
+	csvGVK              = schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "ClusterServiceVersion"}
+)
+
+// MonitorOperators checks the cluster service versions of the operators that are being installed in installed
+// clusters. Once an operator is available its custom manifests are applied.
+func (i *Installer) MonitorOperators() {
+	if !i.leaderElector.IsLeader() {
+		i.log.Debugf("Not a leader, exiting MonitorOperators")
+		return
+	}
+	ctx := context.Background()
+
+	var operators []*models.MonitoredOperator
+	if err := i.db.Where("day2 = ? AND status = ?", true, models.OperatorStatusProgressing).Find(&operators).Error; err != nil {
+		i.log.WithError(err).Error("failed to get the day-2 operators that are being installed")
+		return
+	}
+	for clusterID, clusterOperators := range lo.GroupBy(operators, func(operator *models.MonitoredOperator) strfmt.UUID {
+		return operator.ClusterID
+	}) {
+		client, err := i.getSpokeClient(ctx, clusterID)
+		if err != nil {
+			i.log.WithError(err).Warnf("failed to monitor the operators of cluster %s", clusterID)
+			continue
+		}
+		for _, operator := range clusterOperators {
+			if err = i.monitorOperator(ctx, client, operator); err != nil {
+				i.log.WithError(err).Warnf("failed to monitor operator %s of cluster %s", operator.Name, clusterID)
+			}
+		}
+		client.Close()
+	}
+}
+
+func (i *Installer) monitorOperator(ctx context.Context, client ctrlclient.Client, operator *models.MonitoredOperator) error {
+	csv, err := getInstalledCSV(ctx, client, operator)
+	if err != nil {
+		return err
+	}
+	if csv != nil {
+		phase, _, _ := unstructured.NestedString(csv.Object, "status", "phase")
+		switch phase {
+		case csvPhaseSucceeded:
+			if err = i.applyCustomManifests(ctx, client, operator); err != nil {
+				return err
+			}
+			version, _, _ := unstructured.NestedString(csv.Object, "spec", "version")
+			return i.updateStatus(ctx, operator, models.OperatorStatusAvailable, "install strategy completed with no errors", version)
+		case csvPhaseFailed:
+			message, _, _ := unstructured.NestedString(csv.Object, "status", "message")
+			return i.updateStatus(ctx, operator, models.OperatorStatusFailed, message, "")
+		}
+	}
+
+	timeout := time.Duration(operator.TimeoutSeconds) * time.Second
+	if timeout > 0 && time.Since(time.Time(operator.StatusUpdatedAt)) > timeout {
+		return i.updateStatus(ctx, operator, models.OperatorStatusFailed,
+			fmt.Sprintf("the operator wasn't available after %s", timeout), "")
+	}
+	return nil
+}
+
+// getInstalledCSV returns the cluster service version installed by the subscription of the operator, nil while
+// the subscription didn't install it yet
+func getInstalledCSV(ctx context.Context, client ctrlclient.Client, operator *models.MonitoredOperator) (*unstructured.Unstructured, error) {
+	subscriptions := &unstructured.UnstructuredList{}
+	subscriptions.SetGroupVersionKind(subscriptionListGVK)
+	if err := client.List(ctx, subscriptions, ctrlclient.InNamespace(operator.Namespace)); err != nil {
+		return nil, errors.Wrapf(err, "failed to list the subscriptions of namespace %s", operator.Namespace)
+	}
+	for _, subscription := range subscriptions.Items {
+		name, _, _ := unstructured.NestedString(subscription.Object, "spec", "name")
+		if name != operator.SubscriptionName {
+			continue
+		}
+		installedCSV, _, _ := unstructured.NestedString(subscription.Object, "status", "installedCSV")
+		if installedCSV == "" {
+			return nil, nil
+		}
+		csv := &unstructured.Unstructured{}
+		csv.SetGroupVersionKind(csvGVK)
+		if err := client.Get(ctx, types.NamespacedName{Namespace: operator.Namespace, Name: installedCSV}, csv); err != nil {
+			return nil, errors.Wrapf(err, "failed to get cluster service version %s", installedCSV)
+		}
+		return csv, nil
+	}
+	return nil, nil
+}
+
+// applyCustomManifests applies the manifests that need the resources created by the operator, for example its
+// custom resource definitions
+func (i *Installer) applyCustomManifests(ctx context.Context, client ctrlclient.Client, operator *models.MonitoredOperator) error {
+	objectName := customManifestsObjectName(operator.ClusterID, operator.Name)
+	exists, err := i.objectHandler.DoesObjectExist(ctx, objectName)
+	if err != nil || !exists {
+		return err
+	}
+	reader, _, err := i.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download the custom manifests of operator %s", operator.Name)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the custom manifests of operator %s", operator.Name)
+	}
+	return applyManifests(ctx, client, map[string][]byte{objectName: content})
+}
+
+func (i *Installer) updateStatus(ctx context.Context, operator *models.MonitoredOperator, status models.OperatorStatus, statusInfo, version string) error {
+	updates := map[string]interface{}{
+		"status":            status,
+		"status_info":       statusInfo,
+		"status_updated_at": strfmt.DateTime(time.Now()),
+	}
+	if version != "" {
+		updates["version"] = version
+	}
+	err := i.db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", operator.ClusterID, operator.Name).Updates(updates).Error
+	if err != nil {
+		return errors.Wrapf(err, "failed to update the status of operator %s", operator.Name)
+	}
+	eventgen.SendClusterOperatorStatusEvent(ctx, i.eventsHandler, operator.ClusterID, operator.Name, string(status), statusInfo)
+	return nil
+}
+
+// applyManifests applies the objects of the manifests with server side apply, namespaces first so that the other
+// objects can be created in them
+func applyManifests(ctx context.Context, client ctrlclient.Client, manifests map[string][]byte) error {
+	names := lo.Keys(manifests)
+	sort.Strings(names)
+	var namespaces, others []*unstructured.Unstructured
+	for _, name := range names {
+		objects, err := common.GetMultipleYamls[map[string]interface{}](manifests[name])
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse manifest %s", name)
+		}
+		for _, object := range objects {
+			u := &unstructured.Unstructured{Object: object}
+			if u.GetKind() == "Namespace" {
+				namespaces = append(namespaces, u)
+			} else {
+				others = append(others, u)
+			}
+		}
+	}
+	for _, object := range append(namespaces, others...) {
+		if err := client.Patch(ctx, object, ctrlclient.Apply, ctrlclient.FieldOwner(fieldOwner), ctrlclient.ForceOwnership); err != nil {
+			return errors.Wrapf(err, "failed to apply %s %s", object.GetKind(), object.GetName())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package declarative
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// BundleDefinition describes a bundle of operators defined by the service administrator, in addition to the builtin
+// bundles.
+type BundleDefinition struct {
+	// ID is the identifier of the bundle used in the API, for example 'edge-ai'
+	ID string `json:"id"`
+
+	// Title is the short user friendly name of the bundle, defaults to the identifier
+	Title string `json:"title,omitempty"`
+
+	// Description is the longer user friendly description of the bundle
+	Description string `json:"description,omitempty"`
+
+	// Operators are the names of the operators of the bundle. The operators that list the bundle in their own
+	// bundles are part of it as well.
+	Operators []string `json:"operators"`
+
+	// FeatureSupport describes where the bundle is available
+	FeatureSupport BundleFeatureSupport `json:"featureSupport,omitempty"`
+}
+
+// BundleFeatureSupport describes where a bundle is available, in addition to the support of its operators
+type BundleFeatureSupport struct {
+	// MinOpenshiftVersion is the first OpenShift version where the bundle is available
+	MinOpenshiftVersion string `json:"minOpenshiftVersion,omitempty"`
+
+	// Architectures are the CPU architectures where the bundle is available, all of them when empty
+	Architectures []string `json:"architectures,omitempty"`
+
+	// IncompatibleFeatures are the features, for example 'SNO', that remove all the operators from the bundle
+	IncompatibleFeatures []models.FeatureSupportLevelID `json:"incompatibleFeatures,omitempty"`
+}
+
+// LoadBundleDefinitions loads the bundle definitions from the YAML files of the given directory, usually a mounted
+// config map like the one of the operator definitions.
+func LoadBundleDefinitions(dir string) ([]*BundleDefinition, error) {
+	files, err := yamlFiles(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundles directory %s: %w", dir, err)
+	}
+
+	ret := make([]*BundleDefinition, 0, len(files))
+	ids := make(map[string]string)
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundle file %s: %w", file, err)
+		}
+		definition, err := ParseBundleDefinition(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse bundle file %s: %w", file, err)
+		}
+		if other, ok := ids[definition.ID]; ok {
+			return nil, fmt.Errorf("bundle %s is defined in both %s and %s", definition.ID, other, file)
+		}
+		ids[definition.ID] = file
+		ret = append(ret, definition)
+	}
+	return ret, nil
+}
+
+// ParseBundleDefinition parses and validates a YAML bundle definition, setting the defaults of the optional fields
+func ParseBundleDefinition(content []byte) (*BundleDefinition, error) {
+	var definition BundleDefinition
+	if err := yaml.UnmarshalStrict(content, &definition); err != nil {
+		return nil, err
+	}
+	if definition.Title == "" {
+		definition.Title = definition.ID
+	}
+	if err := definition.validate(); err != nil {
+		return nil, err
+	}
+	return &definition, nil
+}
+
+// validate checks the fields that can be checked without knowing the operators
+func (d *BundleDefinition) validate() error {
+	if errs := validation.IsDNS1123Label(d.ID); len(errs) > 0 {
+		return fmt.Errorf("invalid bundle identifier '%s': %s", d.ID, strings.Join(errs, ", "))
+	}
+	if len(d.Operators) == 0 {
+		return fmt.Errorf("bundle %s has no operators", d.ID)
+	}
+	if duplicates := lo.FindDuplicates(d.Operators); len(duplicates) > 0 {
+		return fmt.Errorf("bundle %s lists operators %s more than once", d.ID, strings.Join(duplicates, ", "))
+	}
+	if d.FeatureSupport.MinOpenshiftVersion != "" {
+		if _, err := common.BaseVersionLessThan(d.FeatureSupport.MinOpenshiftVersion, "4.0"); err != nil {
+			return fmt.Errorf("invalid minimal OpenShift version '%s' of bundle %s: %w", d.FeatureSupport.MinOpenshiftVersion, d.ID, err)
+		}
+	}
+	for _, architecture := range d.FeatureSupport.Architectures {
+		if !lo.Contains(validArchitectures, architecture) {
+			return fmt.Errorf("unknown architecture '%s' of bundle %s", architecture, d.ID)
+		}
+	}
+	for _, featureID := range d.FeatureSupport.IncompatibleFeatures {
+		if err := featureID.Validate(nil); err != nil {
+			return fmt.Errorf("unknown incompatible feature '%s' of bundle %s", featureID, d.ID)
+		}
+	}
+	return nil
+}
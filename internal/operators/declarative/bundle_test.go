@@ -0,0 +1,98 @@
+package declarative
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Bundle definitions", func() {
+	Context("ParseBundleDefinition", func() {
+		It("parses all the fields", func() {
+			definition, err := ParseBundleDefinition([]byte(`
+id: edge-ai
+title: Edge AI
+description: Serve AI models on small clusters.
+operators:
+- lvm
+- nvidia-gpu
+featureSupport:
+  minOpenshiftVersion: "4.16"
+  architectures:
+  - x86_64
+  incompatibleFeatures:
+  - SNO
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(definition.ID).To(Equal("edge-ai"))
+			Expect(definition.Title).To(Equal("Edge AI"))
+			Expect(definition.Description).To(Equal("Serve AI models on small clusters."))
+			Expect(definition.Operators).To(Equal([]string{"lvm", "nvidia-gpu"}))
+			Expect(definition.FeatureSupport.MinOpenshiftVersion).To(Equal("4.16"))
+			Expect(definition.FeatureSupport.Architectures).To(ConsistOf(models.ClusterCPUArchitectureX8664))
+			Expect(definition.FeatureSupport.IncompatibleFeatures).To(ConsistOf(models.FeatureSupportLevelIDSNO))
+		})
+
+		It("uses the identifier as the default title", func() {
+			definition, err := ParseBundleDefinition([]byte("id: edge-ai\noperators: [lvm]"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(definition.Title).To(Equal("edge-ai"))
+		})
+
+		expectInvalid := func(content, message string) {
+			_, err := ParseBundleDefinition([]byte(content))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+		}
+
+		It("rejects invalid definitions", func() {
+			expectInvalid("id: Edge_AI\noperators: [lvm]", "invalid bundle identifier")
+			expectInvalid("id: edge-ai\noperators: [lvm]\nunknown: field", "unknown field")
+			expectInvalid("id: edge-ai", "has no operators")
+			expectInvalid("id: edge-ai\noperators: [lvm, lvm]", "more than once")
+			expectInvalid("id: edge-ai\noperators: [lvm]\nfeatureSupport: {minOpenshiftVersion: latest}", "invalid minimal OpenShift version")
+			expectInvalid("id: edge-ai\noperators: [lvm]\nfeatureSupport: {architectures: [mips]}", "unknown architecture")
+			expectInvalid("id: edge-ai\noperators: [lvm]\nfeatureSupport: {incompatibleFeatures: [NOPE]}", "unknown incompatible feature")
+		})
+	})
+
+	Context("LoadBundleDefinitions", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "bundles")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		writeFile := func(name, content string) {
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0600)).To(Succeed())
+		}
+
+		It("loads the definitions sorted by file name", func() {
+			writeFile("b.yaml", "id: virtualisation\noperators: [cnv]")
+			writeFile("a.yml", "id: edge-ai\noperators: [lvm]")
+			writeFile("README.md", "not a definition")
+
+			definitions, err := LoadBundleDefinitions(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(definitions).To(HaveLen(2))
+			Expect(definitions[0].ID).To(Equal("edge-ai"))
+			Expect(definitions[1].ID).To(Equal("virtualisation"))
+		})
+
+		It("rejects bundles defined twice", func() {
+			writeFile("a.yaml", "id: edge-ai\noperators: [lvm]")
+			writeFile("b.yaml", "id: edge-ai\noperators: [cnv]")
+			_, err := LoadBundleDefinitions(dir)
+			Expect(err).To(MatchError(ContainSubstring("bundle edge-ai is defined in both")))
+		})
+	})
+})
@@ -0,0 +1,196 @@
+package declarative
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	defaultSource          = "redhat-operators"
+	defaultSourceNamespace = "openshift-marketplace"
+	defaultChannel         = "stable"
+	defaultTimeoutSeconds  = 30 * 60
+)
+
+// Definition describes an OLM operator that is installed with a Subscription, a Namespace and an OperatorGroup and
+// that doesn't need any custom logic in the service.
+type Definition struct {
+	// Name is the name of the operator used in the API, for example 'my-operator'
+	Name string `json:"name"`
+
+	// FullName is the user friendly name of the operator, defaults to the name
+	FullName string `json:"fullName,omitempty"`
+
+	// Namespace is the namespace where the operator is installed
+	Namespace string `json:"namespace"`
+
+	// SubscriptionName is the name of the OLM package, defaults to the name
+	SubscriptionName string `json:"subscriptionName,omitempty"`
+
+	// Source and SourceNamespace identify the catalog source of the package
+	Source          string `json:"source,omitempty"`
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+
+	// Channel is the default subscription channel
+	Channel string `json:"channel,omitempty"`
+
+	// Channels are the subscription channels the clusters can pin the operator to, by default only the default channel
+	Channels []string `json:"channels,omitempty"`
+
+	// AllNamespaces makes the operator group target all the namespaces instead of only the operator namespace
+	AllNamespaces bool `json:"allNamespaces,omitempty"`
+
+	// TimeoutSeconds is how long the installation of the operator is monitored
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+
+	// Dependencies are the names of the operators that are installed together with this operator
+	Dependencies []string `json:"dependencies,omitempty"`
+
+	// Bundles are the identifiers of the bundles that include this operator
+	Bundles []string `json:"bundles,omitempty"`
+
+	// Requirements are the additional resources needed by the hosts, per role
+	Requirements Requirements `json:"requirements,omitempty"`
+
+	// Manifests are the templates of the manifests of the operator
+	Manifests Manifests `json:"manifests,omitempty"`
+
+	// FeatureSupport describes where the operator is supported
+	FeatureSupport FeatureSupport `json:"featureSupport,omitempty"`
+}
+
+// RoleRequirements are the additional resources that the operator needs on each host of a role
+type RoleRequirements struct {
+	CPUCores   int64 `json:"cpuCores,omitempty"`
+	RAMMib     int64 `json:"ramMib,omitempty"`
+	DiskSizeGb int64 `json:"diskSizeGb,omitempty"`
+}
+
+// Requirements are the additional resources that the operator needs, the master requirements are also used for
+// single node clusters
+type Requirements struct {
+	Master RoleRequirements `json:"master,omitempty"`
+	Worker RoleRequirements `json:"worker,omitempty"`
+}
+
+// Manifests contains the templates of the manifests, indexed by file name.  The openshift manifests are added to the
+// installation and replace the default namespace.yaml, operatorgroup.yaml and subscription.yaml manifests when they
+// have the same name.  The custom manifests are applied once the operator is installed.
+type Manifests struct {
+	OpenShift map[string]string `json:"openshift,omitempty"`
+	Custom    map[string]string `json:"custom,omitempty"`
+}
+
+// FeatureSupport describes the support level of the operator
+type FeatureSupport struct {
+	// ID is the feature support identifier, defaults to the upper case name with underscores instead of dashes
+	ID string `json:"id,omitempty"`
+
+	// SupportLevel defaults to 'supported'
+	SupportLevel models.SupportLevel `json:"supportLevel,omitempty"`
+
+	// MinOpenshiftVersion is the first OpenShift version where the operator is available
+	MinOpenshiftVersion string `json:"minOpenshiftVersion,omitempty"`
+
+	// Architectures are the CPU architectures where the operator is available, all of them when empty
+	Architectures []string `json:"architectures,omitempty"`
+
+	// IncompatibleFeatures are the features that can't be used together with the operator
+	IncompatibleFeatures []models.FeatureSupportLevelID `json:"incompatibleFeatures,omitempty"`
+}
+
+// setDefaults fills the optional fields that were not set
+func (d *Definition) setDefaults() {
+	if d.FullName == "" {
+		d.FullName = d.Name
+	}
+	if d.SubscriptionName == "" {
+		d.SubscriptionName = d.Name
+	}
+	if d.Source == "" {
+		d.Source = defaultSource
+	}
+	if d.SourceNamespace == "" {
+		d.SourceNamespace = defaultSourceNamespace
+	}
+	if d.Channel == "" {
+		d.Channel = defaultChannel
+	}
+	if len(d.Channels) == 0 {
+		d.Channels = []string{d.Channel}
+	}
+	if d.TimeoutSeconds == 0 {
+		d.TimeoutSeconds = defaultTimeoutSeconds
+	}
+	if d.FeatureSupport.ID == "" {
+		d.FeatureSupport.ID = strings.ToUpper(strings.ReplaceAll(d.Name, "-", "_"))
+	}
+	if d.FeatureSupport.SupportLevel == "" {
+		d.FeatureSupport.SupportLevel = models.SupportLevelSupported
+	}
+}
+
+// validate checks the fields that can be checked without knowing the other operators
+func (d *Definition) validate() error {
+	if errs := validation.IsDNS1123Label(d.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid operator name '%s': %s", d.Name, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsDNS1123Label(d.Namespace); len(errs) > 0 {
+		return fmt.Errorf("invalid namespace '%s' of operator %s: %s", d.Namespace, d.Name, strings.Join(errs, ", "))
+	}
+	if d.TimeoutSeconds < 0 {
+		return fmt.Errorf("invalid timeout %d of operator %s", d.TimeoutSeconds, d.Name)
+	}
+	if !lo.Contains(d.Channels, d.Channel) {
+		return fmt.Errorf("default channel '%s' of operator %s isn't one of its channels", d.Channel, d.Name)
+	}
+	if lo.Contains(d.Dependencies, d.Name) {
+		return fmt.Errorf("operator %s depends on itself", d.Name)
+	}
+	for _, bundle := range d.Bundles {
+		if !operatorscommon.IsValidBundle(bundle) {
+			return fmt.Errorf("unknown bundle '%s' of operator %s", bundle, d.Name)
+		}
+	}
+	for _, requirements := range []RoleRequirements{d.Requirements.Master, d.Requirements.Worker} {
+		if requirements.CPUCores < 0 || requirements.RAMMib < 0 || requirements.DiskSizeGb < 0 {
+			return fmt.Errorf("negative host requirements of operator %s", d.Name)
+		}
+	}
+	for _, manifests := range []map[string]string{d.Manifests.OpenShift, d.Manifests.Custom} {
+		for name := range manifests {
+			if path.Base(name) != name || path.Ext(name) != ".yaml" {
+				return fmt.Errorf("invalid manifest name '%s' of operator %s, expected a file name with the .yaml extension", name, d.Name)
+			}
+		}
+	}
+	if err := d.FeatureSupport.SupportLevel.Validate(nil); err != nil {
+		return fmt.Errorf("invalid support level of operator %s: %w", d.Name, err)
+	}
+	if d.FeatureSupport.MinOpenshiftVersion != "" {
+		if _, err := common.BaseVersionLessThan(d.FeatureSupport.MinOpenshiftVersion, "4.0"); err != nil {
+			return fmt.Errorf("invalid minimal OpenShift version '%s' of operator %s: %w", d.FeatureSupport.MinOpenshiftVersion, d.Name, err)
+		}
+	}
+	for _, architecture := range d.FeatureSupport.Architectures {
+		if !lo.Contains(validArchitectures, architecture) {
+			return fmt.Errorf("unknown architecture '%s' of operator %s", architecture, d.Name)
+		}
+	}
+	return nil
+}
+
+var validArchitectures = []string{
+	models.ClusterCPUArchitectureX8664,
+	models.ClusterCPUArchitectureArm64,
+	models.ClusterCPUArchitectureS390x,
+	models.ClusterCPUArchitecturePpc64le,
+	models.ClusterCPUArchitectureMulti,
+}
@@ -0,0 +1,126 @@
+package declarative
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// LoadDefinitions loads the operator definitions from the YAML files of the given directory.  The directory is usually
+// a mounted config map, so the hidden files and directories that kubelet creates for the mount are ignored.
+func LoadDefinitions(dir string) ([]*Definition, error) {
+	files, err := yamlFiles(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read declarative operators directory %s: %w", dir, err)
+	}
+
+	ret := make([]*Definition, 0, len(files))
+	names := make(map[string]string)
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read declarative operator file %s: %w", file, err)
+		}
+		definition, err := ParseDefinition(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse declarative operator file %s: %w", file, err)
+		}
+		if other, ok := names[definition.Name]; ok {
+			return nil, fmt.Errorf("operator %s is defined in both %s and %s", definition.Name, other, file)
+		}
+		names[definition.Name] = file
+		ret = append(ret, definition)
+	}
+	if err := checkDependencyCycles(ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// checkDependencyCycles fails when the declarative operators depend on each other in a cycle.  The dependencies on
+// the builtin operators are ignored, the builtin operators never depend on declarative operators.
+func checkDependencyCycles(definitions []*Definition) error {
+	byName := make(map[string]*Definition, len(definitions))
+	for _, definition := range definitions {
+		byName[definition.Name] = definition
+	}
+
+	// visited is false while the dependencies of the operator are being visited and true once they were all visited
+	visited := make(map[string]bool, len(definitions))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		done, seen := visited[name]
+		if done {
+			return nil
+		}
+		path = append(path, name)
+		if seen {
+			return fmt.Errorf("declarative operators have a dependency cycle: %s", strings.Join(path[slices.Index(path, name):], " -> "))
+		}
+		visited[name] = false
+		for _, dependency := range byName[name].Dependencies {
+			if _, ok := byName[dependency]; !ok {
+				continue
+			}
+			if err := visit(dependency, path); err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+		return nil
+	}
+
+	for _, definition := range definitions {
+		if err := visit(definition.Name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseDefinition parses and validates a YAML operator definition, setting the defaults of the optional fields
+func ParseDefinition(content []byte) (*Definition, error) {
+	var definition Definition
+	if err := yaml.UnmarshalStrict(content, &definition); err != nil {
+		return nil, err
+	}
+	definition.setDefaults()
+	if err := definition.validate(); err != nil {
+		return nil, err
+	}
+	return &definition, nil
+}
+
+// yamlFiles returns the sorted paths of the YAML files of the given directory, ignoring the hidden files and
+// directories
+func yamlFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		if ext := filepath.Ext(name); ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+		// Config map keys are mounted as symbolic links, so stat follows them to find the regular files
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat file %s: %w", name, err)
+		}
+		if info.Mode().IsRegular() {
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,174 @@
+package declarative
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Definitions", func() {
+	Context("ParseDefinition", func() {
+		It("sets the defaults of the optional fields", func() {
+			definition, err := ParseDefinition([]byte(`
+name: my-operator
+namespace: my-operator-ns
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(definition.FullName).To(Equal("my-operator"))
+			Expect(definition.SubscriptionName).To(Equal("my-operator"))
+			Expect(definition.Source).To(Equal("redhat-operators"))
+			Expect(definition.SourceNamespace).To(Equal("openshift-marketplace"))
+			Expect(definition.Channel).To(Equal("stable"))
+			Expect(definition.Channels).To(ConsistOf("stable"))
+			Expect(definition.TimeoutSeconds).To(BeEquivalentTo(30 * 60))
+			Expect(definition.FeatureSupport.ID).To(Equal("MY_OPERATOR"))
+			Expect(definition.FeatureSupport.SupportLevel).To(Equal(models.SupportLevelSupported))
+		})
+
+		It("parses all the fields", func() {
+			definition, err := ParseDefinition([]byte(`
+name: my-operator
+fullName: My Operator
+namespace: my-operator-ns
+subscriptionName: my-operator-package
+source: community-operators
+channel: alpha
+channels:
+- alpha
+- beta
+allNamespaces: true
+dependencies:
+- lso
+bundles:
+- virtualization
+requirements:
+  master:
+    cpuCores: 2
+    ramMib: 1024
+  worker:
+    cpuCores: 1
+    diskSizeGb: 10
+manifests:
+  custom:
+    instance.yaml: |
+      kind: MyOperator
+featureSupport:
+  supportLevel: tech-preview
+  minOpenshiftVersion: "4.16"
+  architectures:
+  - x86_64
+  incompatibleFeatures:
+  - SNO
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(definition.FullName).To(Equal("My Operator"))
+			Expect(definition.SubscriptionName).To(Equal("my-operator-package"))
+			Expect(definition.Source).To(Equal("community-operators"))
+			Expect(definition.Channel).To(Equal("alpha"))
+			Expect(definition.Channels).To(ConsistOf("alpha", "beta"))
+			Expect(definition.AllNamespaces).To(BeTrue())
+			Expect(definition.Dependencies).To(ConsistOf("lso"))
+			Expect(definition.Requirements.Master).To(Equal(RoleRequirements{CPUCores: 2, RAMMib: 1024}))
+			Expect(definition.Requirements.Worker).To(Equal(RoleRequirements{CPUCores: 1, DiskSizeGb: 10}))
+			Expect(definition.Manifests.Custom).To(HaveKey("instance.yaml"))
+			Expect(definition.FeatureSupport.SupportLevel).To(Equal(models.SupportLevelTechPreview))
+			Expect(definition.FeatureSupport.IncompatibleFeatures).To(ConsistOf(models.FeatureSupportLevelIDSNO))
+		})
+
+		expectInvalid := func(content, message string) {
+			_, err := ParseDefinition([]byte(content))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+		}
+
+		It("rejects invalid definitions", func() {
+			expectInvalid("name: My_Operator\nnamespace: ns", "invalid operator name")
+			expectInvalid("name: my-operator", "invalid namespace")
+			expectInvalid("name: my-operator\nnamespace: ns\nunknown: field", "unknown field")
+			expectInvalid("name: my-operator\nnamespace: ns\nchannels: [alpha, beta]", "isn't one of its channels")
+			expectInvalid("name: my-operator\nnamespace: ns\ndependencies: [my-operator]", "depends on itself")
+			expectInvalid("name: my-operator\nnamespace: ns\nbundles: [nope]", "unknown bundle")
+			expectInvalid("name: my-operator\nnamespace: ns\nrequirements: {master: {cpuCores: -1}}", "negative host requirements")
+			expectInvalid("name: my-operator\nnamespace: ns\nmanifests: {custom: {'a/b.yaml': ''}}", "invalid manifest name")
+			expectInvalid("name: my-operator\nnamespace: ns\nfeatureSupport: {supportLevel: great}", "invalid support level")
+			expectInvalid("name: my-operator\nnamespace: ns\nfeatureSupport: {minOpenshiftVersion: latest}", "invalid minimal OpenShift version")
+			expectInvalid("name: my-operator\nnamespace: ns\nfeatureSupport: {architectures: [mips]}", "unknown architecture")
+		})
+	})
+
+	Context("LoadDefinitions", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "declarative-operators")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		writeFile := func(name, content string) {
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0600)).To(Succeed())
+		}
+
+		It("loads the definitions of a mounted config map", func() {
+			// Kubelet mounts the keys of a config map as links to a hidden timestamped directory
+			data := filepath.Join(dir, "..2024_01_01_00_00_00.000000000")
+			Expect(os.Mkdir(data, 0700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(data, "b.yaml"), []byte("name: b-operator\nnamespace: b"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(data, "a.yml"), []byte("name: a-operator\nnamespace: a"), 0600)).To(Succeed())
+			Expect(os.Symlink(filepath.Base(data), filepath.Join(dir, "..data"))).To(Succeed())
+			Expect(os.Symlink(filepath.Join("..data", "b.yaml"), filepath.Join(dir, "b.yaml"))).To(Succeed())
+			Expect(os.Symlink(filepath.Join("..data", "a.yml"), filepath.Join(dir, "a.yml"))).To(Succeed())
+			writeFile("README.md", "not a definition")
+
+			definitions, err := LoadDefinitions(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(definitions).To(HaveLen(2))
+			Expect(definitions[0].Name).To(Equal("a-operator"))
+			Expect(definitions[1].Name).To(Equal("b-operator"))
+		})
+
+		It("rejects operators defined twice", func() {
+			writeFile("a.yaml", "name: my-operator\nnamespace: a")
+			writeFile("b.yaml", "name: my-operator\nnamespace: b")
+			_, err := LoadDefinitions(dir)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is defined in both"))
+		})
+
+		It("rejects a dependency cycle between operators", func() {
+			writeFile("a.yaml", "name: a-operator\nnamespace: a\ndependencies: [lso, b-operator]")
+			writeFile("b.yaml", "name: b-operator\nnamespace: b\ndependencies: [c-operator]")
+			writeFile("c.yaml", "name: c-operator\nnamespace: c\ndependencies: [a-operator]")
+			_, err := LoadDefinitions(dir)
+			Expect(err).To(MatchError(ContainSubstring("dependency cycle: a-operator -> b-operator -> c-operator -> a-operator")))
+		})
+
+		It("loads operators that share dependencies", func() {
+			writeFile("a.yaml", "name: a-operator\nnamespace: a\ndependencies: [b-operator, c-operator]")
+			writeFile("b.yaml", "name: b-operator\nnamespace: b\ndependencies: [c-operator, lso]")
+			writeFile("c.yaml", "name: c-operator\nnamespace: c\ndependencies: [lso]")
+			definitions, err := LoadDefinitions(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(definitions).To(HaveLen(3))
+		})
+
+		It("reports the file of an invalid definition", func() {
+			writeFile("broken.yaml", "name: [")
+			_, err := LoadDefinitions(dir)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("broken.yaml"))
+		})
+
+		It("fails when the directory doesn't exist", func() {
+			_, err := LoadDefinitions(filepath.Join(dir, "missing"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
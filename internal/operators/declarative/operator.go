@@ -0,0 +1,253 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"testing/fstest"
+	"text/template"
+
+	"github.com/lib/pq"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/templating"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+// ClusterValidationID and HostValidationID are shared by all the declarative operators, the manager merges their
+// results into a single validation
+const (
+	ClusterValidationID = string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)
+	HostValidationID    = string(models.HostValidationIDDeclarativeOperatorsRequirementsSatisfied)
+)
+
+// TemplateConfig is the configuration passed to the manifest templates, available as '.Config'
+type TemplateConfig struct {
+	Definition *Definition
+	Cluster    *common.Cluster
+}
+
+type operator struct {
+	log           logrus.FieldLogger
+	definition    *Definition
+	monitored     models.MonitoredOperator
+	templatesRoot fs.FS
+	templates     *template.Template
+
+	dependenciesFeatureSupportIDs []models.FeatureSupportLevelID
+}
+
+// NewOperator creates a generic operator from a declarative definition
+func NewOperator(log logrus.FieldLogger, definition *Definition) (*operator, error) {
+	templatesRoot, err := definitionTemplates(definition)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := templating.LoadTemplates(templatesRoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the manifest templates of operator %s: %w", definition.Name, err)
+	}
+	return &operator{
+		log:        log.WithField("operator", definition.Name),
+		definition: definition,
+		monitored: models.MonitoredOperator{
+			Name:             definition.Name,
+			Namespace:        definition.Namespace,
+			OperatorType:     models.OperatorTypeOlm,
+			SubscriptionName: definition.SubscriptionName,
+			TimeoutSeconds:   definition.TimeoutSeconds,
+			Bundles:          pq.StringArray(definition.Bundles),
+		},
+		templatesRoot: templatesRoot,
+		templates:     templates,
+	}, nil
+}
+
+// definitionTemplates builds a file system with the default manifest templates and the templates of the definition.
+// The openshift manifests are prefixed with the operator name because all the operators share the same directory.
+func definitionTemplates(definition *Definition) (fs.FS, error) {
+	ret := fstest.MapFS{}
+	openshiftName := func(name string) string {
+		return path.Join("openshift", fmt.Sprintf("50_%s_%s", definition.Name, name))
+	}
+	defaults, err := fs.Glob(templatesRoot, "openshift/*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range defaults {
+		content, err := fs.ReadFile(templatesRoot, name)
+		if err != nil {
+			return nil, err
+		}
+		ret[openshiftName(path.Base(name))] = &fstest.MapFile{Data: content}
+	}
+	for name, content := range definition.Manifests.OpenShift {
+		ret[openshiftName(name)] = &fstest.MapFile{Data: []byte(content)}
+	}
+	for name, content := range definition.Manifests.Custom {
+		ret[path.Join("custom", name)] = &fstest.MapFile{Data: []byte(content)}
+	}
+	return ret, nil
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return o.definition.Name
+}
+
+// GetFullName reports the full name of the specified Operator
+func (o *operator) GetFullName() string {
+	return o.definition.FullName
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies(cluster *common.Cluster) ([]string, error) {
+	return append([]string{}, o.definition.Dependencies...), nil
+}
+
+// GetDependenciesFeatureSupportID provides the feature ids of the dependencies, which are resolved by the manager
+// since the dependencies may be builtin operators
+func (o *operator) GetDependenciesFeatureSupportID() []models.FeatureSupportLevelID {
+	return o.dependenciesFeatureSupportIDs
+}
+
+// SetDependenciesFeatureSupportID sets the feature ids of the dependencies once all the operators are known
+func (o *operator) SetDependenciesFeatureSupportID(featureIDs []models.FeatureSupportLevelID) {
+	o.dependenciesFeatureSupportIDs = featureIDs
+}
+
+// GetClusterValidationIDs returns cluster validation IDs for the Operator
+func (o *operator) GetClusterValidationIDs() []string {
+	return []string{ClusterValidationID}
+}
+
+// GetHostValidationID returns host validation ID for the Operator
+func (o *operator) GetHostValidationID() string {
+	return HostValidationID
+}
+
+// GetProperties provides description of operator properties
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the Operator implementation
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	ret := o.monitored
+	return &ret
+}
+
+// GetFeatureSupportID returns the operator unique feature-support ID
+func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
+	return models.FeatureSupportLevelID(o.definition.FeatureSupport.ID)
+}
+
+// GetBundleLabels returns the list of bundles names associated with the operator
+func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
+	return []string(o.monitored.Bundles)
+}
+
+// GetDefinition returns the definition the operator was created from
+func (o *operator) GetDefinition() *Definition {
+	return o.definition
+}
+
+// GenerateManifests generates manifests for the operator
+func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
+	config := &TemplateConfig{
+		Definition: o.definition,
+		Cluster:    cluster,
+	}
+	return operatorscommon.GenerateManifests(o.templatesRoot, o.templates, config, &o.monitored)
+}
+
+// ValidateCluster verifies that the OpenShift version of the cluster supports the operator
+func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) ([]api.ValidationResult, error) {
+	result := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: ClusterValidationID,
+	}
+	minVersion := o.definition.FeatureSupport.MinOpenshiftVersion
+	if minVersion != "" {
+		if lessThan, err := common.BaseVersionLessThan(minVersion, cluster.OpenshiftVersion); err != nil || lessThan {
+			result.Status = api.Failure
+			result.Reasons = []string{fmt.Sprintf("%s requires OpenShift version %s or newer", o.GetFullName(), minVersion)}
+		}
+	}
+	return []api.ValidationResult{result}, nil
+}
+
+// ValidateHost always succeeds, the requirements of the operator are validated together with the other host
+// requirements
+func (o *operator) ValidateHost(_ context.Context, _ *common.Cluster, _ *models.Host, _ *models.ClusterHostRequirementsDetails) (api.ValidationResult, error) {
+	return api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: HostValidationID,
+	}, nil
+}
+
+// GetHostRequirements provides operator's requirements towards the host
+func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	preflightRequirements, err := o.GetPreflightRequirements(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if common.IsSingleNodeCluster(cluster) {
+		return preflightRequirements.Requirements.Master.Quantitative, nil
+	}
+	switch role := common.GetEffectiveRole(host); role {
+	case models.HostRoleMaster, models.HostRoleBootstrap:
+		return preflightRequirements.Requirements.Master.Quantitative, nil
+	case models.HostRoleWorker, models.HostRoleAutoAssign:
+		return preflightRequirements.Requirements.Worker.Quantitative, nil
+	case models.HostRoleArbiter:
+		return &models.ClusterHostRequirementsDetails{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported role: %s", role)
+	}
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(_ context.Context, cluster *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	dependencies, err := o.GetDependencies(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: dependencies,
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: roleHardwareRequirements(o.definition.Requirements.Master),
+			Worker: roleHardwareRequirements(o.definition.Requirements.Worker),
+		},
+	}, nil
+}
+
+func roleHardwareRequirements(requirements RoleRequirements) *models.HostTypeHardwareRequirements {
+	var qualitative []string
+	if requirements.RAMMib > 0 {
+		qualitative = append(qualitative, fmt.Sprintf("%d MiB of additional RAM", requirements.RAMMib))
+	}
+	if requirements.CPUCores > 0 {
+		qualitative = append(qualitative, fmt.Sprintf("%d additional CPUs", requirements.CPUCores))
+	}
+	if requirements.DiskSizeGb > 0 {
+		qualitative = append(qualitative, fmt.Sprintf("%d GB of additional disk space", requirements.DiskSizeGb))
+	}
+	return &models.HostTypeHardwareRequirements{
+		Qualitative: qualitative,
+		Quantitative: &models.ClusterHostRequirementsDetails{
+			CPUCores:   requirements.CPUCores,
+			RAMMib:     requirements.RAMMib,
+			DiskSizeGb: requirements.DiskSizeGb,
+		},
+	}
+}
+
+// GetChannels returns the subscription channels of the definition, the same for all the OpenShift versions
+func (o *operator) GetChannels(_ string) ([]string, error) {
+	return append([]string{}, o.definition.Channels...), nil
+}
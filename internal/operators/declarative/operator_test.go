@@ -0,0 +1,177 @@
+package declarative
+
+import (
+	"context"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Operator", func() {
+	var (
+		ctx        = context.Background()
+		definition *Definition
+		operator   *operator
+		cluster    *common.Cluster
+	)
+
+	newOperator := func(content string) {
+		var err error
+		definition, err = ParseDefinition([]byte(content))
+		Expect(err).ToNot(HaveOccurred())
+		operator, err = NewOperator(logrus.New(), definition)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		newOperator(`
+name: my-operator
+namespace: my-operator-ns
+channel: stable-1.0
+requirements:
+  master:
+    cpuCores: 4
+    ramMib: 8192
+  worker:
+    cpuCores: 2
+    ramMib: 4096
+featureSupport:
+  minOpenshiftVersion: "4.16"
+`)
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			OpenshiftVersion:     "4.17.0",
+			ControlPlaneCount:    3,
+			HighAvailabilityMode: swag.String(models.ClusterHighAvailabilityModeFull),
+		}}
+	})
+
+	It("describes the monitored operator", func() {
+		Expect(operator.GetName()).To(Equal("my-operator"))
+		Expect(operator.GetFeatureSupportID()).To(BeEquivalentTo("MY_OPERATOR"))
+		monitored := operator.GetMonitoredOperator()
+		Expect(monitored.Namespace).To(Equal("my-operator-ns"))
+		Expect(monitored.SubscriptionName).To(Equal("my-operator"))
+		Expect(monitored.OperatorType).To(Equal(models.OperatorTypeOlm))
+		Expect(operator.GetClusterValidationIDs()).To(ConsistOf(ClusterValidationID))
+		Expect(operator.GetHostValidationID()).To(Equal(HostValidationID))
+	})
+
+	Context("GenerateManifests", func() {
+		It("generates the default manifests", func() {
+			openshiftManifests, customManifests, err := operator.GenerateManifests(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(openshiftManifests).To(HaveLen(3))
+			Expect(openshiftManifests).To(HaveKey("50_my-operator_namespace.yaml"))
+			Expect(openshiftManifests).To(HaveKey("50_my-operator_operatorgroup.yaml"))
+			Expect(openshiftManifests).To(HaveKey("50_my-operator_subscription.yaml"))
+			Expect(customManifests).To(BeEmpty())
+
+			var subscription map[string]interface{}
+			Expect(yaml.Unmarshal(openshiftManifests["50_my-operator_subscription.yaml"], &subscription)).To(Succeed())
+			spec := subscription["spec"].(map[string]interface{})
+			Expect(spec["name"]).To(Equal("my-operator"))
+			Expect(spec["channel"]).To(Equal("stable-1.0"))
+			Expect(spec["source"]).To(Equal("redhat-operators"))
+			Expect(spec["sourceNamespace"]).To(Equal("openshift-marketplace"))
+
+			var operatorGroup map[string]interface{}
+			Expect(yaml.Unmarshal(openshiftManifests["50_my-operator_operatorgroup.yaml"], &operatorGroup)).To(Succeed())
+			Expect(operatorGroup["spec"]).To(HaveKeyWithValue("targetNamespaces", ConsistOf("my-operator-ns")))
+		})
+
+		It("watches all the namespaces", func() {
+			newOperator("name: my-operator\nnamespace: my-operator-ns\nallNamespaces: true")
+			openshiftManifests, _, err := operator.GenerateManifests(cluster)
+			Expect(err).ToNot(HaveOccurred())
+
+			var operatorGroup map[string]interface{}
+			Expect(yaml.Unmarshal(openshiftManifests["50_my-operator_operatorgroup.yaml"], &operatorGroup)).To(Succeed())
+			Expect(operatorGroup["spec"]).To(BeEmpty())
+		})
+
+		It("overrides the default manifests and adds the custom manifests", func() {
+			newOperator(`
+name: my-operator
+namespace: my-operator-ns
+manifests:
+  openshift:
+    namespace.yaml: |
+      apiVersion: v1
+      kind: Namespace
+      metadata:
+        name: {{ .Operator.Namespace }}
+  custom:
+    instance.yaml: |
+      apiVersion: example.com/v1
+      kind: MyOperator
+      metadata:
+        name: instance
+        namespace: {{ .Operator.Namespace }}
+      spec:
+        clusterName: {{ .Config.Cluster.Name }}
+`)
+			cluster.Name = "mycluster"
+			openshiftManifests, customManifests, err := operator.GenerateManifests(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(openshiftManifests).To(HaveLen(3))
+			Expect(string(openshiftManifests["50_my-operator_namespace.yaml"])).ToNot(ContainSubstring("labels"))
+
+			var instance map[string]interface{}
+			Expect(yaml.Unmarshal(customManifests, &instance)).To(Succeed())
+			Expect(instance["kind"]).To(Equal("MyOperator"))
+			Expect(instance["spec"]).To(HaveKeyWithValue("clusterName", "mycluster"))
+		})
+	})
+
+	Context("GetHostRequirements", func() {
+		It("returns the requirements of the host role", func() {
+			master := &models.Host{Role: models.HostRoleMaster}
+			requirements, err := operator.GetHostRequirements(ctx, cluster, master)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 4, RAMMib: 8192}))
+
+			worker := &models.Host{Role: models.HostRoleWorker}
+			requirements, err = operator.GetHostRequirements(ctx, cluster, worker)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 2, RAMMib: 4096}))
+		})
+
+		It("returns the master requirements for single node clusters", func() {
+			cluster.ControlPlaneCount = 1
+			cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
+			requirements, err := operator.GetHostRequirements(ctx, cluster, &models.Host{Role: models.HostRoleAutoAssign})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 4, RAMMib: 8192}))
+		})
+
+		It("describes the preflight requirements", func() {
+			requirements, err := operator.GetPreflightRequirements(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements.OperatorName).To(Equal("my-operator"))
+			Expect(requirements.Requirements.Master.Qualitative).To(ConsistOf("8192 MiB of additional RAM", "4 additional CPUs"))
+		})
+	})
+
+	Context("ValidateCluster", func() {
+		It("succeeds with a supported version", func() {
+			results, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(ConsistOf(api.ValidationResult{Status: api.Success, ValidationId: ClusterValidationID}))
+		})
+
+		It("fails with an older version", func() {
+			cluster.OpenshiftVersion = "4.15.3"
+			results, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Status).To(Equal(api.Failure))
+			Expect(results[0].Reasons).To(ConsistOf("my-operator requires OpenShift version 4.16 or newer"))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package operators
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+// declarativeOperator is implemented by the operators created from declarative definitions
+type declarativeOperator interface {
+	api.Operator
+	SetDependenciesFeatureSupportID(featureIDs []models.FeatureSupportLevelID)
+}
+
+// loadDeclarativeOperators creates the operators defined in the given directory and registers their features.  The
+// declarative operators can depend on the builtin operators and on each other, but can't replace any of them.
+func loadDeclarativeOperators(log logrus.FieldLogger, dir string, builtinOperators []api.Operator) ([]api.Operator, error) {
+	definitions, err := declarative.LoadDefinitions(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorsByName := make(map[string]api.Operator)
+	for _, operator := range builtinOperators {
+		operatorsByName[operator.GetName()] = operator
+	}
+	declarativeOperators := make([]api.Operator, 0, len(definitions))
+	createdOperators := make(map[string]declarativeOperator)
+	for _, definition := range definitions {
+		if _, ok := operatorsByName[definition.Name]; ok {
+			return nil, fmt.Errorf("declarative operator %s conflicts with a builtin operator", definition.Name)
+		}
+		operator, err := declarative.NewOperator(log, definition)
+		if err != nil {
+			return nil, err
+		}
+		operatorsByName[definition.Name] = operator
+		createdOperators[definition.Name] = operator
+		declarativeOperators = append(declarativeOperators, operator)
+	}
+
+	for _, definition := range definitions {
+		featureIDs := make([]models.FeatureSupportLevelID, 0, len(definition.Dependencies))
+		for _, dependency := range definition.Dependencies {
+			operator, ok := operatorsByName[dependency]
+			if !ok {
+				return nil, fmt.Errorf("declarative operator %s depends on unknown operator %s", definition.Name, dependency)
+			}
+			featureIDs = append(featureIDs, operator.GetFeatureSupportID())
+		}
+		createdOperators[definition.Name].SetDependenciesFeatureSupportID(featureIDs)
+
+		if err := featuresupport.RegisterDeclarativeOperatorFeature(definition); err != nil {
+			return nil, err
+		}
+		log.Infof("Loaded declarative operator %s from %s", definition.Name, dir)
+	}
+	return declarativeOperators, nil
+}
+
+// mergeDeclarativeValidationResults combines the results of the declarative operators, which share the same
+// validation ID, into a single result.  The result is added even when there are no declarative operators because the
+// state machines expect it.
+func mergeDeclarativeValidationResults(results []api.ValidationResult, validationID string) []api.ValidationResult {
+	ret := make([]api.ValidationResult, 0, len(results)+1)
+	merged := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: validationID,
+	}
+	for _, result := range results {
+		if result.ValidationId != validationID {
+			ret = append(ret, result)
+			continue
+		}
+		switch {
+		case result.Status == api.Failure:
+			merged.Status = api.Failure
+		case result.Status == api.Pending && merged.Status != api.Failure:
+			merged.Status = api.Pending
+		}
+		merged.Reasons = append(merged.Reasons, result.Reasons...)
+	}
+	// The results of the disabled operators come from a map, sorting keeps the merged result stable
+	sort.Strings(merged.Reasons)
+	return append(ret, merged)
+}
@@ -0,0 +1,204 @@
+package operators
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"k8s.io/utils/ptr"
+)
+
+// GetDependencyGraph returns the requested operators and all the operators they depend on, together with the
+// operators that pull in each dependency, the conflicts with other features and the aggregated hardware
+// requirements. The cluster only needs to contain the details that the operators use to compute their dependencies
+// and requirements, for example the OpenShift version, the CPU architecture or the number of control plane nodes.
+func (mgr *Manager) GetDependencyGraph(ctx context.Context, cluster *common.Cluster, operatorNames []string,
+	featureIDs []models.FeatureSupportLevelID) (*models.OperatorsDependencyGraph, error) {
+	log := logutil.FromContext(ctx, mgr.log)
+
+	nodes, err := mgr.getDependencyNodes(cluster, operatorNames)
+	if err != nil {
+		return nil, err
+	}
+
+	// The requirements of some operators depend on the other operators installed in the cluster
+	graphCluster := *cluster
+	graphCluster.MonitoredOperators = lo.Map(nodes, func(node *models.OperatorDependencyNode, _ int) *models.MonitoredOperator {
+		return mgr.monitoredOperators[node.Name]
+	})
+
+	graph := &models.OperatorsDependencyGraph{
+		Operators:    nodes,
+		Conflicts:    []*models.OperatorDependencyConflict{},
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{},
+	}
+	for _, node := range nodes {
+		operator := mgr.olmOperators[node.Name]
+		requirements, err := operator.GetPreflightRequirements(ctx, &graphCluster)
+		if err != nil {
+			log.WithError(err).Errorf("Cannot get preflight requirements for %s operator", node.Name)
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if requirements != nil && requirements.Requirements != nil {
+			node.Requirements = requirements.Requirements
+			graph.Requirements.Master = addHardwareRequirements(graph.Requirements.Master, requirements.Requirements.Master)
+			graph.Requirements.Worker = addHardwareRequirements(graph.Requirements.Worker, requirements.Requirements.Worker)
+		}
+		if cluster.OpenshiftVersion != "" {
+			node.SupportLevel = featuresupport.GetSupportLevel(operator.GetFeatureSupportID(), supportLevelFilters(cluster))
+		}
+	}
+	if cluster.OpenshiftVersion != "" {
+		graph.Conflicts = mgr.getDependencyConflicts(cluster.OpenshiftVersion, nodes, featureIDs)
+	}
+	return graph, nil
+}
+
+// getDependencyNodes walks the dependencies of the requested operators breadth first, so the returned nodes start
+// with the requested operators followed by their dependencies in the order they were pulled in
+func (mgr *Manager) getDependencyNodes(cluster *common.Cluster, operatorNames []string) ([]*models.OperatorDependencyNode, error) {
+	var nodes []*models.OperatorDependencyNode
+	nodesByName := make(map[string]*models.OperatorDependencyNode)
+	addNode := func(name string, requested bool) *models.OperatorDependencyNode {
+		node := &models.OperatorDependencyNode{
+			Name:         name,
+			Requested:    requested,
+			Dependencies: []string{},
+			RequiredBy:   []string{},
+		}
+		nodes = append(nodes, node)
+		nodesByName[name] = node
+		return node
+	}
+
+	fifo := list.New()
+	for _, name := range operatorNames {
+		if _, ok := mgr.olmOperators[name]; !ok {
+			return nil, common.NewApiError(http.StatusBadRequest, fmt.Errorf("operator %s isn't supported", name))
+		}
+		if _, ok := nodesByName[name]; ok {
+			continue
+		}
+		fifo.PushBack(addNode(name, true))
+	}
+	for fifo.Len() > 0 {
+		node := fifo.Remove(fifo.Front()).(*models.OperatorDependencyNode)
+		deps, err := mgr.olmOperators[node.Name].GetDependencies(cluster)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError,
+				errors.Wrapf(err, "failed to get the dependencies of operator %s", node.Name))
+		}
+		for _, dep := range deps {
+			if _, ok := mgr.olmOperators[dep]; !ok {
+				return nil, common.NewApiError(http.StatusInternalServerError,
+					fmt.Errorf("operator %s depends on unknown operator %s", node.Name, dep))
+			}
+			node.Dependencies = append(node.Dependencies, dep)
+			depNode, ok := nodesByName[dep]
+			if !ok {
+				depNode = addNode(dep, false)
+				fifo.PushBack(depNode)
+			}
+			depNode.RequiredBy = append(depNode.RequiredBy, node.Name)
+		}
+	}
+	return nodes, nil
+}
+
+// getDependencyConflicts returns the operators of the graph that are incompatible with the requested features or
+// with the other operators of the graph
+func (mgr *Manager) getDependencyConflicts(openshiftVersion string, nodes []*models.OperatorDependencyNode,
+	featureIDs []models.FeatureSupportLevelID) []*models.OperatorDependencyConflict {
+	conflicts := []*models.OperatorDependencyConflict{}
+	reported := make(map[string]bool)
+	for _, node := range nodes {
+		featureID := mgr.olmOperators[node.Name].GetFeatureSupportID()
+		others := append([]models.FeatureSupportLevelID{}, featureIDs...)
+		for _, other := range nodes {
+			if other.Name != node.Name {
+				others = append(others, mgr.olmOperators[other.Name].GetFeatureSupportID())
+			}
+		}
+		for _, other := range others {
+			if featuresupport.IsFeatureCompatibleWithOther(openshiftVersion, featureID, []models.FeatureSupportLevelID{other}) {
+				continue
+			}
+			key := fmt.Sprintf("%s/%s", node.Name, other)
+			if reported[key] {
+				continue
+			}
+			reported[key] = true
+			conflicts = append(conflicts, &models.OperatorDependencyConflict{
+				Operator:  node.Name,
+				FeatureID: other,
+				Message:   fmt.Sprintf("operator %s is incompatible with %s", node.Name, other),
+			})
+		}
+	}
+	return conflicts
+}
+
+func supportLevelFilters(cluster *common.Cluster) featuresupport.SupportLevelFilters {
+	filters := featuresupport.SupportLevelFilters{
+		OpenshiftVersion: cluster.OpenshiftVersion,
+		CPUArchitecture:  ptr.To(cluster.CPUArchitecture),
+	}
+	if cluster.Platform != nil {
+		filters.PlatformType = cluster.Platform.Type
+		if cluster.Platform.External != nil {
+			filters.ExternalPlatformName = cluster.Platform.External.PlatformName
+		}
+	}
+	return filters
+}
+
+// addHardwareRequirements adds the requirements of an operator to the total, the quantitative requirements are
+// summed up, except for the thresholds where the strictest one wins
+func addHardwareRequirements(total, requirements *models.HostTypeHardwareRequirements) *models.HostTypeHardwareRequirements {
+	if total == nil {
+		total = &models.HostTypeHardwareRequirements{
+			Qualitative:  []string{},
+			Quantitative: &models.ClusterHostRequirementsDetails{},
+		}
+	}
+	if requirements == nil {
+		return total
+	}
+	total.Qualitative = append(total.Qualitative, requirements.Qualitative...)
+	details := requirements.Quantitative
+	if details == nil {
+		return total
+	}
+	sum := total.Quantitative
+	sum.CPUCores += details.CPUCores
+	sum.RAMMib += details.RAMMib
+	sum.DiskSizeGb += details.DiskSizeGb
+	if details.InstallationDiskSpeedThresholdMs > 0 &&
+		(sum.InstallationDiskSpeedThresholdMs == 0 || details.InstallationDiskSpeedThresholdMs < sum.InstallationDiskSpeedThresholdMs) {
+		sum.InstallationDiskSpeedThresholdMs = details.InstallationDiskSpeedThresholdMs
+	}
+	if details.NetworkLatencyThresholdMs != nil && *details.NetworkLatencyThresholdMs >= 0 {
+		if sum.NetworkLatencyThresholdMs == nil {
+			sum.NetworkLatencyThresholdMs = details.NetworkLatencyThresholdMs
+		} else {
+			sum.NetworkLatencyThresholdMs = ptr.To(math.Min(*sum.NetworkLatencyThresholdMs, *details.NetworkLatencyThresholdMs))
+		}
+	}
+	if details.PacketLossPercentage != nil && *details.PacketLossPercentage >= 0 {
+		if sum.PacketLossPercentage == nil {
+			sum.PacketLossPercentage = details.PacketLossPercentage
+		} else {
+			sum.PacketLossPercentage = ptr.To(math.Min(*sum.PacketLossPercentage, *details.PacketLossPercentage))
+		}
+	}
+	sum.TpmEnabledInBios = sum.TpmEnabledInBios || details.TpmEnabledInBios
+	return total
+}
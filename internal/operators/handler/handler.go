@@ -11,7 +11,9 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/day2"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
@@ -28,11 +30,17 @@ type Handler struct {
 	log                logrus.FieldLogger
 	eventsHandler      eventsapi.Handler
 	clusterProgressAPI cluster.ProgressAPI
+	// day2API installs operators in clusters that are already installed
+	day2API day2.API
+	// hwValidator determines the installation disks of the hosts, which can't be used for storage
+	hwValidator hardware.Validator
 }
 
 // NewHandler creates new handler
-func NewHandler(operatorsAPI operators.API, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, clusterProgressAPI cluster.ProgressAPI) *Handler {
-	return &Handler{operatorsAPI: operatorsAPI, log: log, db: db, eventsHandler: eventsHandler, clusterProgressAPI: clusterProgressAPI}
+func NewHandler(operatorsAPI operators.API, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, clusterProgressAPI cluster.ProgressAPI,
+	day2API day2.API, hwValidator hardware.Validator) *Handler {
+	return &Handler{operatorsAPI: operatorsAPI, log: log, db: db, eventsHandler: eventsHandler, clusterProgressAPI: clusterProgressAPI, day2API: day2API,
+		hwValidator: hwValidator}
 }
 
 // ReportMonitoredOperatorStatus Controller API to report of monitored operators.
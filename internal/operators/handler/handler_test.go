@@ -18,11 +18,14 @@ import (
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/day2"
 	operatorsHandler "github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/operators/lso"
 	"github.com/openshift/assisted-service/models"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -47,7 +50,7 @@ var _ = Describe("Operators manager", func() {
 		mockApi = operators.NewMockAPI(ctrl)
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockClusterProgressApi = cluster.NewMockProgressAPI(ctrl)
-		handler = operatorsHandler.NewHandler(mockApi, log, db, mockEvents, mockClusterProgressApi)
+		handler = operatorsHandler.NewHandler(mockApi, log, db, mockEvents, mockClusterProgressApi, nil, nil)
 
 		// create simple cluster #1
 		clusterID := strfmt.UUID(uuid.New().String())
@@ -224,7 +227,7 @@ var _ = Describe("V2ListBundles validation", func() {
 		db, dbName = common.PrepareTestDB()
 		ctrl = gomock.NewController(GinkgoT())
 		mockApi = operators.NewMockAPI(ctrl)
-		handler = operatorsHandler.NewHandler(mockApi, log, db, nil, nil)
+		handler = operatorsHandler.NewHandler(mockApi, log, db, nil, nil, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -478,3 +481,233 @@ func from(prototype models.MonitoredOperator) *models.MonitoredOperator {
 		TimeoutSeconds: prototype.TimeoutSeconds,
 	}
 }
+
+var _ = Describe("V2InstallClusterOperators", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockDay2API *day2.MockAPI
+		handler     *operatorsHandler.Handler
+		clusterID   strfmt.UUID
+		params      restoperators.V2InstallClusterOperatorsParams
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDay2API = day2.NewMockAPI(ctrl)
+		handler = operatorsHandler.NewHandler(nil, logrus.New(), nil, nil, nil, mockDay2API, nil)
+		clusterID = strfmt.UUID(uuid.New().String())
+		params = restoperators.V2InstallClusterOperatorsParams{
+			ClusterID:     clusterID,
+			InstallParams: []*models.OperatorCreateParams{{Name: lso.Operator.Name}},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("returns the operators that are being installed", func() {
+		installed := models.MonitoredOperatorsList{{Name: lso.Operator.Name, Status: models.OperatorStatusProgressing, Day2: true}}
+		mockDay2API.EXPECT().InstallOperators(gomock.Any(), clusterID, params.InstallParams).Return(installed, nil).Times(1)
+
+		response := handler.V2InstallClusterOperators(context.TODO(), params)
+
+		Expect(response).To(BeAssignableToTypeOf(restoperators.NewV2InstallClusterOperatorsAccepted()))
+		Expect(response.(*restoperators.V2InstallClusterOperatorsAccepted).Payload).To(Equal(installed))
+	})
+
+	It("returns the error of the installation", func() {
+		mockDay2API.EXPECT().InstallOperators(gomock.Any(), clusterID, params.InstallParams).
+			Return(nil, common.NewApiError(http.StatusConflict, errors.New("cluster isn't installed"))).Times(1)
+
+		response := handler.V2InstallClusterOperators(context.TODO(), params)
+
+		Expect(response).To(BeAssignableToTypeOf(common.NewApiError(http.StatusConflict, nil)))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusConflict))
+	})
+})
+
+var _ = Describe("V2ListClusterOperatorsHealthHistory", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockDay2API *day2.MockAPI
+		handler     *operatorsHandler.Handler
+		clusterID   strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDay2API = day2.NewMockAPI(ctrl)
+		handler = operatorsHandler.NewHandler(nil, logrus.New(), nil, nil, nil, mockDay2API, nil)
+		clusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("returns the health history of the requested operator", func() {
+		history := models.MonitoredOperatorHealthHistory{
+			{ClusterID: clusterID, OperatorName: lso.Operator.Name, Status: models.OperatorStatusFailed},
+		}
+		mockDay2API.EXPECT().GetOperatorsHealthHistory(gomock.Any(), clusterID, lso.Operator.Name).Return(history, nil).Times(1)
+
+		response := handler.V2ListClusterOperatorsHealthHistory(context.TODO(), restoperators.V2ListClusterOperatorsHealthHistoryParams{
+			ClusterID:    clusterID,
+			OperatorName: swag.String(lso.Operator.Name),
+		})
+
+		Expect(response).To(BeAssignableToTypeOf(restoperators.NewV2ListClusterOperatorsHealthHistoryOK()))
+		Expect(response.(*restoperators.V2ListClusterOperatorsHealthHistoryOK).Payload).To(Equal(history))
+	})
+
+	It("returns the error of the history", func() {
+		mockDay2API.EXPECT().GetOperatorsHealthHistory(gomock.Any(), clusterID, "").
+			Return(nil, common.NewApiError(http.StatusNotFound, errors.New("cluster not found"))).Times(1)
+
+		response := handler.V2ListClusterOperatorsHealthHistory(context.TODO(), restoperators.V2ListClusterOperatorsHealthHistoryParams{
+			ClusterID: clusterID,
+		})
+
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusNotFound))
+	})
+})
+
+var _ = Describe("V2CalculateClusterStorageSizing", func() {
+	var (
+		db              *gorm.DB
+		dbName          string
+		ctrl            *gomock.Controller
+		mockApi         *operators.MockAPI
+		mockHwValidator *hardware.MockValidator
+		handler         *operatorsHandler.Handler
+		clusterID       strfmt.UUID
+		hostID          strfmt.UUID
+		disks           []*models.Disk
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockApi = operators.NewMockAPI(ctrl)
+		mockHwValidator = hardware.NewMockValidator(ctrl)
+		handler = operatorsHandler.NewHandler(mockApi, logrus.New(), db, nil, nil, nil, mockHwValidator)
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ToNot(HaveOccurred())
+		disks = []*models.Disk{
+			{ID: "/dev/sda", Path: "/dev/sda", DriveType: models.DriveTypeSSD, SizeBytes: 120 * 1000 * 1000 * 1000},
+			{ID: "/dev/sdb", Path: "/dev/sdb", DriveType: models.DriveTypeSSD, SizeBytes: 500 * 1000 * 1000 * 1000},
+		}
+		inventory, err := common.MarshalInventory(&models.Inventory{Disks: disks})
+		Expect(err).ToNot(HaveOccurred())
+		hostID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Host{
+			ID:         &hostID,
+			ClusterID:  &clusterID,
+			InfraEnvID: clusterID,
+			Role:       models.HostRoleWorker,
+			Inventory:  inventory,
+		}).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("doesn't use the installation disk of the hosts for storage", func() {
+		params := &models.StorageSizingParams{OperatorName: swag.String(lso.Operator.Name)}
+		sizing := &models.StorageSizing{OperatorName: lso.Operator.Name}
+		mockHwValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(disks).Times(1)
+		mockApi.EXPECT().GetStorageSizing(gomock.Any(), gomock.Any(), gomock.Any(), params).DoAndReturn(
+			func(_ context.Context, _ *common.Cluster, hostsDisks map[strfmt.UUID][]*models.Disk, _ *models.StorageSizingParams) (*models.StorageSizing, error) {
+				Expect(hostsDisks).To(HaveKey(hostID))
+				Expect(hostsDisks[hostID]).To(HaveLen(1))
+				Expect(hostsDisks[hostID][0].ID).To(Equal("/dev/sdb"))
+				return sizing, nil
+			}).Times(1)
+
+		response := handler.V2CalculateClusterStorageSizing(context.TODO(), restoperators.V2CalculateClusterStorageSizingParams{
+			ClusterID:    clusterID,
+			SizingParams: params,
+		})
+
+		Expect(response).To(BeAssignableToTypeOf(restoperators.NewV2CalculateClusterStorageSizingOK()))
+		Expect(response.(*restoperators.V2CalculateClusterStorageSizingOK).Payload).To(Equal(sizing))
+	})
+
+	It("fails for an unknown cluster", func() {
+		response := handler.V2CalculateClusterStorageSizing(context.TODO(), restoperators.V2CalculateClusterStorageSizingParams{
+			ClusterID:    strfmt.UUID(uuid.New().String()),
+			SizingParams: &models.StorageSizingParams{OperatorName: swag.String(lso.Operator.Name)},
+		})
+
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusNotFound))
+	})
+})
+
+var _ = Describe("V2GetOperatorsDependencyGraph", func() {
+	var (
+		ctrl    *gomock.Controller
+		mockApi *operators.MockAPI
+		handler *operatorsHandler.Handler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockApi = operators.NewMockAPI(ctrl)
+		handler = operatorsHandler.NewHandler(mockApi, logrus.New(), nil, nil, nil, nil, nil)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("computes the graph for a single node cluster when SNO is requested", func() {
+		graph := &models.OperatorsDependencyGraph{
+			Operators: []*models.OperatorDependencyNode{{Name: "openshift-ai", Requested: true}},
+		}
+		mockApi.EXPECT().GetDependencyGraph(gomock.Any(), gomock.Any(), []string{"openshift-ai"},
+			[]models.FeatureSupportLevelID{models.FeatureSupportLevelIDSNO}).
+			DoAndReturn(func(_ context.Context, cluster *common.Cluster, _ []string, _ []models.FeatureSupportLevelID) (*models.OperatorsDependencyGraph, error) {
+				Expect(cluster.OpenshiftVersion).To(Equal("4.16.0"))
+				Expect(cluster.CPUArchitecture).To(Equal(models.ClusterCPUArchitectureX8664))
+				Expect(common.IsSingleNodeCluster(cluster)).To(BeTrue())
+				return graph, nil
+			}).Times(1)
+
+		response := handler.V2GetOperatorsDependencyGraph(context.TODO(), restoperators.V2GetOperatorsDependencyGraphParams{
+			OperatorNames:    []string{"openshift-ai"},
+			OpenshiftVersion: swag.String("4.16.0"),
+			CPUArchitecture:  swag.String("x86_64"),
+			FeatureIds:       []string{"SNO"},
+		})
+
+		Expect(response).To(BeAssignableToTypeOf(restoperators.NewV2GetOperatorsDependencyGraphOK()))
+		Expect(response.(*restoperators.V2GetOperatorsDependencyGraphOK).Payload).To(Equal(graph))
+	})
+
+	It("rejects invalid versions", func() {
+		response := handler.V2GetOperatorsDependencyGraph(context.TODO(), restoperators.V2GetOperatorsDependencyGraphParams{
+			OperatorNames:    []string{"openshift-ai"},
+			OpenshiftVersion: swag.String("invalid"),
+			CPUArchitecture:  swag.String("x86_64"),
+		})
+
+		Expect(response).To(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, nil)))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusBadRequest))
+	})
+
+	It("returns the error of the manager", func() {
+		mockApi.EXPECT().GetDependencyGraph(gomock.Any(), gomock.Any(), []string{"unknown"}, gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("operator unknown isn't supported"))).Times(1)
+
+		response := handler.V2GetOperatorsDependencyGraph(context.TODO(), restoperators.V2GetOperatorsDependencyGraphParams{
+			OperatorNames: []string{"unknown"},
+		})
+
+		Expect(response).To(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, nil)))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusBadRequest))
+	})
+})
@@ -6,13 +6,19 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/hashicorp/go-version"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
 )
 
 // validateBundleParameters validates the parameters for bundle operations
@@ -71,6 +77,25 @@ func (h *Handler) V2ListOfClusterOperators(ctx context.Context, params restopera
 	return restoperators.NewV2ListOfClusterOperatorsOK().WithPayload(operatorsList)
 }
 
+// V2InstallClusterOperators Installs additional operators, and the operators they depend on, in an installed cluster.
+func (h *Handler) V2InstallClusterOperators(ctx context.Context, params restoperators.V2InstallClusterOperatorsParams) middleware.Responder {
+	operatorsList, err := h.day2API.InstallOperators(ctx, params.ClusterID, params.InstallParams)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return restoperators.NewV2InstallClusterOperatorsAccepted().WithPayload(operatorsList)
+}
+
+// V2ListClusterOperatorsHealthHistory Lists the changes of the status of the operators of a cluster that were observed
+// after the installation of the cluster.
+func (h *Handler) V2ListClusterOperatorsHealthHistory(ctx context.Context, params restoperators.V2ListClusterOperatorsHealthHistoryParams) middleware.Responder {
+	history, err := h.day2API.GetOperatorsHealthHistory(ctx, params.ClusterID, swag.StringValue(params.OperatorName))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return restoperators.NewV2ListClusterOperatorsHealthHistoryOK().WithPayload(history)
+}
+
 // V2ListOperatorProperties Lists properties for an operator name.
 func (h *Handler) V2ListOperatorProperties(ctx context.Context, params restoperators.V2ListOperatorPropertiesParams) middleware.Responder {
 	log := logutil.FromContext(ctx, h.log)
@@ -147,3 +172,83 @@ func (h *Handler) V2GetBundle(ctx context.Context, params restoperators.V2GetBun
 
 	return restoperators.NewV2GetBundleOK().WithPayload(bundle)
 }
+
+// V2GetOperatorsDependencyGraph Retrieves the operators pulled in by the requested operators and the reasons why.
+func (h *Handler) V2GetOperatorsDependencyGraph(ctx context.Context, params restoperators.V2GetOperatorsDependencyGraphParams) middleware.Responder {
+	var platformType *models.PlatformType
+	if params.PlatformType != nil {
+		pt := models.PlatformType(*params.PlatformType)
+		platformType = &pt
+	}
+
+	if err := h.validateBundleParameters(params.OpenshiftVersion, params.CPUArchitecture, platformType, params.ExternalPlatformName); err != nil {
+		return err
+	}
+
+	var featureIDs []models.FeatureSupportLevelID
+	for _, featureID := range params.FeatureIds {
+		featureIDs = append(featureIDs, models.FeatureSupportLevelID(featureID))
+	}
+
+	// The dependencies and the requirements of the operators are computed for a cluster that only has the
+	// requested characteristics
+	cluster := &common.Cluster{Cluster: models.Cluster{
+		OpenshiftVersion:  swag.StringValue(params.OpenshiftVersion),
+		CPUArchitecture:   swag.StringValue(params.CPUArchitecture),
+		ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode,
+	}}
+	if platformType != nil {
+		cluster.Platform = &models.Platform{Type: platformType}
+		if *platformType == models.PlatformTypeExternal {
+			cluster.Platform.External = &models.PlatformExternal{PlatformName: params.ExternalPlatformName}
+		}
+	}
+	if funk.Contains(featureIDs, models.FeatureSupportLevelIDSNO) {
+		cluster.ControlPlaneCount = 1
+		cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
+	}
+
+	graph, err := h.operatorsAPI.GetDependencyGraph(ctx, cluster, params.OperatorNames, featureIDs)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return restoperators.NewV2GetOperatorsDependencyGraphOK().WithPayload(graph)
+}
+
+// V2CalculateClusterStorageSizing Calculates the capacity that a storage operator provides with the disks of the hosts of the cluster.
+func (h *Handler) V2CalculateClusterStorageSizing(ctx context.Context, params restoperators.V2CalculateClusterStorageSizingParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	cluster, err := common.GetClusterFromDB(h.db, params.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.GenerateErrorResponder(common.NewApiError(http.StatusNotFound, err))
+		}
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+
+	// The installation disk of a host is the disk it is installed to, chosen among its eligible disks when it
+	// wasn't set, so it can't be used for storage
+	hostsDisks := make(map[strfmt.UUID][]*models.Disk)
+	for _, host := range cluster.Hosts {
+		if host.Inventory == "" {
+			continue
+		}
+		inventory, parseErr := common.UnmarshalInventory(host.Inventory)
+		if parseErr != nil {
+			log.WithError(parseErr).Errorf("failed to parse the inventory of host %s", host.ID)
+			return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, parseErr))
+		}
+		installationDisk := hostutil.DetermineInstallationDisk(h.hwValidator.ListEligibleDisks(inventory), hostutil.GetHostInstallationPath(host))
+		hostsDisks[*host.ID] = lo.Filter(inventory.Disks, func(disk *models.Disk, _ int) bool {
+			return installationDisk == nil || disk.ID != installationDisk.ID
+		})
+	}
+
+	sizing, err := h.operatorsAPI.GetStorageSizing(ctx, cluster, hostsDisks, params.SizingParams)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return restoperators.NewV2CalculateClusterStorageSizingOK().WithPayload(sizing)
+}
@@ -166,3 +166,8 @@ func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
 func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
 	return []string(Operator.Bundles)
 }
+
+// GetChannels returns the subscription channels of the Loki operator
+func (o *operator) GetChannels(openshiftVersion string) ([]string, error) {
+	return []string{Channel}, nil
+}
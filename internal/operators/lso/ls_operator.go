@@ -3,8 +3,10 @@ package lso
 import (
 	"context"
 
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -74,7 +76,11 @@ func (l *lsOperator) ValidateHost(_ context.Context, _ *common.Cluster, _ *model
 // GenerateManifests generates manifests for the operator
 
 func (l *lsOperator) GenerateManifests(c *common.Cluster) (map[string][]byte, []byte, error) {
-	return Manifests()
+	storageClassName, err := StorageClassName(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	return Manifests(storageClassName)
 }
 
 // GetProperties provides description of operator properties: none required
@@ -120,3 +126,23 @@ func (l *lsOperator) GetFeatureSupportID() models.FeatureSupportLevelID {
 func (l *lsOperator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
 	return []string(Operator.Bundles)
 }
+
+// GetChannels returns the subscription channels of the LSO operator
+func (l *lsOperator) GetChannels(openshiftVersion string) ([]string, error) {
+	return []string{"stable"}, nil
+}
+
+// GetStorageSizingDefaults returns the sizing of the local volumes, each disk is a volume without any copy of the data
+func (l *lsOperator) GetStorageSizingDefaults(_ *common.Cluster) (*models.StorageSizingParams, error) {
+	return &models.StorageSizingParams{
+		OperatorName:       swag.String(Operator.Name),
+		Replica:            1,
+		ReservedPercentage: swag.Int64(0),
+	}, nil
+}
+
+// GetStorageDisks returns the disks of the host that can be used as local volumes, the LSO uses the disks of all the
+// hosts
+func (l *lsOperator) GetStorageDisks(_ *common.Cluster, _ *models.Host, disks []*models.Disk) []*models.Disk {
+	return operatorscommon.StorageDisks(disks, 0)
+}
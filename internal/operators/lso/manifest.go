@@ -3,8 +3,37 @@ package lso
 import (
 	"bytes"
 	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 )
 
+const (
+	// DefaultStorageClassName is the storage class of the local volumes when ODF doesn't set another one
+	DefaultStorageClassName = "localblock-sc"
+
+	// odfOperatorName is the operator consuming the local volumes whose storage_class_name property sets their
+	// storage class. The ODF package depends on this one, so its name is repeated here.
+	odfOperatorName = "odf"
+)
+
+// StorageClassName returns the storage class of the local volumes of the cluster, which the consumers of the local
+// volumes must request
+func StorageClassName(cluster *common.Cluster) (string, error) {
+	properties := struct {
+		StorageClassName string `json:"storage_class_name"`
+	}{}
+	if cluster != nil {
+		if err := operatorscommon.UnmarshalProperties(cluster.MonitoredOperators, odfOperatorName, &properties); err != nil {
+			return "", err
+		}
+	}
+	if properties.StorageClassName == "" {
+		return DefaultStorageClassName, nil
+	}
+	return properties.StorageClassName, nil
+}
+
 func lsoSubscription() ([]byte, error) {
 	data := map[string]string{
 		"OPERATOR_NAMESPACE":         Operator.Namespace,
@@ -34,18 +63,34 @@ spec:
 	return buf.Bytes(), nil
 }
 
-func Manifests() (map[string][]byte, []byte, error) {
+func localVolumeSet(storageClassName string) ([]byte, error) {
+	tmpl, err := template.New("localVolumeSet").Parse(localVolumeSetTemplate)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err = tmpl.Execute(buf, map[string]string{"STORAGE_CLASS_NAME": storageClassName}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func Manifests(storageClassName string) (map[string][]byte, []byte, error) {
 	lsoSubs, err := lsoSubscription()
 	if err != nil {
 		return nil, nil, err
 	}
+	lvs, err := localVolumeSet(storageClassName)
+	if err != nil {
+		return nil, nil, err
+	}
 	openshiftManifests := make(map[string][]byte)
 	openshiftManifests["50_openshift-lso_ns.yaml"] = []byte(localStorageNamespace)
 	openshiftManifests["50_openshift-lso_operator_group.yaml"] = []byte(lsoOperatorGroup)
 	openshiftManifests["50_openshift-lso_subscription.yaml"] = lsoSubs
 	openshiftManifests["50_openshift-lso_prometheus-role.yaml"] = []byte(localStoragePrometheusRole)
 	openshiftManifests["50_openshift-lso_prometheus-rolebinding.yaml"] = []byte(localStoragePrometheusRoleBinding)
-	return openshiftManifests, []byte(localVolumeSet), nil
+	return openshiftManifests, lvs, nil
 }
 
 const lsoOperatorGroup = `apiVersion: operators.coreos.com/v1
@@ -66,13 +111,13 @@ metadata:
   labels:
     openshift.io/cluster-monitoring: "true"`
 
-const localVolumeSet = `apiVersion: "local.storage.openshift.io/v1alpha1"
+const localVolumeSetTemplate = `apiVersion: "local.storage.openshift.io/v1alpha1"
 kind: "LocalVolumeSet"
 metadata:
   name: "local-disks"
   namespace: "openshift-local-storage"
 spec:
-  storageClassName: "localblock-sc"
+  storageClassName: "{{.STORAGE_CLASS_NAME}}"
   volumeMode: Block
   deviceInclusionSpec:
     deviceTypes:
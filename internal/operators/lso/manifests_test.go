@@ -32,5 +32,23 @@ var _ = Describe("LSO manifest generation", func() {
 
 		_, err = yaml.YAMLToJSON(manifest)
 		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(manifest)).To(ContainSubstring(`storageClassName: "localblock-sc"`))
+	})
+
+	It("creates the local volumes in the storage class requested by ODF", func() {
+		odfCluster := cluster
+		odfCluster.MonitoredOperators = []*models.MonitoredOperator{
+			{Name: "odf", Properties: `{"storage_class_name": "my-local-disks"}`},
+		}
+		_, manifest, err := operator.GenerateManifests(&odfCluster)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var lvs struct {
+			Spec struct {
+				StorageClassName string `json:"storageClassName"`
+			} `json:"spec"`
+		}
+		Expect(yaml.Unmarshal(manifest, &lvs)).To(Succeed())
+		Expect(lvs.Spec.StorageClassName).To(Equal("my-local-disks"))
 	})
 })
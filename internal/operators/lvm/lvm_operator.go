@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/go-openapi/swag"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/operators/api"
@@ -25,8 +26,6 @@ type operator struct {
 	Config *Config
 }
 
-const defaultStorageClassName = "lvms-" + defaultDeviceName
-
 var Operator = models.MonitoredOperator{
 	Name:             "lvm",
 	OperatorType:     models.OperatorTypeOlm,
@@ -62,8 +61,13 @@ func (o *operator) GetFullName() string {
 	return "Logical Volume Management"
 }
 
-func (o *operator) StorageClassName() string {
-	return defaultStorageClassName
+func (o *operator) StorageClassName(cluster *common.Cluster) string {
+	properties, err := getProperties(cluster)
+	if err != nil {
+		o.log.WithError(err).Warn("Failed to get the LVM properties, using the default device class")
+		return "lvms-" + defaultDeviceName
+	}
+	return "lvms-" + properties.DeviceClassName
 }
 
 // GetDependencies provides a list of dependencies of the Operator
@@ -160,9 +164,9 @@ func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte
 	return Manifests(cluster)
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return propertiesDescription
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the LSO
@@ -249,3 +253,42 @@ func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []
 	// For non-SNO deployments, LVM is not in any bundle by default
 	return []string{}
 }
+
+// GetChannels returns the subscription channels of the LVM operator, which are released together with OpenShift.
+// The channels of the deprecated LVMO subscription aren't known.
+func (o *operator) GetChannels(openshiftVersion string) ([]string, error) {
+	isLvms, err := common.BaseVersionGreaterOrEqual(LvmsMinOpenshiftVersion4_12, openshiftVersion)
+	if err != nil || !isLvms {
+		return nil, err
+	}
+	majorMinor, err := common.GetMajorMinorVersion(openshiftVersion)
+	if err != nil {
+		return nil, err
+	}
+	return []string{fmt.Sprintf("stable-%s", *majorMinor)}, nil
+}
+
+// GetStorageSizingDefaults returns the sizing of the LVM volume group, the space that isn't part of the thin pool is
+// reserved and the data isn't copied
+func (o *operator) GetStorageSizingDefaults(cluster *common.Cluster) (*models.StorageSizingParams, error) {
+	properties, err := getProperties(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &models.StorageSizingParams{
+		OperatorName:       swag.String(Operator.Name),
+		Replica:            1,
+		ReservedPercentage: swag.Int64(100 - properties.ThinPoolSizePercent),
+		DevicePaths:        properties.DevicePaths,
+	}, nil
+}
+
+// GetStorageDisks returns the disks of the host that can be added to the volume group, only the hosts that run
+// workloads are used
+func (o *operator) GetStorageDisks(cluster *common.Cluster, host *models.Host, disks []*models.Disk) []*models.Disk {
+	role := common.GetEffectiveRole(host)
+	if role != models.HostRoleWorker && (!common.ShouldMastersBeSchedulable(&cluster.Cluster) || role == models.HostRoleArbiter) {
+		return nil
+	}
+	return operatorscommon.StorageDisks(disks, 0)
+}
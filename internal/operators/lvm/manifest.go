@@ -23,7 +23,7 @@ func Manifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	lvmcluster, err := getLvmCluster()
+	lvmcluster, err := getLvmCluster(cluster)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,15 +80,22 @@ func getOperatorGroup() ([]byte, error) {
 	return executeTemplate(data, "LvmOperatorGroup", LvmOperatorGroup)
 }
 
-func getLvmCluster() ([]byte, error) {
-	data := map[string]string{
-		"OPERATOR_NAMESPACE": Operator.Namespace,
-		"DEVICE_NAME":        defaultDeviceName,
+func getLvmCluster(cluster *common.Cluster) ([]byte, error) {
+	properties, err := getProperties(cluster)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{
+		"OPERATOR_NAMESPACE":     Operator.Namespace,
+		"DEVICE_NAME":            properties.DeviceClassName,
+		"DEVICE_PATHS":           properties.DevicePaths,
+		"THIN_POOL_SIZE_PERCENT": properties.ThinPoolSizePercent,
+		"OVERPROVISION_RATIO":    properties.OverprovisionRatio,
 	}
 	return executeTemplate(data, "LvmCluster", LvmCluster)
 }
 
-func executeTemplate(data map[string]string, contentName, content string) ([]byte, error) {
+func executeTemplate(data interface{}, contentName, content string) ([]byte, error) {
 	tmpl, err := template.New(contentName).Parse(content)
 	if err != nil {
 		return nil, err
@@ -138,7 +145,14 @@ spec:
   storage:
     deviceClasses:
     - name: {{.DEVICE_NAME}}
+{{- if .DEVICE_PATHS}}
+      deviceSelector:
+        paths:
+{{- range .DEVICE_PATHS}}
+        - "{{.}}"
+{{- end}}
+{{- end}}
       thinPoolConfig:
         name: thin-pool-1
-        sizePercent: 90
-        overprovisionRatio: 10`
+        sizePercent: {{.THIN_POOL_SIZE_PERCENT}}
+        overprovisionRatio: {{.OVERPROVISION_RATIO}}`
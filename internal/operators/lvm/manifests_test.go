@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 )
 
@@ -40,6 +41,32 @@ var _ = Describe("LVM manifest generation", func() {
 			_, err = yaml.YAMLToJSON(manifest)
 			Expect(err).ShouldNot(HaveOccurred(), "yamltojson err: %v", err)
 		})
+
+		It("Uses the properties set by the user in the LVMCluster", func() {
+			cluster = getCluster("4.16.0")
+			operator := Operator
+			operator.Properties = `{"device_class_name": "fast", "device_paths": ["/dev/sdb", "/dev/sdc"], "thin_pool_size_percent": 70}`
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&operator}
+
+			_, manifest, err := NewLvmOperator(common.GetTestLog()).GenerateManifests(cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var lvmCluster map[string]interface{}
+			Expect(yaml.Unmarshal(manifest, &lvmCluster)).To(Succeed())
+			deviceClasses, _, _ := unstructured.NestedSlice(lvmCluster, "spec", "storage", "deviceClasses")
+			Expect(deviceClasses).To(HaveLen(1))
+			deviceClass := deviceClasses[0].(map[string]interface{})
+			Expect(deviceClass).To(HaveKeyWithValue("name", "fast"))
+			Expect(deviceClass).To(HaveKeyWithValue("deviceSelector", map[string]interface{}{
+				"paths": []interface{}{"/dev/sdb", "/dev/sdc"},
+			}))
+			Expect(deviceClass).To(HaveKeyWithValue("thinPoolConfig", map[string]interface{}{
+				"name":               "thin-pool-1",
+				"sizePercent":        float64(70),
+				"overprovisionRatio": float64(10),
+			}))
+			Expect(NewLvmOperator(common.GetTestLog()).StorageClassName(cluster)).To(Equal("lvms-fast"))
+		})
 	})
 	It("Check Subscription information", func() {
 		cluster = getCluster("4.12.0-rc.4")
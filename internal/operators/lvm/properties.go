@@ -0,0 +1,80 @@
+package lvm
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	defaultThinPoolSizePercent = 90
+	defaultOverprovisionRatio  = 10
+
+	// The bounds of the thin pool configuration accepted by the LVMCluster
+	minThinPoolSizePercent = 10
+	maxThinPoolSizePercent = 90
+	minOverprovisionRatio  = 1
+
+	devicePathPattern = `^/dev/[A-Za-z0-9._:+@/-]+$`
+)
+
+// lvmProperties are the values that the user can set to configure the LVMCluster created for the cluster
+type lvmProperties struct {
+	DeviceClassName     string   `json:"device_class_name"`
+	DevicePaths         []string `json:"device_paths"`
+	ThinPoolSizePercent int64    `json:"thin_pool_size_percent"`
+	OverprovisionRatio  int64    `json:"overprovision_ratio"`
+}
+
+var propertiesDescription = models.OperatorProperties{
+	{
+		Name:         "device_class_name",
+		DataType:     models.OperatorPropertyDataTypeString,
+		Description:  "Name of the device class, the storage class created for it is named lvms-<device class name>",
+		DefaultValue: defaultDeviceName,
+		Pattern:      operatorscommon.ResourceNamePattern,
+	},
+	{
+		Name:        "device_paths",
+		DataType:    models.OperatorPropertyDataTypeArray,
+		Description: "Paths of the disks added to the volume group, all the available disks are added when empty",
+		Pattern:     devicePathPattern,
+	},
+	{
+		Name:         "thin_pool_size_percent",
+		DataType:     models.OperatorPropertyDataTypeInteger,
+		Description:  "Percentage of the volume group used by the thin pool",
+		DefaultValue: strconv.Itoa(defaultThinPoolSizePercent),
+		Minimum:      swag.Float64(minThinPoolSizePercent),
+		Maximum:      swag.Float64(maxThinPoolSizePercent),
+	},
+	{
+		Name:         "overprovision_ratio",
+		DataType:     models.OperatorPropertyDataTypeInteger,
+		Description:  "Factor by which the thin pool can be overprovisioned",
+		DefaultValue: strconv.Itoa(defaultOverprovisionRatio),
+		Minimum:      swag.Float64(minOverprovisionRatio),
+	},
+}
+
+// getProperties returns the properties set by the user for the cluster, with defaults for the missing ones
+func getProperties(cluster *common.Cluster) (lvmProperties, error) {
+	ret := lvmProperties{
+		DeviceClassName:     defaultDeviceName,
+		ThinPoolSizePercent: defaultThinPoolSizePercent,
+		OverprovisionRatio:  defaultOverprovisionRatio,
+	}
+	if cluster == nil {
+		return ret, nil
+	}
+	if err := operatorscommon.UnmarshalProperties(cluster.MonitoredOperators, Operator.Name, &ret); err != nil {
+		return ret, err
+	}
+	if ret.DeviceClassName == "" {
+		ret.DeviceClassName = defaultDeviceName
+	}
+	return ret, nil
+}
@@ -9,6 +9,7 @@ import (
 	"path"
 	"strings"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/featuresupport"
@@ -16,6 +17,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/amdgpu"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/operators/mce"
 	"github.com/openshift/assisted-service/internal/operators/nvidiagpu"
@@ -55,6 +57,15 @@ type Manager struct {
 	monitoredOperators map[string]*models.MonitoredOperator
 	manifestsAPI       manifestsapi.ManifestsAPI
 	objectHandler      s3wrapper.API
+
+	// defaultCatalogSource is used by the clusters that don't have their own catalog source
+	defaultCatalogSource *models.OperatorsCatalogSource
+
+	// bundleDefinitions are the bundles defined by the service administrator, indexed by identifier
+	bundleDefinitions map[string]*declarative.BundleDefinition
+
+	// hiddenBundles are the identifiers of the bundles that aren't offered
+	hiddenBundles []string
 }
 
 type OperatorFeatureSupportID struct {
@@ -81,6 +92,8 @@ type API interface {
 	// GenerateManifests generates manifests for all enabled operators.
 	// Returns map assigning manifest content to its desired file name
 	GenerateManifests(ctx context.Context, cluster *common.Cluster) error
+	// GenerateDay2Manifests generates the manifests that install operators in an installed cluster
+	GenerateDay2Manifests(cluster *common.Cluster, operators []*models.MonitoredOperator) (map[string][]byte, map[string][]byte, error)
 	// AnyOLMOperatorEnabled checks whether any OLM operator has been enabled for the given cluster
 	AnyOLMOperatorEnabled(cluster *common.Cluster) bool
 	// ResolveDependencies amends the list of requested additional operators with any missing dependencies
@@ -107,6 +120,14 @@ type API interface {
 	GetBundle(bundleID string, featureIDs []models.FeatureSupportLevelID) (*models.Bundle, error)
 	// GetOperatorDependenciesFeatureID returns the list of dependencies
 	GetOperatorDependenciesFeatureID() []OperatorFeatureSupportID
+	// EnsureOperatorsCatalogSource verifies that the catalog source used by the cluster contains the given operators
+	EnsureOperatorsCatalogSource(catalogSource *models.OperatorsCatalogSource, operators []*models.MonitoredOperator) error
+	// GetDependencyGraph returns the given operators, the operators they depend on and why each one is pulled in
+	GetDependencyGraph(ctx context.Context, cluster *common.Cluster, operatorNames []string, featureIDs []models.FeatureSupportLevelID) (*models.OperatorsDependencyGraph, error)
+	// GetStorageSizing calculates the capacity that a storage operator provides with the disks of the hosts of the cluster
+	GetStorageSizing(ctx context.Context, cluster *common.Cluster, hostsDisks map[strfmt.UUID][]*models.Disk, params *models.StorageSizingParams) (*models.StorageSizing, error)
+	// RemoveOperatorsManifests deletes the manifests generated for operators removed from a cluster that isn't installed yet
+	RemoveOperatorsManifests(ctx context.Context, cluster *common.Cluster, removedOperators []*models.MonitoredOperator) ([]string, error)
 }
 
 // GetPreflightRequirementsBreakdownForCluster provides host requirements breakdown for each supported OLM operator
@@ -178,6 +199,7 @@ func hasMCEAndStorage(operators []*models.MonitoredOperator) bool {
 // Returns map assigning manifest content to its desired file name
 func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Cluster) error {
 	var controllerManifests []Manifest
+	catalogSource := mgr.getCatalogSource(cluster.OperatorsCatalogSource)
 	// Generate manifests for all the generic operators
 	for _, clusterOperator := range cluster.MonitoredOperators {
 		if clusterOperator.OperatorType != models.OperatorTypeOlm {
@@ -186,9 +208,8 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 
 		operator := mgr.olmOperators[clusterOperator.Name]
 		if operator != nil {
-			openshiftManifests, manifest, err := operator.GenerateManifests(cluster)
+			openshiftManifests, manifest, err := mgr.generateOperatorManifests(cluster, operator, clusterOperator, catalogSource)
 			if err != nil {
-				mgr.log.Error(fmt.Sprintf("Cannot generate %s manifests due to ", clusterOperator.Name), err)
 				return err
 			}
 			for k, v := range openshiftManifests {
@@ -202,12 +223,18 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 		}
 	}
 
+	if catalogSource != nil && len(controllerManifests) > 0 {
+		if err := mgr.createCatalogSourceManifest(ctx, cluster, catalogSource); err != nil {
+			return err
+		}
+	}
+
 	if hasMCEAndStorage(cluster.Cluster.MonitoredOperators) {
 		storageOperator, err := mgr.getStorageOperator(&cluster.Cluster)
 		if err != nil {
 			return err
 		}
-		agentServiceConfigYaml, err := mce.GetAgentServiceConfigWithPVCManifest(storageOperator.StorageClassName())
+		agentServiceConfigYaml, err := mce.GetAgentServiceConfigWithPVCManifest(storageOperator.StorageClassName(cluster))
 		if err != nil {
 			return err
 		}
@@ -235,6 +262,61 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 	return nil
 }
 
+// generateOperatorManifests generates the manifests of an operator, with the subscription pinned and using the
+// catalog source of the cluster when it has one
+func (mgr *Manager) generateOperatorManifests(cluster *common.Cluster, operator api.Operator, clusterOperator *models.MonitoredOperator,
+	catalogSource *models.OperatorsCatalogSource) (map[string][]byte, []byte, error) {
+	openshiftManifests, manifest, err := operator.GenerateManifests(cluster)
+	if err != nil {
+		mgr.log.Error(fmt.Sprintf("Cannot generate %s manifests due to ", clusterOperator.Name), err)
+		return nil, nil, err
+	}
+	if err = pinSubscription(openshiftManifests, clusterOperator); err != nil {
+		mgr.log.WithError(err).Errorf("Cannot pin the subscription of operator %s", clusterOperator.Name)
+		return nil, nil, err
+	}
+	if catalogSource != nil {
+		if err = useCatalogSource(openshiftManifests, clusterOperator, catalogSource); err != nil {
+			mgr.log.WithError(err).Errorf("Cannot use catalog source %s for operator %s", catalogSource.Name, clusterOperator.Name)
+			return nil, nil, err
+		}
+	}
+	return openshiftManifests, manifest, nil
+}
+
+// GenerateDay2Manifests generates the manifests that install the given OLM operators in a cluster that is already
+// installed. The openshift manifests are applied right away, the custom manifests are indexed by operator name and
+// are applied once the operator is available.
+func (mgr *Manager) GenerateDay2Manifests(cluster *common.Cluster, operators []*models.MonitoredOperator) (map[string][]byte, map[string][]byte, error) {
+	openshiftManifests := make(map[string][]byte)
+	customManifests := make(map[string][]byte)
+	catalogSource := mgr.getCatalogSource(cluster.OperatorsCatalogSource)
+	for _, clusterOperator := range operators {
+		operator := mgr.olmOperators[clusterOperator.Name]
+		if clusterOperator.OperatorType != models.OperatorTypeOlm || operator == nil {
+			return nil, nil, errors.Errorf("operator %s can't be installed in an installed cluster", clusterOperator.Name)
+		}
+		manifests, manifest, err := mgr.generateOperatorManifests(cluster, operator, clusterOperator, catalogSource)
+		if err != nil {
+			return nil, nil, err
+		}
+		for k, v := range manifests {
+			openshiftManifests[k] = v
+		}
+		if len(manifest) > 0 {
+			customManifests[clusterOperator.Name] = manifest
+		}
+	}
+	if catalogSource != nil && len(openshiftManifests) > 0 {
+		content, err := catalogSourceManifest(catalogSource)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to generate catalog source %s", catalogSource.Name)
+		}
+		openshiftManifests[catalogSourceFileName(catalogSource)] = content
+	}
+	return openshiftManifests, customManifests, nil
+}
+
 // createControllerManifest create a file called custom_manifests.json, which is later obtained by the
 // assisted-installer-controller, which apply this manifest file after the OLM is deployed,
 // so user can provide here even CRs provisioned by the OLM.
@@ -419,7 +501,7 @@ func (mgr *Manager) ValidateHost(ctx context.Context, cluster *common.Cluster, h
 		}
 		results = append(results, result)
 	}
-	return results, nil
+	return mergeDeclarativeValidationResults(results, declarative.HostValidationID), nil
 }
 
 // ValidateCluster validates cluster requirements
@@ -460,7 +542,7 @@ func (mgr *Manager) ValidateCluster(ctx context.Context, cluster *common.Cluster
 			results = append(results, result)
 		}
 	}
-	return results, nil
+	return mergeDeclarativeValidationResults(results, declarative.ClusterValidationID), nil
 }
 
 // GetSupportedOperators returns a list of OLM operators that are supported
@@ -684,6 +766,23 @@ func (mgr *Manager) EnsureOperatorPrerequisite(cluster *common.Cluster, openshif
 		return err
 	}
 
+	err = mgr.EnsureOperatorSubscriptionPinning(openshiftVersion, operators)
+	if err != nil {
+		return err
+	}
+
+	err = mgr.EnsureOperatorProperties(operators)
+	if err != nil {
+		return err
+	}
+
+	if cluster != nil {
+		err = mgr.EnsureOperatorsCatalogSource(cluster.OperatorsCatalogSource, operators)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -691,7 +790,11 @@ func (mgr *Manager) EnsureOperatorPrerequisite(cluster *common.Cluster, openshif
 func (mgr *Manager) ListBundles(filters *featuresupport.SupportLevelFilters, featureIDs []models.FeatureSupportLevelID) []*models.Bundle {
 	var ret []*models.Bundle
 
-	for _, basicBundleDetails := range operatorscommon.Bundles {
+	for _, basicBundleDetails := range operatorscommon.AllBundles() {
+		if funk.ContainsString(mgr.hiddenBundles, basicBundleDetails.ID) {
+			continue
+		}
+
 		// Get the bundle with operators based on feature IDs
 		completeBundleDetails, err := mgr.GetBundle(basicBundleDetails.ID, featureIDs)
 		if err != nil {
@@ -715,12 +818,25 @@ func (mgr *Manager) GetBundle(bundleID string, featureIDs []models.FeatureSuppor
 		return nil, fmt.Errorf("bundle '%s' is not supported", bundleID)
 	}
 
+	// The bundles defined by the administrator have no operators when an incompatible feature is used
+	if definition, ok := mgr.bundleDefinitions[bundleID]; ok {
+		for _, featureID := range featureIDs {
+			if funk.Contains(definition.FeatureSupport.IncompatibleFeatures, featureID) {
+				return bundle, nil
+			}
+		}
+		bundle.Operators = append(bundle.Operators, definition.Operators...)
+	}
+
 	// Get all operators for the bundle based on feature IDs
 	for _, operator := range mgr.olmOperators {
+		operatorName := operator.GetName()
+		if funk.ContainsString(bundle.Operators, operatorName) {
+			continue
+		}
 		operatorBundles := operator.GetBundleLabels(featureIDs)
 		for _, operatorBundle := range operatorBundles {
 			if operatorBundle == bundleID {
-				operatorName := operator.GetName()
 				bundle.Operators = append(bundle.Operators, operatorName)
 				break
 			}
@@ -734,7 +850,10 @@ func (mgr *Manager) GetBundle(bundleID string, featureIDs []models.FeatureSuppor
 // bundle and a boolean flag indicating if it was found. Note that the result does not contain the list of operators
 // that are part of the bundle.
 func (mgr *Manager) lookupBundle(bundleID string) (result *models.Bundle, ok bool) {
-	for _, bundle := range operatorscommon.Bundles {
+	if funk.ContainsString(mgr.hiddenBundles, bundleID) {
+		return
+	}
+	for _, bundle := range operatorscommon.AllBundles() {
 		if bundle.ID == bundleID {
 			result = new(models.Bundle)
 			*result = *bundle
@@ -772,6 +891,10 @@ func (mgr *Manager) isBundleSupported(bundle *models.Bundle, filters *featuresup
 		return true
 	}
 
+	if definition, ok := mgr.bundleDefinitions[bundle.ID]; ok && !isBundleDefinitionSupported(definition, *filters) {
+		return false
+	}
+
 	// Check each operator in the bundle using featuresupport API
 	for _, operatorName := range bundle.Operators {
 		operatorFeatureSupportID, err := mgr.getOperatorFeatureSupportID(operatorName)
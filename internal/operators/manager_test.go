@@ -6,6 +6,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
 	"regexp"
 
 	"github.com/go-openapi/strfmt"
@@ -16,6 +19,10 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/constants"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/featuresupport"
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -37,6 +44,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/nodehealthcheck"
 	"github.com/openshift/assisted-service/internal/operators/nodemaintenance"
 	"github.com/openshift/assisted-service/internal/operators/numaresources"
+	"github.com/openshift/assisted-service/internal/operators/nvidiagpu"
 	"github.com/openshift/assisted-service/internal/operators/oadp"
 	"github.com/openshift/assisted-service/internal/operators/odf"
 	"github.com/openshift/assisted-service/internal/operators/openshiftai"
@@ -544,7 +552,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateCluster(context.TODO(), cluster)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(28))
+			Expect(results).To(HaveLen(29))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied), Reasons: []string{"lso is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied), Reasons: []string{"odf is disabled"}},
@@ -586,7 +594,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateCluster(context.TODO(), cluster)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(28))
+			Expect(results).To(HaveLen(29))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied)},
 				api.ValidationResult{Status: api.Failure, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied),
@@ -628,7 +636,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(28))
+			Expect(results).To(HaveLen(29))
 
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied), Reasons: []string{"lso is disabled"}},
@@ -670,7 +678,7 @@ var _ = Describe("Operators manager", func() {
 
 			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(28))
+			Expect(results).To(HaveLen(29))
 
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied), Reasons: []string{}},
@@ -865,6 +873,15 @@ var _ = Describe("Operators manager", func() {
 		It("should provide properties of an operator", func() {
 			properties, err := manager.GetOperatorProperties("odf")
 
+			Expect(err).ToNot(HaveOccurred())
+			Expect(properties).To(HaveLen(2))
+			Expect(properties[0].Name).To(Equal("storage_class_name"))
+			Expect(properties[1].Name).To(Equal("replica"))
+		})
+
+		It("should provide no properties for an operator that can't be configured", func() {
+			properties, err := manager.GetOperatorProperties("lso")
+
 			Expect(err).ToNot(HaveOccurred())
 			Expect(properties).To(BeEquivalentTo(models.OperatorProperties{}))
 		})
@@ -964,6 +981,646 @@ var _ = Describe("Operators manager", func() {
 		})
 	})
 
+	Context("Operator properties", func() {
+		withProperties := func(operator models.MonitoredOperator, properties string) *models.MonitoredOperator {
+			operator.Properties = properties
+			return &operator
+		}
+
+		It("accepts valid properties", func() {
+			operators := []*models.MonitoredOperator{
+				withProperties(lvm.Operator, `{"device_class_name": "fast", "device_paths": ["/dev/sdb"], "thin_pool_size_percent": 80}`),
+			}
+			Expect(manager.EnsureOperatorPrerequisite(cluster, "4.16.0", models.ClusterCPUArchitectureX8664, operators)).To(Succeed())
+		})
+
+		It("rejects properties that the operator doesn't describe", func() {
+			operators := []*models.MonitoredOperator{withProperties(lvm.Operator, `{"device_selector": "/dev/sdb"}`)}
+			err := manager.EnsureOperatorPrerequisite(cluster, "4.16.0", models.ClusterCPUArchitectureX8664, operators)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid properties of operator lvm"))
+		})
+
+		It("rejects properties with the wrong type", func() {
+			operators := []*models.MonitoredOperator{withProperties(odf.Operator, `{"replica": "3"}`)}
+			err := manager.EnsureOperatorPrerequisite(cluster, "4.16.0", models.ClusterCPUArchitectureX8664, operators)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid properties of operator odf"))
+		})
+
+		DescribeTable("rejects properties out of their bounds",
+			func(operator models.MonitoredOperator, properties string) {
+				operators := []*models.MonitoredOperator{withProperties(operator, properties)}
+				err := manager.EnsureOperatorPrerequisite(cluster, "4.16.0", models.ClusterCPUArchitectureX8664, operators)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid properties of operator " + operator.Name))
+			},
+			Entry("negative thin pool size", lvm.Operator, `{"thin_pool_size_percent": -10}`),
+			Entry("thin pool larger than the volume group", lvm.Operator, `{"thin_pool_size_percent": 95}`),
+			Entry("no overprovisioning", lvm.Operator, `{"overprovision_ratio": 0}`),
+			Entry("device path outside /dev", lvm.Operator, `{"device_paths": ["/tmp/disk"]}`),
+			Entry("device path breaking the YAML", lvm.Operator, `{"device_paths": ["/dev/sdb\"\n- /dev/sdc"]}`),
+			Entry("two replicas", odf.Operator, `{"replica": 2}`),
+			Entry("storage class breaking the YAML", odf.Operator, `{"storage_class_name": "local'\nx: y"}`),
+			Entry("invalid storage class", cnv.Operator, `{"vm_state_storage_class": "Fast Disks"}`),
+		)
+
+		It("doesn't verify the properties of operators that don't describe them", func() {
+			operators := []*models.MonitoredOperator{withProperties(lso.Operator, "blob-info")}
+			Expect(manager.EnsureOperatorPrerequisite(cluster, "4.16.0", models.ClusterCPUArchitectureX8664, operators)).To(Succeed())
+		})
+
+		It("renders the properties in the manifests", func() {
+			cluster.OpenshiftVersion = "4.16.0"
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				withProperties(lvm.Operator, `{"device_class_name": "fast", "device_paths": ["/dev/sdb"]}`),
+				&mce.Operator,
+			}
+			var controllerManifests string
+			mockS3Api.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, data []byte, _ string) error {
+					controllerManifests = string(data)
+					return nil
+				}).Times(1)
+			manifestsAPI.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any(), false).Return(&models.Manifest{}, nil).AnyTimes()
+			Expect(manager.GenerateManifests(ctx, cluster)).To(Succeed())
+
+			var manifests []operators.Manifest
+			Expect(json.Unmarshal([]byte(controllerManifests), &manifests)).To(Succeed())
+			contents := map[string]string{}
+			for _, manifest := range manifests {
+				content, err := base64.StdEncoding.DecodeString(manifest.Content)
+				Expect(err).ToNot(HaveOccurred())
+				contents[manifest.Name] += string(content)
+			}
+			Expect(contents[lvm.Operator.Name]).To(ContainSubstring("- name: fast"))
+			Expect(contents[lvm.Operator.Name]).To(ContainSubstring(`- "/dev/sdb"`))
+			Expect(contents[lvm.Operator.Name]).To(ContainSubstring("storageClassName: lvms-fast"))
+		})
+	})
+
+	Context("Subscription pinning", func() {
+		pinnedOperator := func(operator models.MonitoredOperator, channel, startingCSV string, approval models.OperatorInstallPlanApproval) *models.MonitoredOperator {
+			operator.Channel = channel
+			operator.StartingCsv = startingCSV
+			operator.InstallPlanApproval = approval
+			return &operator
+		}
+
+		It("renders the pinned channel, version and approval in the subscription", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				pinnedOperator(lso.Operator, "stable", "local-storage-operator.v4.14.0-202311031050", models.OperatorInstallPlanApprovalManual),
+			}
+			subscriptions := map[string]map[string]interface{}{}
+			mockS3Api.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			manifestsAPI.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any(), false).DoAndReturn(
+				func(_ context.Context, params operations.V2CreateClusterManifestParams, _ bool) (*models.Manifest, error) {
+					content, err := base64.StdEncoding.DecodeString(*params.CreateManifestParams.Content)
+					Expect(err).ToNot(HaveOccurred())
+					var manifest map[string]interface{}
+					Expect(yaml.Unmarshal(content, &manifest)).To(Succeed())
+					if manifest["kind"] == "Subscription" {
+						subscriptions[*params.CreateManifestParams.FileName] = manifest
+					}
+					return &models.Manifest{}, nil
+				}).Times(6)
+			Expect(manager.GenerateManifests(ctx, cluster)).To(Succeed())
+
+			Expect(subscriptions).To(HaveLen(1))
+			Expect(subscriptions).To(HaveKey("50_openshift-lso_subscription.yaml"))
+			spec := subscriptions["50_openshift-lso_subscription.yaml"]["spec"]
+			Expect(spec).To(HaveKeyWithValue("name", "local-storage-operator"))
+			Expect(spec).To(HaveKeyWithValue("channel", "stable"))
+			Expect(spec).To(HaveKeyWithValue("startingCSV", "local-storage-operator.v4.14.0-202311031050"))
+			Expect(spec).To(HaveKeyWithValue("installPlanApproval", "Manual"))
+		})
+
+		It("accepts operators that are not pinned", func() {
+			Expect(manager.EnsureOperatorSubscriptionPinning("4.14.0", []*models.MonitoredOperator{&lso.Operator, &nmstate.Operator})).To(Succeed())
+		})
+
+		It("accepts the known channels of the OpenShift version", func() {
+			Expect(manager.EnsureOperatorSubscriptionPinning("4.14.0", []*models.MonitoredOperator{
+				pinnedOperator(odf.Operator, "stable-4.14", "", ""),
+				pinnedOperator(lso.Operator, "stable", "", models.OperatorInstallPlanApprovalAutomatic),
+			})).To(Succeed())
+		})
+
+		It("rejects the channels of another OpenShift version", func() {
+			err := manager.EnsureOperatorSubscriptionPinning("4.15.2", []*models.MonitoredOperator{
+				pinnedOperator(odf.Operator, "stable-4.14", "", ""),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("channel stable-4.14 of operator odf isn't available for OpenShift version 4.15.2, the known channels are: stable-4.15"))
+		})
+
+		It("rejects channels of operators without known channels", func() {
+			err := manager.EnsureOperatorSubscriptionPinning("4.14.0", []*models.MonitoredOperator{
+				pinnedOperator(nmstate.Operator, "stable", "", ""),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the channels of operator nmstate aren't known"))
+		})
+
+		It("allows pinning the version of operators without known channels", func() {
+			Expect(manager.EnsureOperatorSubscriptionPinning("4.14.0", []*models.MonitoredOperator{
+				pinnedOperator(nmstate.Operator, "", "kubernetes-nmstate-operator.4.14.0-202310201027", models.OperatorInstallPlanApprovalManual),
+			})).To(Succeed())
+		})
+
+		It("rejects invalid versions and approvals", func() {
+			err := manager.EnsureOperatorSubscriptionPinning("4.14.0", []*models.MonitoredOperator{
+				pinnedOperator(lso.Operator, "", "Local Storage 4.14", ""),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid starting cluster service version"))
+
+			err = manager.EnsureOperatorSubscriptionPinning("4.14.0", []*models.MonitoredOperator{
+				pinnedOperator(lso.Operator, "", "", "Sometimes"),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid install plan approval"))
+		})
+
+		It("validates the pinning together with the other prerequisites", func() {
+			err := manager.EnsureOperatorPrerequisite(cluster, "4.14.0", models.ClusterCPUArchitectureX8664, []*models.MonitoredOperator{
+				pinnedOperator(lso.Operator, "preview", "", ""),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("channel preview of operator lso isn't available"))
+		})
+	})
+
+	Context("Operators catalog source", func() {
+		var manifests map[string]map[string]interface{}
+
+		catalogSource := &models.OperatorsCatalogSource{
+			Name:       "cs-redhat-operator-index",
+			IndexImage: "mirror.example.com:5000/redhat/redhat-operator-index:v4.14",
+			Packages:   []string{"local-storage-operator", "odf-operator"},
+		}
+
+		expectManifests := func(times int) {
+			manifests = map[string]map[string]interface{}{}
+			mockS3Api.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			manifestsAPI.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any(), false).DoAndReturn(
+				func(_ context.Context, params operations.V2CreateClusterManifestParams, _ bool) (*models.Manifest, error) {
+					content, err := base64.StdEncoding.DecodeString(*params.CreateManifestParams.Content)
+					Expect(err).ToNot(HaveOccurred())
+					var manifest map[string]interface{}
+					Expect(yaml.Unmarshal(content, &manifest)).To(Succeed())
+					manifests[*params.CreateManifestParams.FileName] = manifest
+					return &models.Manifest{}, nil
+				}).Times(times)
+		}
+
+		It("makes the subscriptions use the catalog source of the cluster", func() {
+			cluster.OperatorsCatalogSource = catalogSource
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&lso.Operator}
+			expectManifests(7)
+			Expect(manager.GenerateManifests(ctx, cluster)).To(Succeed())
+
+			Expect(manifests["50_openshift-lso_subscription.yaml"]["spec"]).To(And(
+				HaveKeyWithValue("source", "cs-redhat-operator-index"),
+				HaveKeyWithValue("sourceNamespace", "openshift-marketplace"),
+			))
+			Expect(manifests).To(HaveKey("50_openshift-marketplace_cs-redhat-operator-index_catalog_source.yaml"))
+			catalog := manifests["50_openshift-marketplace_cs-redhat-operator-index_catalog_source.yaml"]
+			Expect(catalog["kind"]).To(Equal("CatalogSource"))
+			Expect(catalog["metadata"]).To(HaveKeyWithValue("namespace", "openshift-marketplace"))
+			Expect(catalog["spec"]).To(And(
+				HaveKeyWithValue("sourceType", "grpc"),
+				HaveKeyWithValue("image", "mirror.example.com:5000/redhat/redhat-operator-index:v4.14"),
+				HaveKeyWithValue("displayName", "cs-redhat-operator-index"),
+			))
+		})
+
+		It("uses the default catalog source of the service", func() {
+			manager = operators.NewManager(log, manifestsAPI, operators.Options{
+				CatalogSourceName:       "mirrored-operators",
+				CatalogSourceIndexImage: "mirror.example.com/olm/index:latest",
+			}, mockS3Api)
+			cluster.OperatorsCatalogSource = &models.OperatorsCatalogSource{}
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&lso.Operator}
+			expectManifests(7)
+			Expect(manager.GenerateManifests(ctx, cluster)).To(Succeed())
+
+			Expect(manifests["50_openshift-lso_subscription.yaml"]["spec"]).To(HaveKeyWithValue("source", "mirrored-operators"))
+			Expect(manifests).To(HaveKey("50_openshift-marketplace_mirrored-operators_catalog_source.yaml"))
+		})
+
+		It("keeps the default catalogs without a catalog source", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&lso.Operator}
+			expectManifests(6)
+			Expect(manager.GenerateManifests(ctx, cluster)).To(Succeed())
+
+			Expect(manifests["50_openshift-lso_subscription.yaml"]["spec"]).To(HaveKeyWithValue("source", "redhat-operators"))
+		})
+
+		It("verifies that the catalog source contains the packages of the operators", func() {
+			Expect(manager.EnsureOperatorsCatalogSource(catalogSource, []*models.MonitoredOperator{&lso.Operator, &odf.Operator, &common.TestDefaultConfig.MonitoredOperator})).To(Succeed())
+
+			err := manager.EnsureOperatorsCatalogSource(catalogSource, []*models.MonitoredOperator{&lso.Operator, &cnv.Operator})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("operators catalog source cs-redhat-operator-index doesn't contain the packages " + cnv.Operator.SubscriptionName))
+
+			err = manager.EnsureOperatorPrerequisite(&common.Cluster{Cluster: models.Cluster{OperatorsCatalogSource: catalogSource}},
+				"4.14.0", models.ClusterCPUArchitectureX8664, []*models.MonitoredOperator{&cnv.Operator})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("accepts any package when the catalog source doesn't list them", func() {
+			Expect(manager.EnsureOperatorsCatalogSource(&models.OperatorsCatalogSource{
+				Name:       "mirrored-operators",
+				IndexImage: "mirror.example.com/olm/index:latest",
+			}, []*models.MonitoredOperator{&cnv.Operator})).To(Succeed())
+			Expect(manager.EnsureOperatorsCatalogSource(nil, []*models.MonitoredOperator{&cnv.Operator})).To(Succeed())
+		})
+
+		It("rejects invalid catalog sources", func() {
+			err := operators.ValidateCatalogSource(&models.OperatorsCatalogSource{Name: "Mirrored Operators", IndexImage: "mirror.example.com/olm/index:latest"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid operators catalog source name"))
+
+			err = operators.ValidateCatalogSource(&models.OperatorsCatalogSource{Name: "mirrored-operators", IndexImage: "mirror.example.com/olm/index:Latest!"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid operators catalog source index image"))
+
+			Expect(operators.ValidateCatalogSource(&models.OperatorsCatalogSource{})).To(Succeed())
+		})
+	})
+
+	Context("Day2 manifests", func() {
+		It("generates the manifests of the operators without adding them to the installation", func() {
+			cluster.OperatorsCatalogSource = &models.OperatorsCatalogSource{
+				Name:       "mirrored-operators",
+				IndexImage: "mirror.example.com/olm/index:latest",
+			}
+			operator := lso.Operator
+			operator.Channel = "stable"
+
+			openshiftManifests, customManifests, err := manager.GenerateDay2Manifests(cluster, []*models.MonitoredOperator{&operator})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(openshiftManifests).To(HaveKey("50_openshift-lso_subscription.yaml"))
+			var subscription map[string]interface{}
+			Expect(yaml.Unmarshal(openshiftManifests["50_openshift-lso_subscription.yaml"], &subscription)).To(Succeed())
+			Expect(subscription["spec"]).To(And(
+				HaveKeyWithValue("source", "mirrored-operators"),
+				HaveKeyWithValue("channel", "stable"),
+			))
+			Expect(openshiftManifests).To(HaveKey("50_openshift-marketplace_mirrored-operators_catalog_source.yaml"))
+			Expect(customManifests).To(HaveKey(lso.Operator.Name))
+		})
+
+		It("rejects operators that aren't OLM operators", func() {
+			_, _, err := manager.GenerateDay2Manifests(cluster, []*models.MonitoredOperator{&operators.OperatorConsole})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("operator console can't be installed in an installed cluster"))
+		})
+	})
+
+	Context("Dependency graph", func() {
+		findNode := func(graph *models.OperatorsDependencyGraph, name string) *models.OperatorDependencyNode {
+			for _, node := range graph.Operators {
+				if node.Name == name {
+					return node
+				}
+			}
+			return nil
+		}
+
+		BeforeEach(func() {
+			cluster.OpenshiftVersion = "4.16.0"
+			cluster.CPUArchitecture = models.ClusterCPUArchitectureX8664
+			cluster.ControlPlaneCount = 3
+		})
+
+		It("explains why each dependency is pulled in", func() {
+			graph, err := manager.GetDependencyGraph(ctx, cluster, []string{openshiftai.Operator.Name}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(graph.Operators[0].Name).To(Equal(openshiftai.Operator.Name))
+			Expect(graph.Operators[0].Requested).To(BeTrue())
+			Expect(graph.Operators[0].Dependencies).To(ContainElement(nvidiagpu.Operator.Name))
+			Expect(findNode(graph, lvm.Operator.Name)).To(BeNil())
+
+			nvidia := findNode(graph, nvidiagpu.Operator.Name)
+			Expect(nvidia).ToNot(BeNil())
+			Expect(nvidia.Requested).To(BeFalse())
+			Expect(nvidia.RequiredBy).To(ContainElement(openshiftai.Operator.Name))
+
+			nfd := findNode(graph, nodefeaturediscovery.Operator.Name)
+			Expect(nfd).ToNot(BeNil())
+			Expect(nfd.RequiredBy).To(ContainElement(nvidiagpu.Operator.Name))
+			Expect(nfd.SupportLevel).ToNot(BeEmpty())
+		})
+
+		It("pulls in different dependencies for single node clusters", func() {
+			cluster.ControlPlaneCount = 1
+
+			graph, err := manager.GetDependencyGraph(ctx, cluster, []string{openshiftai.Operator.Name}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			node := findNode(graph, lvm.Operator.Name)
+			Expect(node).ToNot(BeNil())
+			Expect(node.RequiredBy).To(ConsistOf(openshiftai.Operator.Name))
+		})
+
+		It("aggregates the requirements of all the operators", func() {
+			graph, err := manager.GetDependencyGraph(ctx, cluster, []string{cnv.Operator.Name}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			var cpuCores, ramMib int64
+			for _, node := range graph.Operators {
+				cpuCores += node.Requirements.Master.Quantitative.CPUCores
+				ramMib += node.Requirements.Master.Quantitative.RAMMib
+			}
+			Expect(findNode(graph, lso.Operator.Name)).ToNot(BeNil())
+			Expect(graph.Requirements.Master.Quantitative.CPUCores).To(Equal(cpuCores))
+			Expect(graph.Requirements.Master.Quantitative.RAMMib).To(Equal(ramMib))
+		})
+
+		It("reports the incompatible features", func() {
+			graph, err := manager.GetDependencyGraph(ctx, cluster, []string{odf.Operator.Name, lvm.Operator.Name},
+				[]models.FeatureSupportLevelID{models.FeatureSupportLevelIDSNO})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(graph.Conflicts).To(ContainElements(
+				&models.OperatorDependencyConflict{
+					Operator:  odf.Operator.Name,
+					FeatureID: models.FeatureSupportLevelIDSNO,
+					Message:   "operator odf is incompatible with SNO",
+				},
+				&models.OperatorDependencyConflict{
+					Operator:  odf.Operator.Name,
+					FeatureID: models.FeatureSupportLevelIDLVM,
+					Message:   "operator odf is incompatible with LVM",
+				},
+			))
+		})
+
+		It("doesn't compute support levels and conflicts without an OpenShift version", func() {
+			cluster.OpenshiftVersion = ""
+
+			graph, err := manager.GetDependencyGraph(ctx, cluster, []string{odf.Operator.Name, lvm.Operator.Name}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(graph.Conflicts).To(BeEmpty())
+			Expect(graph.Operators[0].SupportLevel).To(BeEmpty())
+		})
+
+		It("rejects unknown operators", func() {
+			_, err := manager.GetDependencyGraph(ctx, cluster, []string{"unknown"}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("operator unknown isn't supported"))
+		})
+	})
+
+	Context("Storage sizing", func() {
+		var hostsDisks map[strfmt.UUID][]*models.Disk
+
+		addHost := func(role models.HostRole, disks ...*models.Disk) *models.Host {
+			hostID := strfmt.UUID(uuid.New().String())
+			host := &models.Host{ID: &hostID, Role: role, RequestedHostname: fmt.Sprintf("host-%d", len(cluster.Hosts))}
+			cluster.Hosts = append(cluster.Hosts, host)
+			hostsDisks[hostID] = disks
+			return host
+		}
+
+		newDisk := func(path string, sizeGB int64) *models.Disk {
+			return &models.Disk{ID: path, Path: path, SizeBytes: conversions.GbToBytes(sizeGB), DriveType: models.DriveTypeSSD}
+		}
+
+		BeforeEach(func() {
+			hostsDisks = make(map[strfmt.UUID][]*models.Disk)
+		})
+
+		It("removes the copies of the data and the reserved space of ODF", func() {
+			for i := 0; i < 3; i++ {
+				addHost(models.HostRoleMaster, newDisk("/dev/sdb", 100), newDisk("/dev/sdc", 100))
+			}
+
+			sizing, err := manager.GetStorageSizing(ctx, cluster, hostsDisks, &models.StorageSizingParams{
+				OperatorName:     swag.String(odf.Operator.Name),
+				TargetCapacityGb: swag.Int64(500),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sizing.Replica).To(BeEquivalentTo(3))
+			Expect(sizing.ReservedPercentage).To(BeEquivalentTo(15))
+			Expect(sizing.Hosts).To(HaveLen(3))
+			Expect(sizing.Hosts[0].Disks).To(HaveLen(2))
+			Expect(sizing.RawCapacityBytes).To(Equal(conversions.GbToBytes(600)))
+			Expect(sizing.UsableCapacityBytes).To(Equal(conversions.GbToBytes(170)))
+			Expect(sizing.TargetCapacityBytes).To(Equal(conversions.GbToBytes(500)))
+			Expect(sizing.Warnings).To(ConsistOf(ContainSubstring("below the target capacity")))
+		})
+
+		It("uses the properties of the LVM operator", func() {
+			cluster.ControlPlaneCount = 1
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				{Name: lvm.Operator.Name, Properties: `{"device_paths": ["/dev/sdb"], "thin_pool_size_percent": 80}`},
+			}
+			addHost(models.HostRoleMaster, newDisk("/dev/sdb", 100), newDisk("/dev/sdc", 100))
+
+			sizing, err := manager.GetStorageSizing(ctx, cluster, hostsDisks, &models.StorageSizingParams{
+				OperatorName: swag.String(lvm.Operator.Name),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sizing.DevicePaths).To(Equal([]string{"/dev/sdb"}))
+			Expect(sizing.Hosts[0].Disks).To(HaveLen(1))
+			Expect(sizing.UsableCapacityBytes).To(Equal(conversions.GbToBytes(80)))
+			Expect(sizing.Warnings).To(BeEmpty())
+		})
+
+		It("overrides the defaults of the operator with the parameters", func() {
+			addHost(models.HostRoleWorker, newDisk("/dev/nvme0n1", 100), newDisk("/dev/sdb", 100))
+			addHost(models.HostRoleWorker, newDisk("/dev/nvme0n1", 100))
+
+			sizing, err := manager.GetStorageSizing(ctx, cluster, hostsDisks, &models.StorageSizingParams{
+				OperatorName:       swag.String(lso.Operator.Name),
+				Replica:            2,
+				ReservedPercentage: swag.Int64(50),
+				DevicePaths:        []string{"/dev/nvme*"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sizing.RawCapacityBytes).To(Equal(conversions.GbToBytes(200)))
+			Expect(sizing.UsableCapacityBytes).To(Equal(conversions.GbToBytes(50)))
+		})
+
+		It("warns about the hosts that can't provide storage", func() {
+			addHost(models.HostRoleWorker, newDisk("/dev/sdb", 100))
+			addHost(models.HostRoleWorker, &models.Disk{ID: "/dev/sr0", Path: "/dev/sr0", DriveType: models.DriveTypeODD, SizeBytes: conversions.GbToBytes(1)})
+			host := addHost(models.HostRoleWorker)
+			delete(hostsDisks, *host.ID)
+
+			sizing, err := manager.GetStorageSizing(ctx, cluster, hostsDisks, &models.StorageSizingParams{
+				OperatorName: swag.String(lso.Operator.Name),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sizing.Hosts).To(HaveLen(2))
+			Expect(sizing.Warnings).To(ConsistOf(
+				ContainSubstring("host-1 has no disk that can be used"),
+				ContainSubstring("host-2 has no inventory"),
+			))
+		})
+
+		It("fails for the operators that don't provide storage", func() {
+			_, err := manager.GetStorageSizing(ctx, cluster, hostsDisks, &models.StorageSizingParams{
+				OperatorName: swag.String(cnv.Operator.Name),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusBadRequest))
+		})
+	})
+
+	Context("Removed operators manifests", func() {
+		BeforeEach(func() {
+			cluster.Status = swag.String(models.ClusterStatusReady)
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&lso.Operator, &cnv.Operator}
+		})
+
+		It("deletes the generated manifests of the removed operators and the shared ones", func() {
+			manifestsAPI.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(models.ListManifests{
+				{Folder: models.ManifestFolderOpenshift, FileName: "50_openshift-lso_ns.yaml", ManifestSource: constants.ManifestSourceSystemGenerated},
+				{Folder: models.ManifestFolderOpenshift, FileName: "50_openshift-lso_subscription.yaml", ManifestSource: constants.ManifestSourceUserSupplied},
+				{Folder: models.ManifestFolderManifests, FileName: "50_openshift-lso_operator_group.yaml", ManifestSource: constants.ManifestSourceSystemGenerated},
+				{Folder: models.ManifestFolderOpenshift, FileName: "50_openshift-cnv_subscription.yaml", ManifestSource: constants.ManifestSourceSystemGenerated},
+				{Folder: models.ManifestFolderOpenshift, FileName: "olm_operator_manifests.yaml", ManifestSource: constants.ManifestSourceSystemGenerated},
+			}, nil).Times(1)
+			var deleted []string
+			manifestsAPI.EXPECT().DeleteClusterManifestInternal(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, params operations.V2DeleteClusterManifestParams) error {
+					Expect(swag.StringValue(params.Folder)).To(Equal(models.ManifestFolderOpenshift))
+					deleted = append(deleted, params.FileName)
+					return nil
+				}).Times(2)
+			mockS3Api.EXPECT().DeleteObject(gomock.Any(), filepath.Join(cluster.ID.String(), "custom_manifests.json")).Return(true, nil).Times(1)
+
+			removed, err := manager.RemoveOperatorsManifests(ctx, cluster, []*models.MonitoredOperator{&lso.Operator})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(ConsistOf("50_openshift-lso_ns.yaml", "olm_operator_manifests.yaml"))
+			Expect(removed).To(Equal([]string{"50_openshift-lso_ns.yaml", "custom_manifests.json", "olm_operator_manifests.yaml"}))
+		})
+
+		It("doesn't delete anything once the installation started", func() {
+			cluster.Status = swag.String(models.ClusterStatusInstalling)
+
+			removed, err := manager.RemoveOperatorsManifests(ctx, cluster, []*models.MonitoredOperator{&lso.Operator})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeEmpty())
+		})
+
+		It("fails when the manifests can't be listed", func() {
+			manifestsAPI.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(nil, errors.New("list failed")).Times(1)
+
+			_, err := manager.RemoveOperatorsManifests(ctx, cluster, []*models.MonitoredOperator{&lso.Operator})
+			Expect(err).To(MatchError(ContainSubstring("list failed")))
+		})
+	})
+
+	Context("Cleanup of removed operators", func() {
+		var (
+			mockOperatorsAPI *operators.MockAPI
+			mockEvents       *eventsapi.MockHandler
+		)
+
+		BeforeEach(func() {
+			mockOperatorsAPI = operators.NewMockAPI(ctrl)
+			mockEvents = eventsapi.NewMockHandler(ctrl)
+		})
+
+		It("reports the removed operators and their deleted manifests", func() {
+			mockOperatorsAPI.EXPECT().RemoveOperatorsManifests(gomock.Any(), cluster, gomock.Any()).
+				Return([]string{"50_openshift-lso_ns.yaml", "olm_operator_manifests.yaml"}, nil).Times(1)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorsRemovedEventName),
+				eventstest.WithClusterIdMatcher(cluster.ID.String()),
+				eventstest.WithMessageContainsMatcher("lso, cnv"),
+				eventstest.WithMessageContainsMatcher("50_openshift-lso_ns.yaml, olm_operator_manifests.yaml"))).Times(1)
+
+			operators.CleanupRemovedOperators(ctx, log, mockOperatorsAPI, mockEvents, cluster,
+				[]*models.MonitoredOperator{&lso.Operator, &cnv.Operator})
+		})
+
+		It("reports the removed operators when their manifests can't be deleted", func() {
+			mockOperatorsAPI.EXPECT().RemoveOperatorsManifests(gomock.Any(), cluster, gomock.Any()).
+				Return(nil, errors.New("list failed")).Times(1)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorsRemovedEventName),
+				eventstest.WithMessageContainsMatcher("none"))).Times(1)
+
+			operators.CleanupRemovedOperators(ctx, log, mockOperatorsAPI, mockEvents, cluster, []*models.MonitoredOperator{&lso.Operator})
+		})
+
+		It("doesn't send events without events handler", func() {
+			mockOperatorsAPI.EXPECT().RemoveOperatorsManifests(gomock.Any(), cluster, gomock.Any()).Return(nil, nil).Times(1)
+
+			operators.CleanupRemovedOperators(ctx, log, mockOperatorsAPI, nil, cluster, []*models.MonitoredOperator{&lso.Operator})
+		})
+	})
+
+	Context("Declarative operators", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "declarative-operators")
+			Expect(err).ToNot(HaveOccurred())
+			definition := `
+name: my-declarative-operator
+namespace: my-declarative-operator-ns
+dependencies:
+- lso
+featureSupport:
+  minOpenshiftVersion: "4.16"
+`
+			Expect(os.WriteFile(filepath.Join(dir, "operator.yaml"), []byte(definition), 0600)).To(Succeed())
+			manager = operators.NewManager(log, manifestsAPI, operators.Options{DeclarativeOperatorsDir: dir}, mockS3Api)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("adds the declarative operators to the supported operators", func() {
+			Expect(manager.GetSupportedOperators()).To(ContainElements("lso", "my-declarative-operator"))
+			Expect(featuresupport.IsFeatureAvailable("MY_DECLARATIVE_OPERATOR", "4.17", swag.String(models.ClusterCPUArchitectureX8664))).To(BeTrue())
+			Expect(featuresupport.IsFeatureAvailable("MY_DECLARATIVE_OPERATOR", "4.15", swag.String(models.ClusterCPUArchitectureX8664))).To(BeFalse())
+		})
+
+		It("resolves the dependencies of the declarative operators", func() {
+			cluster.OpenshiftVersion = "4.17.0"
+			operators, err := manager.ResolveDependencies(cluster, []*models.MonitoredOperator{
+				{Name: "my-declarative-operator", OperatorType: models.OperatorTypeOlm},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			names := make([]string, 0, len(operators))
+			for _, operator := range operators {
+				names = append(names, operator.Name)
+			}
+			Expect(names).To(ConsistOf("my-declarative-operator", "lso"))
+		})
+
+		It("merges the validations of the declarative operators", func() {
+			cluster.OpenshiftVersion = "4.15.0"
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				{Name: "my-declarative-operator", OperatorType: models.OperatorTypeOlm},
+			}
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(29))
+			Expect(results).To(ContainElement(api.ValidationResult{
+				Status:       api.Failure,
+				ValidationId: string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied),
+				Reasons:      []string{"my-declarative-operator requires OpenShift version 4.16 or newer"},
+			}))
+		})
+	})
+
 	Context("Bundles", func() {
 		// we use the real operators here, as we want to test the manager's ability to group them into bundles
 		var (
@@ -1079,6 +1736,90 @@ var _ = Describe("Operators manager", func() {
 			Expect(bundle.Title).ToNot(BeEmpty())
 		})
 	})
+
+	Context("Custom bundles", func() {
+		var bundlesDir, operatorsDir string
+
+		BeforeEach(func() {
+			var err error
+			bundlesDir, err = os.MkdirTemp("", "bundles")
+			Expect(err).ToNot(HaveOccurred())
+			operatorsDir, err = os.MkdirTemp("", "declarative-operators")
+			Expect(err).ToNot(HaveOccurred())
+			bundle := `
+id: edge-ai
+title: Edge AI
+description: Serve AI models on small clusters.
+operators:
+- lvm
+- nvidia-gpu
+featureSupport:
+  minOpenshiftVersion: "4.16"
+  architectures:
+  - x86_64
+  incompatibleFeatures:
+  - SNO
+`
+			Expect(os.WriteFile(filepath.Join(bundlesDir, "edge-ai.yaml"), []byte(bundle), 0600)).To(Succeed())
+			// The declarative operators can be part of the custom bundles
+			operator := `
+name: my-edge-operator
+namespace: my-edge-operator-ns
+bundles:
+- edge-ai
+`
+			Expect(os.WriteFile(filepath.Join(operatorsDir, "operator.yaml"), []byte(operator), 0600)).To(Succeed())
+			manager = operators.NewManager(log, manifestsAPI, operators.Options{
+				BundlesDir:              bundlesDir,
+				DeclarativeOperatorsDir: operatorsDir,
+				HiddenBundles:           []string{operatorscommon.BundleOpenShiftAI.ID},
+			}, mockS3Api)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(bundlesDir)).To(Succeed())
+			Expect(os.RemoveAll(operatorsDir)).To(Succeed())
+		})
+
+		listBundleIDs := func(openshiftVersion, cpuArchitecture string, featureIDs ...models.FeatureSupportLevelID) []string {
+			bundles := manager.ListBundles(&featuresupport.SupportLevelFilters{
+				OpenshiftVersion: openshiftVersion,
+				CPUArchitecture:  swag.String(cpuArchitecture),
+			}, featureIDs)
+			ids := make([]string, len(bundles))
+			for i, bundle := range bundles {
+				ids[i] = bundle.ID
+			}
+			return ids
+		}
+
+		It("returns the operators of the bundle and the ones that list it", func() {
+			bundle, err := manager.GetBundle("edge-ai", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bundle.Title).To(Equal("Edge AI"))
+			Expect(bundle.Description).To(Equal("Serve AI models on small clusters."))
+			Expect(bundle.Operators).To(ConsistOf("lvm", "nvidia-gpu", "my-edge-operator"))
+		})
+
+		It("lists the bundle only where it is available", func() {
+			Expect(listBundleIDs("4.17", models.ClusterCPUArchitectureX8664)).To(ContainElement("edge-ai"))
+			Expect(listBundleIDs("4.15", models.ClusterCPUArchitectureX8664)).ToNot(ContainElement("edge-ai"))
+			Expect(listBundleIDs("4.17", models.ClusterCPUArchitectureArm64)).ToNot(ContainElement("edge-ai"))
+			Expect(listBundleIDs("4.17", models.ClusterCPUArchitectureX8664, models.FeatureSupportLevelIDSNO)).ToNot(ContainElement("edge-ai"))
+		})
+
+		It("has no operators with an incompatible feature", func() {
+			bundle, err := manager.GetBundle("edge-ai", []models.FeatureSupportLevelID{models.FeatureSupportLevelIDSNO})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bundle.Operators).To(BeEmpty())
+		})
+
+		It("hides the builtin bundles", func() {
+			Expect(listBundleIDs("4.17", models.ClusterCPUArchitectureX8664)).ToNot(ContainElement(operatorscommon.BundleOpenShiftAI.ID))
+			_, err := manager.GetBundle(operatorscommon.BundleOpenShiftAI.ID, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
 func mockOperatorBase(operatorName string) *api.MockOperator {
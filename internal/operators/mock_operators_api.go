@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	strfmt "github.com/go-openapi/strfmt"
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
 	featuresupport "github.com/openshift/assisted-service/internal/featuresupport"
@@ -66,6 +67,36 @@ func (mr *MockAPIMockRecorder) EnsureOperatorPrerequisite(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureOperatorPrerequisite", reflect.TypeOf((*MockAPI)(nil).EnsureOperatorPrerequisite), arg0, arg1, arg2, arg3)
 }
 
+// EnsureOperatorsCatalogSource mocks base method.
+func (m *MockAPI) EnsureOperatorsCatalogSource(arg0 *models.OperatorsCatalogSource, arg1 []*models.MonitoredOperator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureOperatorsCatalogSource", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureOperatorsCatalogSource indicates an expected call of EnsureOperatorsCatalogSource.
+func (mr *MockAPIMockRecorder) EnsureOperatorsCatalogSource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureOperatorsCatalogSource", reflect.TypeOf((*MockAPI)(nil).EnsureOperatorsCatalogSource), arg0, arg1)
+}
+
+// GenerateDay2Manifests mocks base method.
+func (m *MockAPI) GenerateDay2Manifests(arg0 *common.Cluster, arg1 []*models.MonitoredOperator) (map[string][]byte, map[string][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateDay2Manifests", arg0, arg1)
+	ret0, _ := ret[0].(map[string][]byte)
+	ret1, _ := ret[1].(map[string][]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateDay2Manifests indicates an expected call of GenerateDay2Manifests.
+func (mr *MockAPIMockRecorder) GenerateDay2Manifests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDay2Manifests", reflect.TypeOf((*MockAPI)(nil).GenerateDay2Manifests), arg0, arg1)
+}
+
 // GenerateManifests mocks base method.
 func (m *MockAPI) GenerateManifests(arg0 context.Context, arg1 *common.Cluster) error {
 	m.ctrl.T.Helper()
@@ -95,6 +126,21 @@ func (mr *MockAPIMockRecorder) GetBundle(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBundle", reflect.TypeOf((*MockAPI)(nil).GetBundle), arg0, arg1)
 }
 
+// GetDependencyGraph mocks base method.
+func (m *MockAPI) GetDependencyGraph(arg0 context.Context, arg1 *common.Cluster, arg2 []string, arg3 []models.FeatureSupportLevelID) (*models.OperatorsDependencyGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependencyGraph", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.OperatorsDependencyGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependencyGraph indicates an expected call of GetDependencyGraph.
+func (mr *MockAPIMockRecorder) GetDependencyGraph(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyGraph", reflect.TypeOf((*MockAPI)(nil).GetDependencyGraph), arg0, arg1, arg2, arg3)
+}
+
 // GetMonitoredOperatorsList mocks base method.
 func (m *MockAPI) GetMonitoredOperatorsList() map[string]*models.MonitoredOperator {
 	m.ctrl.T.Helper()
@@ -183,6 +229,21 @@ func (mr *MockAPIMockRecorder) GetRequirementsBreakdownForHostInCluster(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequirementsBreakdownForHostInCluster", reflect.TypeOf((*MockAPI)(nil).GetRequirementsBreakdownForHostInCluster), arg0, arg1, arg2)
 }
 
+// GetStorageSizing mocks base method.
+func (m *MockAPI) GetStorageSizing(arg0 context.Context, arg1 *common.Cluster, arg2 map[strfmt.UUID][]*models.Disk, arg3 *models.StorageSizingParams) (*models.StorageSizing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageSizing", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.StorageSizing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageSizing indicates an expected call of GetStorageSizing.
+func (mr *MockAPIMockRecorder) GetStorageSizing(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageSizing", reflect.TypeOf((*MockAPI)(nil).GetStorageSizing), arg0, arg1, arg2, arg3)
+}
+
 // GetSupportedOperators mocks base method.
 func (m *MockAPI) GetSupportedOperators() []string {
 	m.ctrl.T.Helper()
@@ -225,6 +286,21 @@ func (mr *MockAPIMockRecorder) ListBundles(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBundles", reflect.TypeOf((*MockAPI)(nil).ListBundles), arg0, arg1)
 }
 
+// RemoveOperatorsManifests mocks base method.
+func (m *MockAPI) RemoveOperatorsManifests(arg0 context.Context, arg1 *common.Cluster, arg2 []*models.MonitoredOperator) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOperatorsManifests", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOperatorsManifests indicates an expected call of RemoveOperatorsManifests.
+func (mr *MockAPIMockRecorder) RemoveOperatorsManifests(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOperatorsManifests", reflect.TypeOf((*MockAPI)(nil).RemoveOperatorsManifests), arg0, arg1, arg2)
+}
+
 // ResolveDependencies mocks base method.
 func (m *MockAPI) ResolveDependencies(arg0 *common.Cluster, arg1 []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
 	m.ctrl.T.Helper()
@@ -11,4 +11,9 @@ const (
 
 	clusterValidationID = string(models.ClusterValidationIDOdfRequirementsSatisfied)
 	hostValidationID    = string(models.HostValidationIDOdfRequirementsSatisfied)
+
+	// cephPoolReplica is the number of copies of the data kept by the Ceph pools created by ODF
+	cephPoolReplica = 3
+	// cephFullRatioPercent is the usage of the Ceph cluster at which ODF makes the storage read only
+	cephFullRatioPercent = 85
 )
@@ -8,11 +8,23 @@ import (
 )
 
 type storageInfo struct {
-	ODFDisks int64
+	ODFDisks         int64
+	Replica          int64
+	FlexibleScaling  bool
+	StorageClassName string
 }
 
-func generateStorageClusterManifest(StorageClusterManifest string, odfDiskCounts int64) ([]byte, error) {
-	info := &storageInfo{ODFDisks: odfDiskCounts}
+func generateStorageClusterManifest(StorageClusterManifest string, odfDiskCounts int64, properties odfProperties) ([]byte, error) {
+	// The disks are spread among the replicas of the device set, which requires disabling flexible scaling
+	info := &storageInfo{
+		ODFDisks:         odfDiskCounts,
+		Replica:          properties.Replica,
+		FlexibleScaling:  properties.Replica == 1,
+		StorageClassName: properties.StorageClassName,
+	}
+	if properties.Replica > 1 {
+		info.ODFDisks = max(odfDiskCounts/properties.Replica, 1)
+	}
 	tmpl, err := template.New("OcsStorageCluster").Parse(StorageClusterManifest)
 	if err != nil {
 		return nil, err
@@ -28,18 +40,18 @@ func generateStorageClusterManifest(StorageClusterManifest string, odfDiskCounts
 
 }
 
-func Manifests(mode odfDeploymentMode, numberOfDisks int64, openshiftVersion string) (map[string][]byte, []byte, error) {
+func Manifests(mode odfDeploymentMode, numberOfDisks int64, openshiftVersion string, properties odfProperties) (map[string][]byte, []byte, error) {
 	openshiftManifests := make(map[string][]byte)
 	var odfSC []byte
 	var err error
 
 	if mode == compactMode {
-		odfSC, err = generateStorageClusterManifest(ocsMinDeploySC, numberOfDisks)
+		odfSC, err = generateStorageClusterManifest(ocsMinDeploySC, numberOfDisks, properties)
 		if err != nil {
 			return nil, nil, err
 		}
 	} else { // use the ODF CR with labelSelector to deploy ODF on only worker nodes
-		odfSC, err = generateStorageClusterManifest(ocsSc, numberOfDisks)
+		odfSC, err = generateStorageClusterManifest(ocsSc, numberOfDisks, properties)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -186,7 +198,7 @@ spec:
         values:
         - ""
   manageNodes: false
-  flexibleScaling: true
+  flexibleScaling: {{.FlexibleScaling}}
   resources:
     mds:
       limits:
@@ -212,7 +224,7 @@ spec:
           resources:
             requests:
               storage: "1"
-          storageClassName: 'localblock-sc'
+          storageClassName: '{{.StorageClassName}}'
           volumeMode: Block
       name: ocs-deviceset
       placement:
@@ -252,7 +264,7 @@ spec:
               topologyKey: kubernetes.io/hostname
             weight: 100
       portable: false
-      replica: 1
+      replica: {{.Replica}}
       resources:
         limits:
           cpu: "2"
@@ -282,7 +294,7 @@ spec:
         - ""
 
   manageNodes: false
-  flexibleScaling: true
+  flexibleScaling: {{.FlexibleScaling}}
   monDataDirHostPath: /var/lib/rook
 
   storageDeviceSets:
@@ -303,7 +315,7 @@ spec:
 
             storage: "1"
 
-        storageClassName: 'localblock-sc'
+        storageClassName: '{{.StorageClassName}}'
 
         volumeMode: Block
 
@@ -348,5 +360,5 @@ spec:
 
     portable: false
 
-    replica: 1
+    replica: {{.Replica}}
 `
@@ -14,8 +14,10 @@ import (
 var _ = Describe("OCS manifest generation", func() {
 	type StorageCluster struct {
 		Spec struct {
+			FlexibleScaling   bool `yaml:"flexibleScaling"`
 			StorageDeviceSets []struct {
-				Count int `yaml:"count"`
+				Count   int `yaml:"count"`
+				Replica int `yaml:"replica"`
 			} `yaml:"storageDeviceSets"`
 		} `yaml:"spec"`
 	}
@@ -291,4 +293,35 @@ var _ = Describe("OCS manifest generation", func() {
 			Expect(string(manifest)).NotTo(ContainSubstring("kind: StorageSystem"))
 		})
 	})
+
+	Context("Create ODF Manifests with the properties set by the user", func() {
+		It("Spreads the disks among the replicas", func() {
+			odfOperator := Operator
+			odfOperator.Properties = `{"storage_class_name": "my-local-disks", "replica": 3}`
+			hosts := []*models.Host{}
+			for _, role := range []models.HostRole{models.HostRoleMaster, models.HostRoleMaster, models.HostRoleMaster,
+				models.HostRoleWorker, models.HostRoleWorker, models.HostRoleWorker} {
+				hosts = append(hosts, &models.Host{Role: role, InstallationDiskID: diskID1, Inventory: Inventory(&InventoryResources{Disks: []*models.Disk{
+					{ID: diskID1, SizeBytes: conversions.GbToBytes(30), DriveType: models.DriveTypeHDD},
+					{ID: diskID2, SizeBytes: conversions.GbToBytes(30), DriveType: models.DriveTypeHDD},
+				}})})
+			}
+			cluster := common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion:   "4.19.0",
+				Hosts:              hosts,
+				MonitoredOperators: []*models.MonitoredOperator{&odfOperator},
+			}}
+
+			_, manifest, err := operator.GenerateManifests(&cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(manifest)).To(ContainSubstring("storageClassName: 'my-local-disks'"))
+
+			var storageCluster StorageCluster
+			Expect(yaml.Unmarshal(manifest, &storageCluster)).To(Succeed())
+			Expect(storageCluster.Spec.FlexibleScaling).To(BeFalse())
+			Expect(storageCluster.Spec.StorageDeviceSets).To(HaveLen(1))
+			Expect(storageCluster.Spec.StorageDeviceSets[0].Count).To(Equal(1))
+			Expect(storageCluster.Spec.StorageDeviceSets[0].Replica).To(Equal(3))
+		})
+	})
 })
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/go-openapi/swag"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/lib/pq"
 	"github.com/openshift/assisted-service/internal/common"
@@ -113,7 +114,7 @@ func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (
 	return result, nil
 }
 
-func (o *operator) StorageClassName() string {
+func (o *operator) StorageClassName(_ *common.Cluster) string {
 	return defaultStorageClassName
 }
 
@@ -220,13 +221,18 @@ func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte
 		return nil, nil, err
 	}
 
+	properties, err := getProperties(cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	o.log.Info("No. of ODF eligible disks in cluster ", cluster.ID, " are ", odfClusterResources.numberOfDisks)
-	return Manifests(mode, odfClusterResources.numberOfDisks, cluster.OpenshiftVersion)
+	return Manifests(mode, odfClusterResources.numberOfDisks, cluster.OpenshiftVersion, properties)
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return propertiesDescription
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the ODF Operator
@@ -348,3 +354,33 @@ func capitalizeFirstLetter(s string) string {
 	runes[0] = unicode.ToUpper(runes[0])
 	return string(runes)
 }
+
+// GetChannels returns the subscription channels of the ODF operator, which are released together with OpenShift
+func (o *operator) GetChannels(openshiftVersion string) ([]string, error) {
+	majorMinor, err := common.GetMajorMinorVersion(openshiftVersion)
+	if err != nil {
+		return nil, err
+	}
+	return []string{fmt.Sprintf("stable-%s", *majorMinor)}, nil
+}
+
+// GetStorageSizingDefaults returns the sizing of the Ceph cluster, the data is copied three times and the storage
+// becomes read only when it reaches its full ratio
+func (o *operator) GetStorageSizingDefaults(_ *common.Cluster) (*models.StorageSizingParams, error) {
+	return &models.StorageSizingParams{
+		OperatorName:       swag.String(o.GetName()),
+		Replica:            cephPoolReplica,
+		ReservedPercentage: swag.Int64(100 - cephFullRatioPercent),
+	}, nil
+}
+
+// GetStorageDisks returns the disks of the host that can be used by the storage device set, only the hosts that run
+// ODF in the deployment mode of the cluster are used
+func (o *operator) GetStorageDisks(cluster *common.Cluster, host *models.Host, disks []*models.Disk) []*models.Disk {
+	mode := getODFDeploymentMode(&cluster.Cluster, o.config.ODFNumMinimumHosts)
+	shouldHostRunODF, err := shouldHostRunODF(&cluster.Cluster, mode, host.Role)
+	if err != nil || !swag.BoolValue(shouldHostRunODF) {
+		return nil
+	}
+	return operatorscommon.StorageDisks(disks, o.getMinDiskSizeGB(0))
+}
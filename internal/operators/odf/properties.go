@@ -0,0 +1,56 @@
+package odf
+
+import (
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/operators/lso"
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	defaultReplica = 1
+	// minReplica is the minimum number of replicas without flexible scaling, one per failure domain
+	minReplica = 3
+)
+
+// odfProperties are the values that the user can set to configure the StorageCluster created for the cluster
+type odfProperties struct {
+	StorageClassName string `json:"storage_class_name"`
+	Replica          int64  `json:"replica"`
+}
+
+var propertiesDescription = models.OperatorProperties{
+	{
+		Name:         "storage_class_name",
+		DataType:     models.OperatorPropertyDataTypeString,
+		Description:  "Storage class of the local disks used by the storage device set, created by the Local Storage Operator",
+		DefaultValue: lso.DefaultStorageClassName,
+		Pattern:      operatorscommon.ResourceNamePattern,
+	},
+	{
+		Name:     "replica",
+		DataType: models.OperatorPropertyDataTypeInteger,
+		Description: "Number of replicas of the storage device set, one per failure domain, the disks are spread " +
+			"among the replicas. A single replica with flexible scaling is used when not set",
+		Minimum: swag.Float64(minReplica),
+	},
+}
+
+// getProperties returns the properties set by the user for the cluster, with defaults for the missing ones
+func getProperties(cluster *common.Cluster) (odfProperties, error) {
+	ret := odfProperties{
+		StorageClassName: lso.DefaultStorageClassName,
+		Replica:          defaultReplica,
+	}
+	if err := operatorscommon.UnmarshalProperties(cluster.MonitoredOperators, Operator.Name, &ret); err != nil {
+		return ret, err
+	}
+	if ret.StorageClassName == "" {
+		ret.StorageClassName = lso.DefaultStorageClassName
+	}
+	if ret.Replica < 1 {
+		ret.Replica = defaultReplica
+	}
+	return ret, nil
+}
@@ -167,3 +167,8 @@ func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
 func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
 	return []string(Operator.Bundles)
 }
+
+// GetChannels returns the subscription channels of the OpenShift Logging operator
+func (o *operator) GetChannels(openshiftVersion string) ([]string, error) {
+	return []string{Channel}, nil
+}
@@ -0,0 +1,27 @@
+package operators
+
+import (
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// EnsureOperatorProperties verifies the properties that the user set for the operators against the properties that
+// the operators describe. The properties of the operators that don't describe any aren't used, so they aren't
+// verified.
+func (mgr *Manager) EnsureOperatorProperties(operators []*models.MonitoredOperator) error {
+	for _, monitoredOperator := range operators {
+		operator, ok := mgr.olmOperators[monitoredOperator.Name]
+		if !ok || monitoredOperator.Properties == "" {
+			continue
+		}
+		properties := operator.GetProperties()
+		if len(properties) == 0 {
+			continue
+		}
+		if err := operatorscommon.ValidateProperties(properties, monitoredOperator.Properties); err != nil {
+			return errors.Wrapf(err, "invalid properties of operator %s", monitoredOperator.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package operators
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/constants"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+// preInstallationStatuses are the statuses of the clusters whose manifests can still be changed
+var preInstallationStatuses = []string{
+	models.ClusterStatusInsufficient,
+	models.ClusterStatusReady,
+	models.ClusterStatusPendingForInput,
+}
+
+// RemoveOperatorsManifests deletes the manifests that were generated for operators removed from a cluster that isn't
+// installed yet, so that they aren't applied with the manifests of the remaining operators. The manifests shared by
+// all the operators are deleted as well, they are generated again for the remaining operators when the installation
+// starts. The manifests uploaded by the user are never deleted, and nothing is deleted once the installation started.
+// Returns the names of the deleted manifests.
+func (mgr *Manager) RemoveOperatorsManifests(ctx context.Context, cluster *common.Cluster, removedOperators []*models.MonitoredOperator) ([]string, error) {
+	log := logutil.FromContext(ctx, mgr.log)
+	if len(removedOperators) == 0 || !funk.ContainsString(preInstallationStatuses, swag.StringValue(cluster.Status)) {
+		return nil, nil
+	}
+
+	fileNames := map[string]bool{controllerManifestConfigMapFile: true}
+	for _, removedOperator := range removedOperators {
+		operator := mgr.olmOperators[removedOperator.Name]
+		if removedOperator.OperatorType != models.OperatorTypeOlm || operator == nil {
+			continue
+		}
+		openshiftManifests, _, err := operator.GenerateManifests(cluster)
+		if err != nil {
+			log.WithError(err).Warnf("Cannot generate the manifests of removed operator %s, they aren't deleted", removedOperator.Name)
+			continue
+		}
+		for fileName := range openshiftManifests {
+			fileNames[fileName] = true
+		}
+	}
+	catalogSource := mgr.getCatalogSource(cluster.OperatorsCatalogSource)
+	if catalogSource != nil && !hasRemainingOLMOperators(cluster.MonitoredOperators, removedOperators) {
+		fileNames[catalogSourceFileName(catalogSource)] = true
+	}
+
+	manifests, err := mgr.manifestsAPI.ListClusterManifestsInternal(ctx, operations.V2ListClusterManifestsParams{
+		ClusterID:              *cluster.ID,
+		IncludeSystemGenerated: swag.Bool(true),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the manifests of cluster %s", cluster.ID)
+	}
+	var deleted []string
+	for _, manifest := range manifests {
+		if manifest.Folder != models.ManifestFolderOpenshift || manifest.ManifestSource != constants.ManifestSourceSystemGenerated ||
+			!fileNames[manifest.FileName] {
+			continue
+		}
+		if err = mgr.manifestsAPI.DeleteClusterManifestInternal(ctx, operations.V2DeleteClusterManifestParams{
+			ClusterID: *cluster.ID,
+			Folder:    swag.String(manifest.Folder),
+			FileName:  manifest.FileName,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete manifest %s of cluster %s", manifest.FileName, cluster.ID)
+		}
+		deleted = append(deleted, manifest.FileName)
+	}
+
+	existed, err := mgr.objectHandler.DeleteObject(ctx, path.Join(string(*cluster.ID), controllerManifestFile))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to delete the custom manifests of cluster %s", cluster.ID)
+	}
+	if existed {
+		deleted = append(deleted, controllerManifestFile)
+	}
+	sort.Strings(deleted)
+	return deleted, nil
+}
+
+// CleanupRemovedOperators deletes the manifests generated for the operators removed from the cluster and sends an event
+// listing the removed operators and the deleted manifests. Failing to delete the manifests is only logged, the operators
+// are already removed from the cluster. The feature usage of the removed operators is updated by the callers.
+func CleanupRemovedOperators(ctx context.Context, log logrus.FieldLogger, operatorsAPI API, eventsHandler eventsapi.Sender,
+	cluster *common.Cluster, removedOperators []*models.MonitoredOperator) {
+	manifests, err := operatorsAPI.RemoveOperatorsManifests(ctx, cluster, removedOperators)
+	if err != nil {
+		log.WithError(err).Errorf("failed to delete the manifests of the operators removed from cluster %s", cluster.ID)
+	}
+	if eventsHandler == nil {
+		return
+	}
+	names := make([]string, len(removedOperators))
+	for i, operator := range removedOperators {
+		names[i] = operator.Name
+	}
+	deletedManifests := "none"
+	if len(manifests) > 0 {
+		deletedManifests = strings.Join(manifests, ", ")
+	}
+	eventgen.SendClusterOperatorsRemovedEvent(ctx, eventsHandler, *cluster.ID, strings.Join(names, ", "), deletedManifests)
+}
+
+// hasRemainingOLMOperators checks if the cluster still has OLM operators once the removed operators are gone
+func hasRemainingOLMOperators(operators, removedOperators []*models.MonitoredOperator) bool {
+	for _, operator := range operators {
+		if operator.OperatorType == models.OperatorTypeOlm && !operatorscommon.HasOperator(removedOperators, operator.Name) {
+			return true
+		}
+	}
+	return false
+}
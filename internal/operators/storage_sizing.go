@@ -0,0 +1,133 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// GetStorageSizing calculates the capacity that a storage operator provides with the disks of the hosts of the
+// cluster. The disks of each host are the disks of its inventory without its installation disk, the hosts without
+// inventory aren't part of the given disks. The parameters that aren't set are taken from the operator.
+func (mgr *Manager) GetStorageSizing(ctx context.Context, cluster *common.Cluster, hostsDisks map[strfmt.UUID][]*models.Disk,
+	params *models.StorageSizingParams) (*models.StorageSizing, error) {
+	log := logutil.FromContext(ctx, mgr.log)
+
+	operatorName := swag.StringValue(params.OperatorName)
+	operator, ok := mgr.olmOperators[operatorName]
+	if !ok {
+		return nil, common.NewApiError(http.StatusBadRequest, fmt.Errorf("operator %s isn't supported", operatorName))
+	}
+	sizingOperator, ok := operator.(api.StorageSizingOperator)
+	if !ok {
+		return nil, common.NewApiError(http.StatusBadRequest, fmt.Errorf("operator %s doesn't provide storage", operatorName))
+	}
+	defaults, err := sizingOperator.GetStorageSizingDefaults(cluster)
+	if err != nil {
+		log.WithError(err).Errorf("Cannot get the storage sizing defaults of %s operator", operatorName)
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "failed to get the storage sizing of operator %s", operatorName))
+	}
+
+	sizing := &models.StorageSizing{
+		OperatorName:       operatorName,
+		Replica:            defaults.Replica,
+		ReservedPercentage: swag.Int64Value(defaults.ReservedPercentage),
+		DevicePaths:        defaults.DevicePaths,
+		Hosts:              []*models.StorageSizingHost{},
+		Warnings:           []string{},
+	}
+	if params.Replica > 0 {
+		sizing.Replica = params.Replica
+	}
+	if params.ReservedPercentage != nil {
+		sizing.ReservedPercentage = *params.ReservedPercentage
+	}
+	if len(params.DevicePaths) > 0 {
+		sizing.DevicePaths = params.DevicePaths
+	}
+	for _, pattern := range sizing.DevicePaths {
+		if _, err = path.Match(pattern, ""); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "invalid device path %s", pattern))
+		}
+	}
+
+	hostsWithDisks := 0
+	for _, host := range cluster.Hosts {
+		hostname := hostutil.GetHostnameForMsg(host)
+		disks, ok := hostsDisks[*host.ID]
+		if !ok {
+			sizing.Warnings = append(sizing.Warnings, fmt.Sprintf("host %s has no inventory, its disks aren't counted", hostname))
+			continue
+		}
+		disks = sizingOperator.GetStorageDisks(cluster, host, disks)
+		if disks == nil {
+			continue
+		}
+		sizingHost := &models.StorageSizingHost{
+			HostID:   *host.ID,
+			Hostname: hostname,
+			Role:     common.GetEffectiveRole(host),
+			Disks:    []*models.StorageSizingDisk{},
+		}
+		for _, disk := range disks {
+			if !matchDevicePaths(disk, sizing.DevicePaths) {
+				continue
+			}
+			sizingHost.Disks = append(sizingHost.Disks, &models.StorageSizingDisk{
+				ID:        disk.ID,
+				Path:      disk.Path,
+				DriveType: disk.DriveType,
+				SizeBytes: disk.SizeBytes,
+			})
+			sizingHost.RawCapacityBytes += disk.SizeBytes
+		}
+		if len(sizingHost.Disks) == 0 {
+			sizing.Warnings = append(sizing.Warnings, fmt.Sprintf("host %s has no disk that can be used by operator %s", hostname, operatorName))
+		} else {
+			hostsWithDisks++
+		}
+		sizing.Hosts = append(sizing.Hosts, sizingHost)
+		sizing.RawCapacityBytes += sizingHost.RawCapacityBytes
+	}
+
+	sizing.UsableCapacityBytes = sizing.RawCapacityBytes * (100 - sizing.ReservedPercentage) / 100 / sizing.Replica
+	if sizing.Replica > 1 && int64(hostsWithDisks) < sizing.Replica {
+		sizing.Warnings = append(sizing.Warnings, fmt.Sprintf("the data is copied to %d hosts but only %d hosts have disks",
+			sizing.Replica, hostsWithDisks))
+	}
+	if target := swag.Int64Value(params.TargetCapacityGb); target > 0 {
+		sizing.TargetCapacityBytes = conversions.GbToBytes(target)
+		if sizing.UsableCapacityBytes < sizing.TargetCapacityBytes {
+			sizing.Warnings = append(sizing.Warnings, fmt.Sprintf("the usable capacity of %s is below the target capacity of %s",
+				conversions.BytesToString(sizing.UsableCapacityBytes), conversions.BytesToString(sizing.TargetCapacityBytes)))
+		}
+	}
+	return sizing, nil
+}
+
+// matchDevicePaths checks if the path or the by-id path of the disk matches one of the patterns, all the disks match
+// when there are no patterns
+func matchDevicePaths(disk *models.Disk, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		for _, name := range []string{disk.Path, disk.ByID} {
+			if matched, _ := path.Match(pattern, name); name != "" && matched {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package operators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const subscriptionKind = "Subscription"
+
+// isSubscriptionPinned checks if the user asked for a specific channel, version or approval of the operator
+func isSubscriptionPinned(operator *models.MonitoredOperator) bool {
+	return operator.Channel != "" || operator.StartingCsv != "" || operator.InstallPlanApproval != ""
+}
+
+// EnsureOperatorSubscriptionPinning verifies that the operators are pinned to channels known for the OpenShift
+// version and to valid cluster service versions
+func (mgr *Manager) EnsureOperatorSubscriptionPinning(openshiftVersion string, operators []*models.MonitoredOperator) error {
+	for _, monitoredOperator := range operators {
+		if !isSubscriptionPinned(monitoredOperator) {
+			continue
+		}
+		if monitoredOperator.OperatorType != models.OperatorTypeOlm {
+			return fmt.Errorf("the subscription of operator %s can't be pinned", monitoredOperator.Name)
+		}
+		if monitoredOperator.InstallPlanApproval != "" {
+			if err := monitoredOperator.InstallPlanApproval.Validate(nil); err != nil {
+				return errors.Wrapf(err, "invalid install plan approval of operator %s", monitoredOperator.Name)
+			}
+		}
+		if monitoredOperator.StartingCsv != "" {
+			if errs := validation.IsDNS1123Subdomain(monitoredOperator.StartingCsv); len(errs) > 0 {
+				return fmt.Errorf("invalid starting cluster service version '%s' of operator %s: %s",
+					monitoredOperator.StartingCsv, monitoredOperator.Name, strings.Join(errs, ", "))
+			}
+		}
+		if monitoredOperator.Channel != "" {
+			if err := mgr.ensureKnownChannel(openshiftVersion, monitoredOperator); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (mgr *Manager) ensureKnownChannel(openshiftVersion string, monitoredOperator *models.MonitoredOperator) error {
+	operator, ok := mgr.olmOperators[monitoredOperator.Name].(api.ChannelsOperator)
+	if !ok {
+		return fmt.Errorf("the channels of operator %s aren't known, its subscription can't be pinned to channel %s",
+			monitoredOperator.Name, monitoredOperator.Channel)
+	}
+	channels, err := operator.GetChannels(openshiftVersion)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the channels of operator %s for OpenShift version %s", monitoredOperator.Name, openshiftVersion)
+	}
+	for _, channel := range channels {
+		if channel == monitoredOperator.Channel {
+			return nil
+		}
+	}
+	if len(channels) == 0 {
+		return fmt.Errorf("operator %s has no known channels for OpenShift version %s, its subscription can't be pinned to channel %s",
+			monitoredOperator.Name, openshiftVersion, monitoredOperator.Channel)
+	}
+	return fmt.Errorf("channel %s of operator %s isn't available for OpenShift version %s, the known channels are: %s",
+		monitoredOperator.Channel, monitoredOperator.Name, openshiftVersion, strings.Join(channels, ", "))
+}
+
+// pinSubscription sets the channel, starting cluster service version and install plan approval requested for the
+// operator in its Subscription manifests. The manifests of the operators are left as they are when nothing is pinned.
+func pinSubscription(manifests map[string][]byte, monitoredOperator *models.MonitoredOperator) error {
+	if !isSubscriptionPinned(monitoredOperator) {
+		return nil
+	}
+	return updateSubscriptions(manifests, monitoredOperator.Name, func(subscription *unstructured.Unstructured) error {
+		if monitoredOperator.Channel != "" {
+			if err := unstructured.SetNestedField(subscription.Object, monitoredOperator.Channel, "spec", "channel"); err != nil {
+				return err
+			}
+		}
+		if monitoredOperator.StartingCsv != "" {
+			if err := unstructured.SetNestedField(subscription.Object, monitoredOperator.StartingCsv, "spec", "startingCSV"); err != nil {
+				return err
+			}
+		}
+		if monitoredOperator.InstallPlanApproval != "" {
+			return unstructured.SetNestedField(subscription.Object, string(monitoredOperator.InstallPlanApproval), "spec", "installPlanApproval")
+		}
+		return nil
+	})
+}
+
+// updateSubscriptions applies the update to the Subscription manifests of an operator and fails if the operator
+// doesn't have any
+func updateSubscriptions(manifests map[string][]byte, operatorName string, update func(subscription *unstructured.Unstructured) error) error {
+	updated := false
+	for name, content := range manifests {
+		var subscription unstructured.Unstructured
+		if err := k8syaml.Unmarshal(content, &subscription.Object); err != nil || subscription.GetKind() != subscriptionKind {
+			continue
+		}
+		if err := update(&subscription); err != nil {
+			return errors.Wrapf(err, "failed to update subscription %s", name)
+		}
+		updatedContent, err := k8syaml.Marshal(subscription.Object)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal subscription %s", name)
+		}
+		manifests[name] = updatedContent
+		updated = true
+	}
+	if !updated {
+		return fmt.Errorf("no subscription manifest found for operator %s", operatorName)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package spoke_k8s_client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	etcdNamespace        = "openshift-etcd"
+	etcdPodLabelSelector = "app=etcd"
+	etcdctlContainerName = "etcdctl"
+
+	// minEtcdVotingMembers is the number of voting members that must be left after the removal of a member when the
+	// node isn't replaced
+	minEtcdVotingMembers = 3
+)
+
+// ErrEtcdMemberRemovalUnsafe is returned when the removal of an etcd member is refused because it would put the etcd
+// cluster at risk
+var ErrEtcdMemberRemovalUnsafe = errors.New("the removal of the etcd member is unsafe")
+
+// etcdMemberList is the part of the output of 'etcdctl member list -w json' used to find the member of a node
+type etcdMemberList struct {
+	Members []struct {
+		ID         uint64   `json:"ID"`
+		Name       string   `json:"name"`
+		IsLearner  bool     `json:"isLearner"`
+		ClientURLs []string `json:"clientURLs"`
+	} `json:"members"`
+}
+
+// etcdEndpointHealth is the part of the output of 'etcdctl endpoint health --cluster -w json' used to check the health
+// of the members
+type etcdEndpointHealth struct {
+	Endpoint string `json:"endpoint"`
+	Health   bool   `json:"health"`
+}
+
+// RemoveEtcdMember removes the etcd member of a control plane node, running etcdctl in the etcd pod of another control
+// plane node. Nothing is done when the node doesn't have a member, so it can be called again after a failure.
+// The removal is refused with ErrEtcdMemberRemovalUnsafe when the remaining members would lose quorum, or when less
+// than three voting members would be left and the node isn't replaced.
+func (c *spokeK8sClient) RemoveEtcdMember(ctx context.Context, nodeName string, replaced bool) error {
+	etcdPod, memberList, err := c.listEtcdMembers(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+	for _, member := range memberList.Members {
+		if member.Name != nodeName {
+			continue
+		}
+		if !member.IsLearner {
+			var output []byte
+			output, err = c.execInPod(ctx, etcdPod, etcdctlContainerName, "etcdctl", "endpoint", "health", "--cluster", "-w", "json")
+			// etcdctl exits with an error when an endpoint is unhealthy, the output still lists the health of all of them
+			if err != nil && len(output) == 0 {
+				return err
+			}
+			var health []etcdEndpointHealth
+			if err = json.Unmarshal(output, &health); err != nil {
+				return errors.Wrap(err, "failed to parse the health of the etcd members")
+			}
+			if err = checkEtcdMemberRemoval(memberList, health, nodeName, replaced); err != nil {
+				return err
+			}
+		}
+		c.logger.Infof("Removing etcd member %x of node %s", member.ID, nodeName)
+		_, err = c.execInPod(ctx, etcdPod, etcdctlContainerName, "etcdctl", "member", "remove", strconv.FormatUint(member.ID, 16))
+		return err
+	}
+	c.logger.Infof("Node %s doesn't have an etcd member", nodeName)
+	return nil
+}
+
+// IsEtcdMemberStarted returns true once the etcd member of a control plane node is started and is a voting member of
+// the etcd cluster. The name of a member is only set once it is started.
+func (c *spokeK8sClient) IsEtcdMemberStarted(ctx context.Context, nodeName string) (bool, error) {
+	_, memberList, err := c.listEtcdMembers(ctx, nodeName)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range memberList.Members {
+		if member.Name == nodeName {
+			return !member.IsLearner, nil
+		}
+	}
+	return false, nil
+}
+
+// checkEtcdMemberRemoval returns ErrEtcdMemberRemovalUnsafe when removing the voting member of the node would leave
+// the etcd cluster without quorum, or with less than minEtcdVotingMembers voting members when the node isn't replaced
+func checkEtcdMemberRemoval(memberList *etcdMemberList, health []etcdEndpointHealth, nodeName string, replaced bool) error {
+	healthyEndpoints := map[string]bool{}
+	for _, endpoint := range health {
+		if endpoint.Health {
+			healthyEndpoints[endpoint.Endpoint] = true
+		}
+	}
+	var remaining, healthy int
+	for _, member := range memberList.Members {
+		if member.Name == nodeName || member.IsLearner {
+			continue
+		}
+		remaining++
+		for _, url := range member.ClientURLs {
+			if healthyEndpoints[url] {
+				healthy++
+				break
+			}
+		}
+	}
+	if !replaced && remaining < minEtcdVotingMembers {
+		return errors.Wrapf(ErrEtcdMemberRemovalUnsafe, "only %d voting members would be left, another control plane node must join the cluster first",
+			remaining)
+	}
+	if quorum := remaining/2 + 1; healthy < quorum {
+		return errors.Wrapf(ErrEtcdMemberRemovalUnsafe, "only %d of the %d remaining voting members are healthy, the etcd cluster would lose quorum",
+			healthy, remaining)
+	}
+	return nil
+}
+
+// listEtcdMembers lists the etcd members from the etcd pod of a control plane node other than the given one, and
+// returns the pod used
+func (c *spokeK8sClient) listEtcdMembers(ctx context.Context, nodeName string) (*corev1.Pod, *etcdMemberList, error) {
+	pods, err := c.coreClient.Pods(etcdNamespace).List(ctx, metav1.ListOptions{LabelSelector: etcdPodLabelSelector})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list the etcd pods")
+	}
+	var etcdPod *corev1.Pod
+	for i := range pods.Items {
+		if pods.Items[i].Spec.NodeName != nodeName && pods.Items[i].Status.Phase == corev1.PodRunning {
+			etcdPod = &pods.Items[i]
+			break
+		}
+	}
+	if etcdPod == nil {
+		return nil, nil, errors.Errorf("no running etcd pod found on another node than %s", nodeName)
+	}
+
+	output, err := c.execInPod(ctx, etcdPod, etcdctlContainerName, "etcdctl", "member", "list", "-w", "json")
+	if err != nil {
+		return nil, nil, err
+	}
+	var memberList etcdMemberList
+	if err = json.Unmarshal(output, &memberList); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse the etcd members")
+	}
+	return etcdPod, &memberList, nil
+}
+
+// execInPod runs a command in a container of a pod, the output is also returned when the command fails
+func (c *spokeK8sClient) execInPod(ctx context.Context, pod *corev1.Pod, container string, command ...string) ([]byte, error) {
+	request := c.coreClient.RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(c.restConfig, http.MethodPost, request.URL())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the executor for pod %s/%s", pod.Namespace, pod.Name)
+	}
+	var stdout, stderr bytes.Buffer
+	if err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr}); err != nil {
+		return stdout.Bytes(), errors.Wrapf(err, "failed to run %v in pod %s/%s: %s", command, pod.Namespace, pod.Name, stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,61 @@
+package spoke_k8s_client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+var _ = Describe("Etcd member removal check", func() {
+	memberList := func(voting, learners int) *etcdMemberList {
+		var members []map[string]interface{}
+		for i := 0; i < voting+learners; i++ {
+			members = append(members, map[string]interface{}{
+				"ID":         i + 1,
+				"name":       fmt.Sprintf("master-%d", i),
+				"isLearner":  i >= voting,
+				"clientURLs": []string{fmt.Sprintf("https://10.0.0.%d:2379", i)},
+			})
+		}
+		data, err := json.Marshal(map[string]interface{}{"members": members})
+		Expect(err).ToNot(HaveOccurred())
+		var list etcdMemberList
+		Expect(json.Unmarshal(data, &list)).To(Succeed())
+		return &list
+	}
+
+	health := func(list *etcdMemberList, unhealthy ...string) []etcdEndpointHealth {
+		var ret []etcdEndpointHealth
+		for _, member := range list.Members {
+			ret = append(ret, etcdEndpointHealth{
+				Endpoint: member.ClientURLs[0],
+				Health:   !funk.ContainsString(unhealthy, member.Name),
+			})
+		}
+		return ret
+	}
+
+	DescribeTable("checkEtcdMemberRemoval",
+		func(voting, learners int, unhealthy []string, replaced, refused bool) {
+			list := memberList(voting, learners)
+			err := checkEtcdMemberRemoval(list, health(list, unhealthy...), "master-0", replaced)
+			if refused {
+				Expect(errors.Is(err, ErrEtcdMemberRemovalUnsafe)).To(BeTrue())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+		},
+		Entry("allows the removal from a healthy cluster of four members", 4, 0, nil, false, false),
+		Entry("refuses to leave two voting members", 3, 0, nil, false, true),
+		Entry("refuses to leave two voting members when the other member is a learner", 3, 1, nil, false, true),
+		Entry("allows to leave two voting members when the node is replaced", 3, 0, nil, true, false),
+		Entry("allows the removal of an unhealthy member", 4, 0, []string{"master-0"}, false, false),
+		Entry("refuses when the remaining members would lose quorum", 4, 0, []string{"master-1", "master-2"}, false, true),
+		Entry("refuses when the remaining members of a replaced node would lose quorum", 3, 0, []string{"master-1"}, true, true),
+	)
+})
@@ -20,18 +20,20 @@ import (
 type SpokeK8sClientFactory interface {
 	CreateFromSecret(deployment *hivev1.ClusterDeployment, secret *corev1.Secret) (SpokeK8sClient, error)
 	ClientAndSetFromSecret(deployment *hivev1.ClusterDeployment, secret *corev1.Secret) (SpokeK8sClient, *kubernetes.Clientset, error)
+	CreateFromKubeconfig(kubeConfig []byte) (SpokeK8sClient, error)
 }
 
 type spokeK8sClientFactory struct {
 	logger           logrus.FieldLogger
 	transportWrapper func(http.RoundTripper) http.RoundTripper
 	sys              system.SystemInfo
+	metrics          *Metrics
 }
 
-// NewFactory creates a spoke client factory. The logger and the hubClient are mandatory, the transport wrappers are
-// optional.
+// NewFactory creates a spoke client factory. The logger and the hubClient are mandatory, the transport wrappers and
+// the metrics are optional.
 func NewFactory(logger logrus.FieldLogger,
-	transportWrapper func(http.RoundTripper) http.RoundTripper, sys system.SystemInfo) (SpokeK8sClientFactory, error) {
+	transportWrapper func(http.RoundTripper) http.RoundTripper, sys system.SystemInfo, metrics *Metrics) (SpokeK8sClientFactory, error) {
 	// Check parameters:
 	if logger == nil {
 		return nil, errors.New("logger is mandatory")
@@ -45,6 +47,7 @@ func NewFactory(logger logrus.FieldLogger,
 		logger:           logger,
 		transportWrapper: transportWrapper,
 		sys:              sys,
+		metrics:          metrics,
 	}
 	return result, nil
 }
@@ -62,10 +65,25 @@ func (f *spokeK8sClientFactory) ClientAndSetFromSecret(deployment *hivev1.Cluste
 	if err != nil {
 		return nil, nil, err
 	}
+	return f.clientAndSetFromRestConfig(deployment, restConfig, SpokeName(deployment, secret))
+}
 
+// CreateFromKubeconfig creates a client from the content of a kubeconfig, for clusters that don't have a cluster
+// deployment, for example the clusters installed with the REST API.
+func (f *spokeK8sClientFactory) CreateFromKubeconfig(kubeConfig []byte) (SpokeK8sClient, error) {
+	restConfig, err := f.restConfigFromKubeconfig(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	client, _, err := f.clientAndSetFromRestConfig(nil, restConfig, restConfig.Host)
+	return client, err
+}
+
+func (f *spokeK8sClientFactory) clientAndSetFromRestConfig(deployment *hivev1.ClusterDeployment,
+	restConfig *rest.Config, spokeName string) (SpokeK8sClient, *kubernetes.Clientset, error) {
 	// If the cluster's API certificates were changed, the existing kubeconfig will receive certificate errors.
 	// So we merge the system CA bundle (created by the AgentServiceConfig controller) into the rest config.
-	if err = f.mergeSystemCABundleIntoRestConfig(restConfig); err != nil {
+	if err := f.mergeSystemCABundleIntoRestConfig(restConfig); err != nil {
 		f.logger.WithError(err).Error("failed to merge system CA bundle with rest config")
 		return nil, nil, err
 	}
@@ -74,7 +92,7 @@ func (f *spokeK8sClientFactory) ClientAndSetFromSecret(deployment *hivev1.Cluste
 	// 'kube-apiserver.*.svc' address that uses the service network and therefore reduces the number of round trips
 	// and doesn't need to go via proxies.
 	if f.isHostedCluster(deployment) {
-		err = f.modifyRestConfigForHostedCluster(deployment, restConfig)
+		err := f.modifyRestConfigForHostedCluster(deployment, restConfig)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -84,15 +102,24 @@ func (f *spokeK8sClientFactory) ClientAndSetFromSecret(deployment *hivev1.Cluste
 	if f.transportWrapper != nil {
 		restConfig.Wrap(f.transportWrapper)
 	}
+	if f.metrics != nil {
+		restConfig.Wrap(f.metrics.transportWrapper(spokeName))
+	}
+
+	// Both clients share the HTTP client, and so its connections:
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// Create the controller-runtime client:
-	client, err := f.clientFromRestConfig(restConfig)
+	client, err := f.clientFromRestConfig(restConfig, httpClient)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Create the client-go client:
-	clientSet, err := f.clientSetFromRestConfig(restConfig)
+	clientSet, err := kubernetes.NewForConfigAndClient(restConfig, httpClient)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -104,6 +131,9 @@ func (f *spokeK8sClientFactory) ClientAndSetFromSecret(deployment *hivev1.Cluste
 		csrClient:   clientSet.CertificatesV1().CertificateSigningRequests(),
 		sarClient:   clientSet.AuthorizationV1().SelfSubjectAccessReviews(),
 		nodesClient: clientSet.CoreV1().Nodes(),
+		coreClient:  clientSet.CoreV1(),
+		restConfig:  restConfig,
+		httpClient:  httpClient,
 	}
 	return result, clientSet, nil
 }
@@ -128,6 +158,13 @@ func (f *spokeK8sClientFactory) restConfigFromSecret(secret *corev1.Secret) (*re
 	if err != nil {
 		return nil, err
 	}
+	return f.restConfigFromKubeconfig(kubeConfig)
+}
+
+func (f *spokeK8sClientFactory) restConfigFromKubeconfig(kubeConfig []byte) (*rest.Config, error) {
+	if len(kubeConfig) == 0 {
+		return nil, errors.New("kubeconfig is empty")
+	}
 	clientConfig, err := clientcmd.NewClientConfigFromBytes(kubeConfig)
 	if err != nil {
 		return nil, err
@@ -151,13 +188,9 @@ func (f *spokeK8sClientFactory) kubeConfigFromSecret(secret *corev1.Secret) ([]b
 	return result, nil
 }
 
-func (f *spokeK8sClientFactory) clientFromRestConfig(restConfig *rest.Config) (ctrlclient.Client, error) {
+func (f *spokeK8sClientFactory) clientFromRestConfig(restConfig *rest.Config, httpClient *http.Client) (ctrlclient.Client, error) {
 	schemes := GetKubeClientSchemes()
-	return ctrlclient.New(restConfig, ctrlclient.Options{Scheme: schemes})
-}
-
-func (f *spokeK8sClientFactory) clientSetFromRestConfig(restConfig *rest.Config) (*kubernetes.Clientset, error) {
-	return kubernetes.NewForConfig(restConfig)
+	return ctrlclient.New(restConfig, ctrlclient.Options{Scheme: schemes, HTTPClient: httpClient})
 }
 
 // isHostedCluster determines if the given cluster deployment belongs to a hosted cluster.
@@ -95,13 +95,13 @@ var _ = Describe("Factory", func() {
 		})
 
 		It("Can't be created without a logger", func() {
-			client, err := NewFactory(nil, nil, mockSystemInfo)
+			client, err := NewFactory(nil, nil, mockSystemInfo, nil)
 			Expect(err).To(MatchError("logger is mandatory"))
 			Expect(client).To(BeNil())
 		})
 
 		It("Can't be created without system info", func() {
-			client, err := NewFactory(logger, nil, nil)
+			client, err := NewFactory(logger, nil, nil, nil)
 			Expect(err).To(MatchError("sys is mandatory"))
 			Expect(client).To(BeNil())
 		})
@@ -126,7 +126,7 @@ var _ = Describe("Factory", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				// Create the factory:
-				factory, err := NewFactory(logger, nil, mockSystemInfo)
+				factory, err := NewFactory(logger, nil, mockSystemInfo, nil)
 				Expect(err).ToNot(HaveOccurred())
 
 				// Check the error:
@@ -243,6 +243,7 @@ var _ = Describe("Factory", func() {
 						return transport
 					},
 					mockSystemInfo,
+					nil,
 				)
 				Expect(err).ToNot(HaveOccurred())
 
@@ -286,6 +287,7 @@ var _ = Describe("Factory", func() {
 						return transport
 					},
 					mockSystemInfo,
+					nil,
 				)
 				Expect(err).ToNot(HaveOccurred())
 
@@ -325,6 +327,7 @@ var _ = Describe("Factory", func() {
 						return transport
 					},
 					mockSystemInfo,
+					nil,
 				)
 				Expect(err).ToNot(HaveOccurred())
 
@@ -344,6 +347,47 @@ var _ = Describe("Factory", func() {
 				Expect(err).To(MatchError(ContainSubstring("myerror")))
 			})
 
+			It("Creates the client directly from the kubeconfig", func() {
+				mockSystemInfo.EXPECT().GetSystemCABundle().Return([]byte(testCert2), nil).Times(1)
+
+				// Use a transport that fails all requests, it is only used to verify the API server address:
+				transport := ghttp.RoundTripperFunc(
+					func(request *http.Request) (response *http.Response, err error) {
+						Expect(request.URL.String()).To(HavePrefix("https://mylb:32132/"))
+						err = errors.New("myerror")
+						return
+					},
+				)
+				factory, err := NewFactory(
+					logger,
+					func(http.RoundTripper) http.RoundTripper {
+						return transport
+					},
+					mockSystemInfo,
+					nil,
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				client, err := factory.CreateFromKubeconfig(kubeconfigSecret.Data["kubeconfig"])
+				Expect(err).ToNot(HaveOccurred())
+
+				configMap := &corev1.ConfigMap{}
+				configMapKey := types.NamespacedName{
+					Namespace: hubNamespace.Name,
+					Name:      "myconfig",
+				}
+				err = client.Get(ctx, configMapKey, configMap)
+				Expect(err).To(MatchError(ContainSubstring("myerror")))
+			})
+
+			It("Fails to create the client from an empty kubeconfig", func() {
+				factory, err := NewFactory(logger, nil, mockSystemInfo, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = factory.CreateFromKubeconfig(nil)
+				Expect(err).To(MatchError("kubeconfig is empty"))
+			})
+
 			It("System CA bundle has 1 cert that's not in the kubeconfig", func() {
 				mockSystemInfo.EXPECT().GetSystemCABundle().Return([]byte(testCert2), nil).Times(1)
 
@@ -360,7 +404,7 @@ var _ = Describe("Factory", func() {
 					return rt
 				}
 
-				factory, err := NewFactory(logger, wrapper, mockSystemInfo)
+				factory, err := NewFactory(logger, wrapper, mockSystemInfo, nil)
 				Expect(err).ToNot(HaveOccurred())
 
 				_, err = factory.CreateFromSecret(clusterDeployment, kubeconfigSecret)
@@ -384,7 +428,7 @@ var _ = Describe("Factory", func() {
 					return rt
 				}
 
-				factory, err := NewFactory(logger, wrapper, mockSystemInfo)
+				factory, err := NewFactory(logger, wrapper, mockSystemInfo, nil)
 				Expect(err).ToNot(HaveOccurred())
 
 				_, err = factory.CreateFromSecret(clusterDeployment, kubeconfigSecret)
@@ -408,7 +452,7 @@ var _ = Describe("Factory", func() {
 					return rt
 				}
 
-				factory, err := NewFactory(logger, wrapper, mockSystemInfo)
+				factory, err := NewFactory(logger, wrapper, mockSystemInfo, nil)
 				Expect(err).ToNot(HaveOccurred())
 
 				_, err = factory.CreateFromSecret(clusterDeployment, kubeconfigSecret)
@@ -0,0 +1,110 @@
+package spoke_k8s_client
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	spokeLabel = "spoke"
+	codeLabel  = "code"
+
+	metricsSubsystem = "spoke_client"
+)
+
+// Metrics records the metrics of the clients of the spoke clusters: the size of the client cache, the connection
+// failures and the latency of the requests, per spoke cluster.
+type Metrics struct {
+	cacheSize          prometheus.Gauge
+	connectionFailures *prometheus.CounterVec
+	requestDuration    *prometheus.HistogramVec
+}
+
+func NewMetrics(registry prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		cacheSize: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "assisted_installer",
+			Subsystem: metricsSubsystem,
+			Name:      "cache_size",
+			Help:      "The number of spoke clients in the cache",
+		}),
+		connectionFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "assisted_installer",
+			Subsystem: metricsSubsystem,
+			Name:      "connection_failures_total",
+			Help:      "The number of requests to the API of the spoke cluster that failed without a response, by spoke",
+		}, []string{spokeLabel}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "assisted_installer",
+			Subsystem: metricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "The latency of the requests to the API of the spoke cluster, by spoke and status code",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{spokeLabel, codeLabel}),
+	}
+	registry.MustRegister(m.cacheSize, m.connectionFailures, m.requestDuration)
+	return m
+}
+
+func (m *Metrics) SetCacheSize(size int) {
+	m.cacheSize.Set(float64(size))
+}
+
+func (m *Metrics) ConnectionFailed(spoke string) {
+	m.connectionFailures.WithLabelValues(spoke).Inc()
+}
+
+// DeleteSpoke removes the metrics of a spoke cluster, so that the clusters that aren't managed anymore don't keep
+// their series forever
+func (m *Metrics) DeleteSpoke(spoke string) {
+	m.connectionFailures.DeletePartialMatch(prometheus.Labels{spokeLabel: spoke})
+	m.requestDuration.DeletePartialMatch(prometheus.Labels{spokeLabel: spoke})
+}
+
+// transportWrapper returns a wrapper that records the latency of the requests to the given spoke cluster, and the
+// requests that failed without a response as connection failures
+func (m *Metrics) transportWrapper(spoke string) func(http.RoundTripper) http.RoundTripper {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &instrumentedRoundTripper{RoundTripper: rt, metrics: m, spoke: spoke}
+	}
+}
+
+type instrumentedRoundTripper struct {
+	http.RoundTripper
+	metrics *Metrics
+	spoke   string
+}
+
+func (t *instrumentedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil {
+		t.metrics.ConnectionFailed(t.spoke)
+		return resp, err
+	}
+	t.metrics.requestDuration.WithLabelValues(t.spoke, strconv.Itoa(resp.StatusCode)).Observe(time.Since(start).Seconds())
+	return resp, nil
+}
+
+// WrappedRoundTripper allows client-go to close the idle connections of the wrapped transport
+func (t *instrumentedRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return t.RoundTripper
+}
+
+// SpokeName returns the name of the spoke cluster used in the metrics: the name of the cluster deployment, or of the
+// kubeconfig secret for the clusters without a cluster deployment
+func SpokeName(deployment *hivev1.ClusterDeployment, secret *corev1.Secret) string {
+	if deployment != nil {
+		return types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}.String()
+	}
+	if secret != nil {
+		return types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}.String()
+	}
+	return ""
+}
@@ -55,6 +55,32 @@ func (mr *MockSpokeK8sClientMockRecorder) ApproveCsr(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveCsr", reflect.TypeOf((*MockSpokeK8sClient)(nil).ApproveCsr), arg0, arg1)
 }
 
+// CheckHealth mocks base method.
+func (m *MockSpokeK8sClient) CheckHealth(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckHealth", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckHealth indicates an expected call of CheckHealth.
+func (mr *MockSpokeK8sClientMockRecorder) CheckHealth(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockSpokeK8sClient)(nil).CheckHealth), arg0)
+}
+
+// Close mocks base method.
+func (m *MockSpokeK8sClient) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSpokeK8sClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSpokeK8sClient)(nil).Close))
+}
+
 // Create mocks base method.
 func (m *MockSpokeK8sClient) Create(arg0 context.Context, arg1 client.Object, arg2 ...client.CreateOption) error {
 	m.ctrl.T.Helper()
@@ -175,6 +201,21 @@ func (mr *MockSpokeK8sClientMockRecorder) GroupVersionKindFor(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupVersionKindFor", reflect.TypeOf((*MockSpokeK8sClient)(nil).GroupVersionKindFor), arg0)
 }
 
+// IsEtcdMemberStarted mocks base method.
+func (m *MockSpokeK8sClient) IsEtcdMemberStarted(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEtcdMemberStarted", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsEtcdMemberStarted indicates an expected call of IsEtcdMemberStarted.
+func (mr *MockSpokeK8sClientMockRecorder) IsEtcdMemberStarted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEtcdMemberStarted", reflect.TypeOf((*MockSpokeK8sClient)(nil).IsEtcdMemberStarted), arg0, arg1)
+}
+
 // IsObjectNamespaced mocks base method.
 func (m *MockSpokeK8sClient) IsObjectNamespaced(arg0 runtime.Object) (bool, error) {
 	m.ctrl.T.Helper()
@@ -285,6 +326,20 @@ func (mr *MockSpokeK8sClientMockRecorder) RESTMapper() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RESTMapper", reflect.TypeOf((*MockSpokeK8sClient)(nil).RESTMapper))
 }
 
+// RemoveEtcdMember mocks base method.
+func (m *MockSpokeK8sClient) RemoveEtcdMember(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEtcdMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveEtcdMember indicates an expected call of RemoveEtcdMember.
+func (mr *MockSpokeK8sClientMockRecorder) RemoveEtcdMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEtcdMember", reflect.TypeOf((*MockSpokeK8sClient)(nil).RemoveEtcdMember), arg0, arg1, arg2)
+}
+
 // Scheme mocks base method.
 func (m *MockSpokeK8sClient) Scheme() *runtime.Scheme {
 	m.ctrl.T.Helper()
@@ -52,6 +52,21 @@ func (mr *MockSpokeK8sClientFactoryMockRecorder) ClientAndSetFromSecret(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientAndSetFromSecret", reflect.TypeOf((*MockSpokeK8sClientFactory)(nil).ClientAndSetFromSecret), arg0, arg1)
 }
 
+// CreateFromKubeconfig mocks base method.
+func (m *MockSpokeK8sClientFactory) CreateFromKubeconfig(arg0 []byte) (SpokeK8sClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFromKubeconfig", arg0)
+	ret0, _ := ret[0].(SpokeK8sClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFromKubeconfig indicates an expected call of CreateFromKubeconfig.
+func (mr *MockSpokeK8sClientFactoryMockRecorder) CreateFromKubeconfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromKubeconfig", reflect.TypeOf((*MockSpokeK8sClientFactory)(nil).CreateFromKubeconfig), arg0)
+}
+
 // CreateFromSecret mocks base method.
 func (m *MockSpokeK8sClientFactory) CreateFromSecret(arg0 *v1.ClusterDeployment, arg1 *v10.Secret) (SpokeK8sClient, error) {
 	m.ctrl.T.Helper()
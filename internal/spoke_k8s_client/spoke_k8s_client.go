@@ -3,6 +3,7 @@ package spoke_k8s_client
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	authzv1 "github.com/openshift/api/authorization/v1"
@@ -22,11 +23,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	authorizationv1interfaces "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	cerv1 "k8s.io/client-go/kubernetes/typed/certificates/v1"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
 	apiregv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -40,6 +43,13 @@ type SpokeK8sClient interface {
 	PatchNodeLabels(ctx context.Context, nodeName string, nodeLabels string) error
 	PatchMachineConfigPoolPaused(ctx context.Context, pause bool, mcpName string) error
 	DeleteNode(ctx context.Context, name string) error
+	// RemoveEtcdMember removes the etcd member of a node, replaced is true when a new control plane node replaces it
+	RemoveEtcdMember(ctx context.Context, nodeName string, replaced bool) error
+	IsEtcdMemberStarted(ctx context.Context, nodeName string) (bool, error)
+	// CheckHealth returns an error when the API server of the spoke cluster isn't reachable or isn't ready
+	CheckHealth(ctx context.Context) error
+	// Close closes the idle connections of the client
+	Close()
 }
 
 type spokeK8sClient struct {
@@ -47,6 +57,9 @@ type spokeK8sClient struct {
 	csrClient   cerv1.CertificateSigningRequestInterface
 	sarClient   authorizationv1interfaces.SelfSubjectAccessReviewInterface
 	nodesClient typedcorev1.NodeInterface
+	coreClient  typedcorev1.CoreV1Interface
+	restConfig  *rest.Config
+	httpClient  *http.Client
 	logger      logrus.FieldLogger
 }
 
@@ -94,6 +107,16 @@ func (c *spokeK8sClient) PatchMachineConfigPoolPaused(ctx context.Context, pause
 	return c.Patch(ctx, mcp, client.RawPatch(types.MergePatchType, pausePatch))
 }
 
+func (c *spokeK8sClient) CheckHealth(ctx context.Context) error {
+	return c.coreClient.RESTClient().Get().AbsPath("/readyz").Do(ctx).Error()
+}
+
+func (c *spokeK8sClient) Close() {
+	if c.httpClient != nil {
+		utilnet.CloseIdleConnectionsFor(c.httpClient.Transport)
+	}
+}
+
 func (c *spokeK8sClient) DeleteNode(ctx context.Context, name string) error {
 	return c.nodesClient.Delete(ctx, name, metav1.DeleteOptions{})
 }
@@ -316,6 +316,20 @@ func (mr *MockInstallerAPIMockRecorder) V2CompleteInstallation(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CompleteInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).V2CompleteInstallation), arg0, arg1)
 }
 
+// V2DeregisterBmcReference mocks base method.
+func (m *MockInstallerAPI) V2DeregisterBmcReference(arg0 context.Context, arg1 installer.V2DeregisterBmcReferenceParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DeregisterBmcReference", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DeregisterBmcReference indicates an expected call of V2DeregisterBmcReference.
+func (mr *MockInstallerAPIMockRecorder) V2DeregisterBmcReference(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DeregisterBmcReference", reflect.TypeOf((*MockInstallerAPI)(nil).V2DeregisterBmcReference), arg0, arg1)
+}
+
 // V2DeregisterCluster mocks base method.
 func (m *MockInstallerAPI) V2DeregisterCluster(arg0 context.Context, arg1 installer.V2DeregisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -442,6 +456,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetClusterDefaultConfig(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterDefaultConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterDefaultConfig), arg0, arg1)
 }
 
+// V2GetClusterIPStackReadiness mocks base method.
+func (m *MockInstallerAPI) V2GetClusterIPStackReadiness(arg0 context.Context, arg1 installer.V2GetClusterIPStackReadinessParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterIPStackReadiness", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterIPStackReadiness indicates an expected call of V2GetClusterIPStackReadiness.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterIPStackReadiness(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterIPStackReadiness", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterIPStackReadiness), arg0, arg1)
+}
+
 // V2GetClusterInstallConfig mocks base method.
 func (m *MockInstallerAPI) V2GetClusterInstallConfig(arg0 context.Context, arg1 installer.V2GetClusterInstallConfigParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -456,6 +484,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallConfig(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallConfig), arg0, arg1)
 }
 
+// V2GetClusterNetworkTopology mocks base method.
+func (m *MockInstallerAPI) V2GetClusterNetworkTopology(arg0 context.Context, arg1 installer.V2GetClusterNetworkTopologyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterNetworkTopology", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterNetworkTopology indicates an expected call of V2GetClusterNetworkTopology.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterNetworkTopology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterNetworkTopology", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterNetworkTopology), arg0, arg1)
+}
+
 // V2GetClusterUISettings mocks base method.
 func (m *MockInstallerAPI) V2GetClusterUISettings(arg0 context.Context, arg1 installer.V2GetClusterUISettingsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -470,6 +512,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetClusterUISettings(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterUISettings", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterUISettings), arg0, arg1)
 }
 
+// V2GetClusterVipSuggestions mocks base method.
+func (m *MockInstallerAPI) V2GetClusterVipSuggestions(arg0 context.Context, arg1 installer.V2GetClusterVipSuggestionsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterVipSuggestions", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterVipSuggestions indicates an expected call of V2GetClusterVipSuggestions.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterVipSuggestions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterVipSuggestions", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterVipSuggestions), arg0, arg1)
+}
+
 // V2GetCredentials mocks base method.
 func (m *MockInstallerAPI) V2GetCredentials(arg0 context.Context, arg1 installer.V2GetCredentialsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -624,6 +680,20 @@ func (mr *MockInstallerAPIMockRecorder) V2InstallHost(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2InstallHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2InstallHost), arg0, arg1)
 }
 
+// V2ListBmcReferences mocks base method.
+func (m *MockInstallerAPI) V2ListBmcReferences(arg0 context.Context, arg1 installer.V2ListBmcReferencesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListBmcReferences", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListBmcReferences indicates an expected call of V2ListBmcReferences.
+func (mr *MockInstallerAPIMockRecorder) V2ListBmcReferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListBmcReferences", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListBmcReferences), arg0, arg1)
+}
+
 // V2ListClusters mocks base method.
 func (m *MockInstallerAPI) V2ListClusters(arg0 context.Context, arg1 installer.V2ListClustersParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -666,6 +736,20 @@ func (mr *MockInstallerAPIMockRecorder) V2PostStepReply(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2PostStepReply", reflect.TypeOf((*MockInstallerAPI)(nil).V2PostStepReply), arg0, arg1)
 }
 
+// V2RegisterBmcReference mocks base method.
+func (m *MockInstallerAPI) V2RegisterBmcReference(arg0 context.Context, arg1 installer.V2RegisterBmcReferenceParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2RegisterBmcReference", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2RegisterBmcReference indicates an expected call of V2RegisterBmcReference.
+func (mr *MockInstallerAPIMockRecorder) V2RegisterBmcReference(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RegisterBmcReference", reflect.TypeOf((*MockInstallerAPI)(nil).V2RegisterBmcReference), arg0, arg1)
+}
+
 // V2RegisterCluster mocks base method.
 func (m *MockInstallerAPI) V2RegisterCluster(arg0 context.Context, arg1 installer.V2RegisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -750,6 +834,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ResetHostValidation(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ResetHostValidation", reflect.TypeOf((*MockInstallerAPI)(nil).V2ResetHostValidation), arg0, arg1)
 }
 
+// V2RunBmcAction mocks base method.
+func (m *MockInstallerAPI) V2RunBmcAction(arg0 context.Context, arg1 installer.V2RunBmcActionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2RunBmcAction", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2RunBmcAction indicates an expected call of V2RunBmcAction.
+func (mr *MockInstallerAPIMockRecorder) V2RunBmcAction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RunBmcAction", reflect.TypeOf((*MockInstallerAPI)(nil).V2RunBmcAction), arg0, arg1)
+}
+
 // V2SetIgnoredValidations mocks base method.
 func (m *MockInstallerAPI) V2SetIgnoredValidations(arg0 context.Context, arg1 installer.V2SetIgnoredValidationsParams) middleware.Responder {
 	m.ctrl.T.Helper()
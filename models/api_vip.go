@@ -23,6 +23,9 @@ type APIVip struct {
 	// Format: uuid
 	ClusterID strfmt.UUID `json:"cluster_id,omitempty" gorm:"primaryKey"`
 
+	// MAC address of a device that is not part of the cluster and answers for the VIP, 'unknown' when the agent didn't report it.
+	ConflictingMacAddress string `json:"conflicting_mac_address,omitempty"`
+
 	// The IP address.
 	IP IP `json:"ip,omitempty" gorm:"primaryKey"`
 
@@ -88,6 +88,10 @@ type Cluster struct {
 	// JSON-formatted string containing the usage information by feature name
 	FeatureUsage string `json:"feature_usage,omitempty" gorm:"type:text"`
 
+	// The federation member instance that owns the cluster, set in the federated responses.
+	// Read Only: true
+	FederationInstanceID string `json:"federation_instance_id,omitempty" gorm:"-"`
+
 	// (DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster
 	// over multiple master nodes whereas 'None' installs a full cluster over one node.
 	//
@@ -211,6 +215,9 @@ type Cluster struct {
 	// Version of the OpenShift cluster.
 	OpenshiftVersion string `json:"openshift_version,omitempty"`
 
+	// operators catalog source
+	OperatorsCatalogSource *OperatorsCatalogSource `json:"operators_catalog_source,omitempty" gorm:"embedded;embeddedPrefix:operators_catalog_source_"`
+
 	// org id
 	OrgID string `json:"org_id,omitempty"`
 
@@ -407,6 +414,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOperatorsCatalogSource(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePlatform(formats); err != nil {
 		res = append(res, err)
 	}
@@ -1131,6 +1142,25 @@ func (m *Cluster) validateOpenshiftClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateOperatorsCatalogSource(formats strfmt.Registry) error {
+	if swag.IsZero(m.OperatorsCatalogSource) { // not required
+		return nil
+	}
+
+	if m.OperatorsCatalogSource != nil {
+		if err := m.OperatorsCatalogSource.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("operators_catalog_source")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("operators_catalog_source")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) validatePlatform(formats strfmt.Registry) error {
 	if swag.IsZero(m.Platform) { // not required
 		return nil
@@ -1329,6 +1359,10 @@ func (m *Cluster) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateFederationInstanceID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateHostNetworks(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -1369,6 +1403,10 @@ func (m *Cluster) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateOperatorsCatalogSource(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePlatform(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -1443,6 +1481,15 @@ func (m *Cluster) contextValidateDiskEncryption(ctx context.Context, formats str
 	return nil
 }
 
+func (m *Cluster) contextValidateFederationInstanceID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "federation_instance_id", "body", string(m.FederationInstanceID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) contextValidateHostNetworks(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.HostNetworks); i++ {
@@ -1619,6 +1666,22 @@ func (m *Cluster) contextValidateMonitoredOperators(ctx context.Context, formats
 	return nil
 }
 
+func (m *Cluster) contextValidateOperatorsCatalogSource(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.OperatorsCatalogSource != nil {
+		if err := m.OperatorsCatalogSource.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("operators_catalog_source")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("operators_catalog_source")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) contextValidatePlatform(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Platform != nil {
@@ -109,6 +109,9 @@ type ClusterCreateParams struct {
 	// Required: true
 	OpenshiftVersion *string `json:"openshift_version"`
 
+	// operators catalog source
+	OperatorsCatalogSource *OperatorsCatalogSource `json:"operators_catalog_source,omitempty" gorm:"embedded;embeddedPrefix:operators_catalog_source_"`
+
 	// platform
 	Platform *Platform `json:"platform,omitempty" gorm:"embedded;embeddedPrefix:platform_"`
 
@@ -207,6 +210,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOperatorsCatalogSource(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePlatform(formats); err != nil {
 		res = append(res, err)
 	}
@@ -671,6 +678,25 @@ func (m *ClusterCreateParams) validateOpenshiftVersion(formats strfmt.Registry)
 	return nil
 }
 
+func (m *ClusterCreateParams) validateOperatorsCatalogSource(formats strfmt.Registry) error {
+	if swag.IsZero(m.OperatorsCatalogSource) { // not required
+		return nil
+	}
+
+	if m.OperatorsCatalogSource != nil {
+		if err := m.OperatorsCatalogSource.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("operators_catalog_source")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("operators_catalog_source")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validatePlatform(formats strfmt.Registry) error {
 	if swag.IsZero(m.Platform) { // not required
 		return nil
@@ -773,6 +799,10 @@ func (m *ClusterCreateParams) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateOperatorsCatalogSource(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePlatform(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -935,6 +965,22 @@ func (m *ClusterCreateParams) contextValidateOlmOperators(ctx context.Context, f
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateOperatorsCatalogSource(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.OperatorsCatalogSource != nil {
+		if err := m.OperatorsCatalogSource.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("operators_catalog_source")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("operators_catalog_source")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidatePlatform(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Platform != nil {
@@ -33,6 +33,10 @@ type Event struct {
 	// Format: date-time
 	EventTime *strfmt.DateTime `json:"event_time" gorm:"type:timestamp with time zone"`
 
+	// The federation member instance that reported the event, set in the federated responses.
+	// Read Only: true
+	FederationInstanceID string `json:"federation_instance_id,omitempty" gorm:"-"`
+
 	// Unique identifier of the host this event relates to.
 	// Format: uuid
 	HostID *strfmt.UUID `json:"host_id,omitempty" gorm:"index"`
@@ -264,8 +268,26 @@ func (m *Event) validateSeverity(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this event based on context it is used
+// ContextValidate validate this event based on the context it is used
 func (m *Event) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFederationInstanceID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Event) contextValidateFederationInstanceID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "federation_instance_id", "body", string(m.FederationInstanceID)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -23,6 +23,9 @@ type Interface struct {
 	// client id
 	ClientID string `json:"client_id,omitempty"`
 
+	// Name of the bond or team interface that this interface is a port of. Not reported by older agents, the bond and team validation is skipped without it.
+	Controller string `json:"controller,omitempty"`
+
 	// flags
 	Flags []string `json:"flags"`
 
@@ -55,6 +58,12 @@ type Interface struct {
 
 	// vendor
 	Vendor string `json:"vendor,omitempty"`
+
+	// Name of the interface that a VLAN interface is created on. Not reported by older agents, it is then parsed from the <base>.<id> name of the interface.
+	VlanBaseInterface string `json:"vlan_base_interface,omitempty"`
+
+	// VLAN ID of a VLAN interface. Not reported by older agents, the VLAN ID is then parsed from the <base>.<id> name of the interface.
+	VlanID int64 `json:"vlan_id,omitempty"`
 }
 
 // Validate validates this interface
@@ -23,13 +23,22 @@ type MonitoredOperator struct {
 	// List of identifier of the bundles associated with the operator. Can be empty.
 	Bundles pq.StringArray `json:"bundles" gorm:"type:text[]"`
 
+	// The subscription channel the operator is pinned to. Empty for the default channel of the operator.
+	Channel string `json:"channel,omitempty"`
+
 	// The cluster that this operator is associated with.
 	// Format: uuid
 	ClusterID strfmt.UUID `json:"cluster_id,omitempty" gorm:"primaryKey"`
 
+	// Whether the operator was installed after the installation of the cluster.
+	Day2 bool `json:"day2,omitempty"`
+
 	// Whether the operator can't be installed without being required by another operator.
 	DependencyOnly bool `json:"dependency_only,omitempty"`
 
+	// install plan approval
+	InstallPlanApproval OperatorInstallPlanApproval `json:"install_plan_approval,omitempty"`
+
 	// Unique name of the operator.
 	Name string `json:"name,omitempty" gorm:"primaryKey"`
 
@@ -42,6 +51,9 @@ type MonitoredOperator struct {
 	// Blob of operator-dependent parameters that are required for installation.
 	Properties string `json:"properties,omitempty" gorm:"type:text"`
 
+	// The cluster service version the subscription of the operator starts from. Empty for the latest version of the channel.
+	StartingCsv string `json:"starting_csv,omitempty"`
+
 	// status
 	Status OperatorStatus `json:"status,omitempty"`
 
@@ -70,6 +82,10 @@ func (m *MonitoredOperator) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateInstallPlanApproval(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOperatorType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -100,6 +116,23 @@ func (m *MonitoredOperator) validateClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *MonitoredOperator) validateInstallPlanApproval(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallPlanApproval) { // not required
+		return nil
+	}
+
+	if err := m.InstallPlanApproval.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("install_plan_approval")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("install_plan_approval")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *MonitoredOperator) validateOperatorType(formats strfmt.Registry) error {
 	if swag.IsZero(m.OperatorType) { // not required
 		return nil
@@ -150,6 +183,10 @@ func (m *MonitoredOperator) validateStatusUpdatedAt(formats strfmt.Registry) err
 func (m *MonitoredOperator) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateInstallPlanApproval(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateOperatorType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -164,6 +201,20 @@ func (m *MonitoredOperator) ContextValidate(ctx context.Context, formats strfmt.
 	return nil
 }
 
+func (m *MonitoredOperator) contextValidateInstallPlanApproval(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.InstallPlanApproval.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("install_plan_approval")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("install_plan_approval")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *MonitoredOperator) contextValidateOperatorType(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.OperatorType.ContextValidate(ctx, formats); err != nil {
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -17,20 +18,80 @@ import (
 // swagger:model operator-create-params
 type OperatorCreateParams struct {
 
+	// The subscription channel to pin the operator to, one of the channels known for the OpenShift version of the cluster.
+	Channel string `json:"channel,omitempty"`
+
+	// install plan approval
+	InstallPlanApproval OperatorInstallPlanApproval `json:"install_plan_approval,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
-	// Blob of operator-dependent parameters that are required for installation.
+	// Blob of operator-dependent parameters that are required for installation. For the operators that describe
+	// their properties it is a JSON object validated against them.
+	//
 	Properties string `json:"properties,omitempty" gorm:"type:text"`
+
+	// The cluster service version the subscription of the operator starts from.
+	StartingCsv string `json:"starting_csv,omitempty"`
 }
 
 // Validate validates this operator create params
 func (m *OperatorCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateInstallPlanApproval(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OperatorCreateParams) validateInstallPlanApproval(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallPlanApproval) { // not required
+		return nil
+	}
+
+	if err := m.InstallPlanApproval.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("install_plan_approval")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("install_plan_approval")
+		}
+		return err
+	}
+
 	return nil
 }
 
-// ContextValidate validates this operator create params based on context it is used
+// ContextValidate validate this operator create params based on the context it is used
 func (m *OperatorCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateInstallPlanApproval(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OperatorCreateParams) contextValidateInstallPlanApproval(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.InstallPlanApproval.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("install_plan_approval")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("install_plan_approval")
+		}
+		return err
+	}
+
 	return nil
 }
 
@@ -20,8 +20,8 @@ import (
 // swagger:model operator-property
 type OperatorProperty struct {
 
-	// Type of the property
-	// Enum: [boolean string integer float]
+	// Type of the property, the items of array properties are strings
+	// Enum: [boolean string integer float array]
 	DataType string `json:"data_type,omitempty"`
 
 	// Default value for the property
@@ -33,11 +33,20 @@ type OperatorProperty struct {
 	// Indicates whether the property is reqired
 	Mandatory bool `json:"mandatory,omitempty"`
 
+	// Maximum value of integer and float properties
+	Maximum *float64 `json:"maximum,omitempty"`
+
+	// Minimum value of integer and float properties
+	Minimum *float64 `json:"minimum,omitempty"`
+
 	// Name of the property
 	Name string `json:"name,omitempty"`
 
 	// Values to select from
 	Options []string `json:"options"`
+
+	// Regular expression matching the values of string properties, or the items of array properties
+	Pattern string `json:"pattern,omitempty"`
 }
 
 // Validate validates this operator property
@@ -58,7 +67,7 @@ var operatorPropertyTypeDataTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["boolean","string","integer","float"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["boolean","string","integer","float","array"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -79,6 +88,9 @@ const (
 
 	// OperatorPropertyDataTypeFloat captures enum value "float"
 	OperatorPropertyDataTypeFloat string = "float"
+
+	// OperatorPropertyDataTypeArray captures enum value "array"
+	OperatorPropertyDataTypeArray string = "array"
 )
 
 // prop value enum
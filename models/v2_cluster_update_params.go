@@ -98,6 +98,9 @@ type V2ClusterUpdateParams struct {
 	//
 	OlmOperators []*OperatorCreateParams `json:"olm_operators"`
 
+	// operators catalog source
+	OperatorsCatalogSource *OperatorsCatalogSource `json:"operators_catalog_source,omitempty" gorm:"embedded;embeddedPrefix:operators_catalog_source_"`
+
 	// platform
 	Platform *Platform `json:"platform,omitempty" gorm:"embedded;embeddedPrefix:platform_"`
 
@@ -187,6 +190,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOperatorsCatalogSource(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePlatform(formats); err != nil {
 		res = append(res, err)
 	}
@@ -553,6 +560,25 @@ func (m *V2ClusterUpdateParams) validateOlmOperators(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateOperatorsCatalogSource(formats strfmt.Registry) error {
+	if swag.IsZero(m.OperatorsCatalogSource) { // not required
+		return nil
+	}
+
+	if m.OperatorsCatalogSource != nil {
+		if err := m.OperatorsCatalogSource.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("operators_catalog_source")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("operators_catalog_source")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validatePlatform(formats strfmt.Registry) error {
 	if swag.IsZero(m.Platform) { // not required
 		return nil
@@ -646,6 +672,10 @@ func (m *V2ClusterUpdateParams) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateOperatorsCatalogSource(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePlatform(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -808,6 +838,22 @@ func (m *V2ClusterUpdateParams) contextValidateOlmOperators(ctx context.Context,
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateOperatorsCatalogSource(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.OperatorsCatalogSource != nil {
+		if err := m.OperatorsCatalogSource.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("operators_catalog_source")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("operators_catalog_source")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidatePlatform(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Platform != nil {
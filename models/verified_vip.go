@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // VerifiedVip Single VIP verification result.
@@ -18,6 +19,10 @@ import (
 // swagger:model verified_vip
 type VerifiedVip struct {
 
+	// MAC address that answered the ARP or NDP requests sent for the VIP, if any.
+	// Format: mac
+	ConflictingMacAddress strfmt.MAC `json:"conflicting_mac_address,omitempty"`
+
 	// verification
 	Verification *VipVerification `json:"verification,omitempty"`
 
@@ -32,6 +37,10 @@ type VerifiedVip struct {
 func (m *VerifiedVip) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateConflictingMacAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateVerification(formats); err != nil {
 		res = append(res, err)
 	}
@@ -50,6 +59,18 @@ func (m *VerifiedVip) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *VerifiedVip) validateConflictingMacAddress(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConflictingMacAddress) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("conflicting_mac_address", "body", "mac", m.ConflictingMacAddress.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *VerifiedVip) validateVerification(formats strfmt.Registry) error {
 	if swag.IsZero(m.Verification) { // not required
 		return nil
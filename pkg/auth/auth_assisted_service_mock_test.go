@@ -29,6 +29,34 @@ func (f fakeInventory) V2GetPreflightRequirements(ctx context.Context, params in
 	return installer.NewV2GetPreflightRequirementsOK().WithPayload(&models.PreflightHardwareRequirements{})
 }
 
+func (f fakeInventory) V2GetClusterNetworkTopology(ctx context.Context, params installer.V2GetClusterNetworkTopologyParams) middleware.Responder {
+	return installer.NewV2GetClusterNetworkTopologyOK().WithPayload(&models.NetworkTopology{})
+}
+
+func (f fakeInventory) V2GetClusterVipSuggestions(ctx context.Context, params installer.V2GetClusterVipSuggestionsParams) middleware.Responder {
+	return installer.NewV2GetClusterVipSuggestionsOK().WithPayload(models.VipSuggestions{})
+}
+
+func (f fakeInventory) V2GetClusterIPStackReadiness(ctx context.Context, params installer.V2GetClusterIPStackReadinessParams) middleware.Responder {
+	return installer.NewV2GetClusterIPStackReadinessOK().WithPayload(&models.IPStackReadiness{})
+}
+
+func (f fakeInventory) V2RunBmcAction(ctx context.Context, params installer.V2RunBmcActionParams) middleware.Responder {
+	return installer.NewV2RunBmcActionOK().WithPayload(&models.BmcInventory{})
+}
+
+func (f fakeInventory) V2RegisterBmcReference(ctx context.Context, params installer.V2RegisterBmcReferenceParams) middleware.Responder {
+	return installer.NewV2RegisterBmcReferenceCreated().WithPayload(&models.BmcReference{})
+}
+
+func (f fakeInventory) V2ListBmcReferences(ctx context.Context, params installer.V2ListBmcReferencesParams) middleware.Responder {
+	return installer.NewV2ListBmcReferencesOK().WithPayload(models.BmcReferenceList{})
+}
+
+func (f fakeInventory) V2DeregisterBmcReference(ctx context.Context, params installer.V2DeregisterBmcReferenceParams) middleware.Responder {
+	return installer.NewV2DeregisterBmcReferenceNoContent()
+}
+
 func (f fakeInventory) V2CancelInstallation(ctx context.Context, params installer.V2CancelInstallationParams) middleware.Responder {
 	return installer.NewV2CancelInstallationAccepted()
 }
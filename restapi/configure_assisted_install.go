@@ -16,6 +16,7 @@ import (
 
 	"github.com/openshift/assisted-service/restapi/operations"
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/openshift/assisted-service/restapi/operations/federation"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
@@ -38,6 +39,17 @@ type EventsAPI interface {
 	V2TriggerEvent(ctx context.Context, params events.V2TriggerEventParams) middleware.Responder
 }
 
+//go:generate mockery -name FederationAPI -inpkg
+
+/* FederationAPI  */
+type FederationAPI interface {
+	/* V2ListFederationMembers Lists the assisted-service instances registered in the federation directory. */
+	V2ListFederationMembers(ctx context.Context, params federation.V2ListFederationMembersParams) middleware.Responder
+
+	/* V2RegisterFederationMember Registers an assisted-service instance in the federation directory, or renews its registration. */
+	V2RegisterFederationMember(ctx context.Context, params federation.V2RegisterFederationMemberParams) middleware.Responder
+}
+
 //go:generate mockery -name InstallerAPI -inpkg
 
 /* InstallerAPI  */
@@ -100,6 +112,9 @@ type InstallerAPI interface {
 	/* V2CancelInstallation Cancels an ongoing installation. */
 	V2CancelInstallation(ctx context.Context, params installer.V2CancelInstallationParams) middleware.Responder
 
+	/* V2DeregisterBmcReference Deregisters the BMC of a host of the infra-env and deletes its credentials. */
+	V2DeregisterBmcReference(ctx context.Context, params installer.V2DeregisterBmcReferenceParams) middleware.Responder
+
 	/* V2DownloadClusterCredentials Downloads credentials relating to the installed/installing cluster. */
 	V2DownloadClusterCredentials(ctx context.Context, params installer.V2DownloadClusterCredentialsParams) middleware.Responder
 
@@ -112,9 +127,18 @@ type InstallerAPI interface {
 	/* V2GetClusterDefaultConfig Get the default values for various cluster properties. */
 	V2GetClusterDefaultConfig(ctx context.Context, params installer.V2GetClusterDefaultConfigParams) middleware.Responder
 
+	/* V2GetClusterIPStackReadiness Get a report of the readiness of the cluster hosts for each IP address family, including whether the cluster can use IPv6 as its primary IP stack. */
+	V2GetClusterIPStackReadiness(ctx context.Context, params installer.V2GetClusterIPStackReadinessParams) middleware.Responder
+
+	/* V2GetClusterNetworkTopology Get a graph of the cluster network built from the hosts inventories and connectivity reports. */
+	V2GetClusterNetworkTopology(ctx context.Context, params installer.V2GetClusterNetworkTopologyParams) middleware.Responder
+
 	/* V2GetClusterUISettings Fetch cluster specific UI settings. */
 	V2GetClusterUISettings(ctx context.Context, params installer.V2GetClusterUISettingsParams) middleware.Responder
 
+	/* V2GetClusterVipSuggestions Get ranked API and ingress VIP candidates for each machine network of the cluster. */
+	V2GetClusterVipSuggestions(ctx context.Context, params installer.V2GetClusterVipSuggestionsParams) middleware.Responder
+
 	/* V2GetCredentials Get the cluster admin credentials. */
 	V2GetCredentials(ctx context.Context, params installer.V2GetCredentialsParams) middleware.Responder
 
@@ -124,6 +148,15 @@ type InstallerAPI interface {
 	/* V2GetPresignedForClusterFiles Retrieves a pre-signed S3 URL for downloading cluster files. */
 	V2GetPresignedForClusterFiles(ctx context.Context, params installer.V2GetPresignedForClusterFilesParams) middleware.Responder
 
+	/* V2ListBmcReferences Lists the BMCs of the hosts of the infra-env, without their credentials. */
+	V2ListBmcReferences(ctx context.Context, params installer.V2ListBmcReferencesParams) middleware.Responder
+
+	/* V2RegisterBmcReference Registers the BMC of a host of the infra-env.  The credentials of the BMC are kept in the secret store of the service and are never returned. */
+	V2RegisterBmcReference(ctx context.Context, params installer.V2RegisterBmcReferenceParams) middleware.Responder
+
+	/* V2RunBmcAction Runs an action on a host through its registered BMC, for example to boot the host from the discovery image of the infra-env. */
+	V2RunBmcAction(ctx context.Context, params installer.V2RunBmcActionParams) middleware.Responder
+
 	/* V2UpdateCluster Updates an OpenShift cluster definition. */
 	V2UpdateCluster(ctx context.Context, params installer.V2UpdateClusterParams) middleware.Responder
 
@@ -268,12 +301,28 @@ type ManifestsAPI interface {
 
 /* OperatorsAPI  */
 type OperatorsAPI interface {
+	/* V2CalculateClusterStorageSizing Calculates the capacity that a storage operator provides with the disks of the hosts of the cluster, and warns
+	   when it is below the target capacity.
+	*/
+	V2CalculateClusterStorageSizing(ctx context.Context, params operators.V2CalculateClusterStorageSizingParams) middleware.Responder
+
 	/* V2GetBundle Get operator properties for a bundle */
 	V2GetBundle(ctx context.Context, params operators.V2GetBundleParams) middleware.Responder
 
+	/* V2GetOperatorsDependencyGraph Get the dependency graph of a set of operators */
+	V2GetOperatorsDependencyGraph(ctx context.Context, params operators.V2GetOperatorsDependencyGraphParams) middleware.Responder
+
+	/* V2InstallClusterOperators Installs additional operators, and the operators they depend on, in an installed cluster. */
+	V2InstallClusterOperators(ctx context.Context, params operators.V2InstallClusterOperatorsParams) middleware.Responder
+
 	/* V2ListBundles Get list of available bundles */
 	V2ListBundles(ctx context.Context, params operators.V2ListBundlesParams) middleware.Responder
 
+	/* V2ListClusterOperatorsHealthHistory Lists the changes of the status of the operators of a cluster that were observed after the installation of the
+	   cluster, oldest first.
+	*/
+	V2ListClusterOperatorsHealthHistory(ctx context.Context, params operators.V2ListClusterOperatorsHealthHistoryParams) middleware.Responder
+
 	/* V2ListOfClusterOperators Lists operators to be monitored for a cluster. */
 	V2ListOfClusterOperators(ctx context.Context, params operators.V2ListOfClusterOperatorsParams) middleware.Responder
 
@@ -304,6 +353,7 @@ type VersionsAPI interface {
 // Config is configuration for Handler
 type Config struct {
 	EventsAPI
+	FederationAPI
 	InstallerAPI
 	ManagedDomainsAPI
 	ManifestsAPI
@@ -523,6 +573,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateInfraEnv(ctx, params)
 	})
+	api.OperatorsV2CalculateClusterStorageSizingHandler = operators.V2CalculateClusterStorageSizingHandlerFunc(func(params operators.V2CalculateClusterStorageSizingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2CalculateClusterStorageSizing(ctx, params)
+	})
 	api.InstallerV2CancelInstallationHandler = installer.V2CancelInstallationHandlerFunc(func(params installer.V2CancelInstallationParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -538,6 +593,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.V2DeleteClusterManifest(ctx, params)
 	})
+	api.InstallerV2DeregisterBmcReferenceHandler = installer.V2DeregisterBmcReferenceHandlerFunc(func(params installer.V2DeregisterBmcReferenceParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DeregisterBmcReference(ctx, params)
+	})
 	api.InstallerV2DownloadClusterCredentialsHandler = installer.V2DownloadClusterCredentialsHandlerFunc(func(params installer.V2DownloadClusterCredentialsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -563,16 +623,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetClusterDefaultConfig(ctx, params)
 	})
+	api.InstallerV2GetClusterIPStackReadinessHandler = installer.V2GetClusterIPStackReadinessHandlerFunc(func(params installer.V2GetClusterIPStackReadinessParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterIPStackReadiness(ctx, params)
+	})
+	api.InstallerV2GetClusterNetworkTopologyHandler = installer.V2GetClusterNetworkTopologyHandlerFunc(func(params installer.V2GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterNetworkTopology(ctx, params)
+	})
 	api.InstallerV2GetClusterUISettingsHandler = installer.V2GetClusterUISettingsHandlerFunc(func(params installer.V2GetClusterUISettingsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetClusterUISettings(ctx, params)
 	})
+	api.InstallerV2GetClusterVipSuggestionsHandler = installer.V2GetClusterVipSuggestionsHandlerFunc(func(params installer.V2GetClusterVipSuggestionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterVipSuggestions(ctx, params)
+	})
 	api.InstallerV2GetCredentialsHandler = installer.V2GetCredentialsHandlerFunc(func(params installer.V2GetCredentialsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetCredentials(ctx, params)
 	})
+	api.OperatorsV2GetOperatorsDependencyGraphHandler = operators.V2GetOperatorsDependencyGraphHandlerFunc(func(params operators.V2GetOperatorsDependencyGraphParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2GetOperatorsDependencyGraph(ctx, params)
+	})
 	api.InstallerV2GetPresignedForClusterCredentialsHandler = installer.V2GetPresignedForClusterCredentialsHandlerFunc(func(params installer.V2GetPresignedForClusterCredentialsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -583,6 +663,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetPresignedForClusterFiles(ctx, params)
 	})
+	api.OperatorsV2InstallClusterOperatorsHandler = operators.V2InstallClusterOperatorsHandlerFunc(func(params operators.V2InstallClusterOperatorsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2InstallClusterOperators(ctx, params)
+	})
+	api.InstallerV2ListBmcReferencesHandler = installer.V2ListBmcReferencesHandlerFunc(func(params installer.V2ListBmcReferencesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListBmcReferences(ctx, params)
+	})
 	api.OperatorsV2ListBundlesHandler = operators.V2ListBundlesHandlerFunc(func(params operators.V2ListBundlesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -593,6 +683,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.V2ListClusterManifests(ctx, params)
 	})
+	api.OperatorsV2ListClusterOperatorsHealthHistoryHandler = operators.V2ListClusterOperatorsHealthHistoryHandlerFunc(func(params operators.V2ListClusterOperatorsHealthHistoryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2ListClusterOperatorsHealthHistory(ctx, params)
+	})
 	api.ManagedDomainsV2ListManagedDomainsHandler = managed_domains.V2ListManagedDomainsHandlerFunc(func(params managed_domains.V2ListManagedDomainsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -613,6 +708,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.OperatorsAPI.V2ListSupportedOperators(ctx, params)
 	})
+	api.InstallerV2RegisterBmcReferenceHandler = installer.V2RegisterBmcReferenceHandlerFunc(func(params installer.V2RegisterBmcReferenceParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2RegisterBmcReference(ctx, params)
+	})
+	api.InstallerV2RunBmcActionHandler = installer.V2RunBmcActionHandlerFunc(func(params installer.V2RunBmcActionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2RunBmcAction(ctx, params)
+	})
 	api.InstallerV2UpdateClusterHandler = installer.V2UpdateClusterHandlerFunc(func(params installer.V2UpdateClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -728,6 +833,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.EventsAPI.V2ListEvents(ctx, params)
 	})
+	api.FederationV2ListFederationMembersHandler = federation.V2ListFederationMembersHandlerFunc(func(params federation.V2ListFederationMembersParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.FederationAPI.V2ListFederationMembers(ctx, params)
+	})
 	api.InstallerV2ListHostsHandler = installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -758,6 +868,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterDisconnectedCluster(ctx, params)
 	})
+	api.FederationV2RegisterFederationMemberHandler = federation.V2RegisterFederationMemberHandlerFunc(func(params federation.V2RegisterFederationMemberParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.FederationAPI.V2RegisterFederationMember(ctx, params)
+	})
 	api.InstallerV2RegisterHostHandler = installer.V2RegisterHostHandlerFunc(func(params installer.V2RegisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
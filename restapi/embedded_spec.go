@@ -97,6 +97,13 @@ func init() {
             "description": "If provided, returns only clusters that are owned by the specified user.",
             "name": "owner",
             "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include the clusters of all the member instances of the federation.",
+            "name": "federated",
+            "in": "query"
           }
         ],
         "responses": {
@@ -1797,6 +1804,72 @@ func init() {
         }
       }
     },
+    "/v2/clusters/{cluster_id}/ip-stack-readiness": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get a report of the readiness of the cluster hosts for each IP address family, including whether the cluster can use IPv6 as its primary IP stack.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterIPStackReadiness",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to evaluate.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ip-stack-readiness"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
@@ -2638,39 +2711,46 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get preflight requirements for a cluster.",
+      },
+      "post": {
+        "description": "Installs additional operators, and the operators they depend on, in an installed cluster.",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "V2InstallClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
+            "description": "The installed cluster where the operators are installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The operators to install.",
+            "name": "install-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/operator-create-params"
+              }
+            }
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2697,6 +2777,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2706,40 +2792,35 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation finalizing progress.",
+    "/v2/clusters/{cluster_id}/monitored-operators/health-history": {
+      "get": {
+        "description": "Lists the changes of the status of the operators of a cluster that were observed after the installation of the\ncluster, oldest first.\n",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "v2UpdateClusterFinalizingProgress",
+        "operationId": "V2ListClusterOperatorsHealthHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster being updated.",
+            "description": "The cluster to return the health history of its operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "New progress value.",
-            "name": "finalizing-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-finalizing-progress"
-            }
+            "type": "string",
+            "description": "An operator in the specified cluster to return its health history.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operator-health-history"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2770,17 +2851,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
+    "/v2/clusters/{cluster_id}/network-topology": {
       "get": {
         "security": [
           {
@@ -2791,16 +2866,16 @@ func init() {
             ]
           }
         ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "description": "Get a graph of the cluster network built from the hosts inventories and connectivity reports.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "V2GetClusterNetworkTopology",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
+            "description": "The cluster to return the network topology for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2810,10 +2885,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
+              "$ref": "#/definitions/network-topology"
             }
           },
           "401": {
@@ -2834,6 +2906,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2843,18 +2921,27 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/ui-settings": {
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
       "get": {
-        "description": "Fetch cluster specific UI settings.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterUISettings",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be retrieved.",
+            "description": "The cluster to return preflight requirements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2864,13 +2951,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -2891,6 +2972,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2898,44 +2985,42 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/v2/clusters/{cluster_id}/progress": {
       "put": {
-        "description": "Update cluster specific UI settings.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation finalizing progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateClusterUISettings",
+        "operationId": "v2UpdateClusterFinalizingProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be updated.",
+            "description": "The cluster being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Settings for the installer UI.",
-            "name": "ui-settings",
+            "description": "New progress value.",
+            "name": "finalizing-progress",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/cluster-finalizing-progress"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -2955,56 +3040,324 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+    "/v2/clusters/{cluster_id}/storage-sizing": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Calculates the capacity that a storage operator provides with the disks of the hosts of the cluster, and warns\nwhen it is below the target capacity.\n",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "V2CalculateClusterStorageSizing",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster whose hosts provide the storage.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
+            "description": "The storage operator and its configuration.",
+            "name": "sizing-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/storage-sizing-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/storage-sizing"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/supported-platforms": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterSupportedPlatforms",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose platform types should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/ui-settings": {
+      "get": {
+        "description": "Fetch cluster specific UI settings.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterUISettings",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which UI settings should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update cluster specific UI settings.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2UpdateClusterUISettings",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which UI settings should be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Settings for the installer UI.",
+            "name": "ui-settings",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Transfer the ingress certificate for the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UploadClusterIngressCert",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to associate with the ingress certificate.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
           "400": {
             "description": "Error.",
             "schema": {
@@ -3050,6 +3403,87 @@ func init() {
         }
       }
     },
+    "/v2/clusters/{cluster_id}/vip-suggestions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get ranked API and ingress VIP candidates for each machine network of the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterVipSuggestions",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to suggest VIPs for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 20,
+            "minimum": 1,
+            "type": "integer",
+            "default": 3,
+            "description": "The maximal number of candidates returned for each VIP type and machine network.",
+            "name": "max_candidates",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/vip-suggestions"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/component-versions": {
       "get": {
         "security": [
@@ -3226,6 +3660,13 @@ func init() {
             "description": "A comma-separated list of event categories.",
             "name": "categories",
             "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include the events of all the member instances of the federation.",
+            "name": "federated",
+            "in": "query"
           }
         ],
         "responses": {
@@ -3339,35 +3780,147 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Cluster cannot accept new agents due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/federation/members": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the assisted-service instances registered in the federation directory.",
+        "tags": [
+          "federation"
+        ],
+        "operationId": "v2ListFederationMembers",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/federation-member-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Registers an assisted-service instance in the federation directory, or renews its registration.",
+        "tags": [
+          "federation"
+        ],
+        "operationId": "v2RegisterFederationMember",
+        "parameters": [
+          {
+            "description": "The instance to register.",
+            "name": "member",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/federation-member"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/federation-member"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
@@ -3827,29 +4380,272 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-actions": {
+      "post": {
+        "description": "Runs an action on a host through its registered BMC, for example to boot the host from the discovery image of the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2RunBmcAction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose discovery image the host boots from.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The BMC reference of the host and the action to run.",
+            "name": "bmc_action_params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-action-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-inventory"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-references": {
+      "get": {
+        "description": "Lists the BMCs of the hosts of the infra-env, without their credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2ListBmcReferences",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose BMC references should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-reference-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Registers the BMC of a host of the infra-env.  The credentials of the BMC are kept in the secret store of the service and are never returned.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2RegisterBmcReference",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose hosts the BMC belongs to.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The address and the credentials of the BMC.",
+            "name": "bmc_reference_create_params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-reference-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-reference"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-references/{bmc_reference_id}": {
+      "delete": {
+        "description": "Deregisters the BMC of a host of the infra-env and deletes its credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2DeregisterBmcReference",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose hosts the BMC belongs to.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC reference that should be deregistered.",
+            "name": "bmc_reference_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
@@ -5924,6 +6720,102 @@ func init() {
         }
       }
     },
+    "/v2/operators/dependency-graph": {
+      "get": {
+        "description": "Retrieves the operators that would be installed when the given operators are requested, why each of them is\npulled in, the conflicts with other features and the aggregated hardware requirements.\n",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get the dependency graph of a set of operators",
+        "operationId": "V2GetOperatorsDependencyGraph",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Names of the requested operators, for example ` + "`" + `openshift-ai` + "`" + ` or ` + "`" + `cnv` + "`" + `.",
+            "name": "operator_names",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster. If the parameter is not specified, support levels and conflicts aren't computed.",
+            "name": "openshift_version",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external"
+            ],
+            "type": "string",
+            "description": "The provider platform type. openshift_version must be set.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
+            "name": "external_platform_name",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect the dependencies (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/operators-dependency-graph"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/release-sources": {
       "get": {
         "security": [
@@ -6361,6 +7253,39 @@ func init() {
     }
   },
   "definitions": {
+    "address-family-readiness": {
+      "type": "object",
+      "required": [
+        "address_family",
+        "ready"
+      ],
+      "properties": {
+        "address_family": {
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        },
+        "addresses": {
+          "description": "The addresses of the host in the address family.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ip-stack-check"
+          }
+        },
+        "ready": {
+          "description": "Whether none of the checks of the address family failed.",
+          "type": "boolean"
+        }
+      }
+    },
     "api_vip": {
       "description": "The virtual IP used to reach the OpenShift cluster's API.",
       "type": "object",
@@ -6371,6 +7296,10 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
+        "conflicting_mac_address": {
+          "description": "MAC address of a device that is not part of the cluster and answers for the VIP, 'unknown' when the agent didn't report it.",
+          "type": "string"
+        },
         "ip": {
           "description": "The IP address.",
           "$ref": "#/definitions/ip"
@@ -6472,6 +7401,171 @@ func init() {
         }
       }
     },
+    "bmc-action": {
+      "description": "The action to run through the BMC of a host.\nboot-discovery-image: inserts the discovery image of the infra-env as virtual media, sets it as the one-time boot device and power cycles the host.\npower-cycle: power cycles the host, or powers it on when it is off.\nget-inventory: only reads the inventory of the BMC.\n",
+      "type": "string",
+      "enum": [
+        "boot-discovery-image",
+        "power-cycle",
+        "get-inventory"
+      ]
+    },
+    "bmc-action-params": {
+      "type": "object",
+      "required": [
+        "action",
+        "bmc_reference_id"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/bmc-action"
+        },
+        "bmc_reference_id": {
+          "description": "The registered BMC of the host to run the action on.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "bmc-inventory": {
+      "description": "The inventory of a host as reported by its BMC.",
+      "type": "object",
+      "properties": {
+        "bios_version": {
+          "type": "string"
+        },
+        "cpu_count": {
+          "type": "integer"
+        },
+        "driver": {
+          "description": "The driver used to connect to the BMC.",
+          "type": "string",
+          "enum": [
+            "redfish",
+            "ipmi"
+          ]
+        },
+        "manufacturer": {
+          "type": "string"
+        },
+        "memory_gib": {
+          "type": "number"
+        },
+        "model": {
+          "type": "string"
+        },
+        "power_state": {
+          "description": "The power state of the host, for example On or Off.",
+          "type": "string"
+        },
+        "serial_number": {
+          "type": "string"
+        },
+        "virtual_media_supported": {
+          "description": "Whether the BMC can boot the host from virtual media.",
+          "type": "boolean"
+        }
+      }
+    },
+    "bmc-reference": {
+      "description": "The BMC of a host of an infra-env.  The credentials of the BMC are kept in the secret store of the service.",
+      "type": "object",
+      "required": [
+        "id",
+        "infra_env_id",
+        "address",
+        "username"
+      ],
+      "properties": {
+        "address": {
+          "description": "The address of the host's BMC.",
+          "type": "string"
+        },
+        "certificate_verification": {
+          "description": "Whether certificate verification is enabled or disabled when connecting to the host's BMC.",
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env whose hosts the BMC belongs to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "name": {
+          "description": "A name that identifies the host of the BMC, for example its hostname.",
+          "type": "string"
+        },
+        "username": {
+          "description": "The username to connect to the host's BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-reference-create-params": {
+      "type": "object",
+      "required": [
+        "address",
+        "username",
+        "password"
+      ],
+      "properties": {
+        "address": {
+          "description": "The address of the host's BMC, for example redfish-virtualmedia://bmc1.example.com/redfish/v1/Systems/1 or ipmi://bmc1.example.com:623.",
+          "type": "string"
+        },
+        "certificate_verification": {
+          "description": "Whether to enable or disable certificate verification when connecting to the host's BMC.",
+          "type": "string",
+          "default": "Enabled",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "name": {
+          "description": "A name that identifies the host of the BMC, for example its hostname.",
+          "type": "string"
+        },
+        "password": {
+          "description": "The password to connect to the host's BMC.",
+          "type": "string",
+          "format": "password"
+        },
+        "username": {
+          "description": "The username to connect to the host's BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-reference-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/bmc-reference"
+      }
+    },
     "boot": {
       "type": "object",
       "properties": {
@@ -6658,6 +7752,12 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "federation_instance_id": {
+          "description": "The federation member instance that owns the cluster, set in the federated responses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"-\"",
+          "readOnly": true
+        },
         "high_availability_mode": {
           "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
@@ -6843,6 +7943,9 @@ func init() {
           "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
+        "operators_catalog_source": {
+          "$ref": "#/definitions/operators-catalog-source"
+        },
         "org_id": {
           "type": "string"
         },
@@ -7129,6 +8232,9 @@ func init() {
           "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
+        "operators_catalog_source": {
+          "$ref": "#/definitions/operators-catalog-source"
+        },
         "platform": {
           "x-nullable": true,
           "$ref": "#/definitions/platform"
@@ -7304,6 +8410,7 @@ func init() {
         "api-vips-valid",
         "ingress-vips-defined",
         "ingress-vips-valid",
+        "vips-conflict-free",
         "all-hosts-are-ready-to-install",
         "sufficient-masters-count",
         "dns-domain-defined",
@@ -7340,7 +8447,8 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "declarative-operators-requirements-satisfied"
       ]
     },
     "cluster_default_config": {
@@ -8130,6 +9238,12 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "federation_instance_id": {
+          "description": "The federation member instance that reported the event, set in the federated responses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"-\"",
+          "readOnly": true
+        },
         "host_id": {
           "description": "Unique identifier of the host this event relates to.",
           "type": "string",
@@ -8258,10 +9372,50 @@ func init() {
         "METALLB",
         "DUAL_STACK_PRIMARY_IPV6",
         "LOKI",
-        "OPENSHIFT_LOGGING"
+        "OPENSHIFT_LOGGING",
+        "DECLARATIVE_OPERATOR"
       ],
       "x-nullable": false
     },
+    "federation-member": {
+      "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
+      "properties": {
+        "id": {
+          "description": "Unique identifier of the assisted-service instance in the federation.",
+          "type": "string"
+        },
+        "last_seen_at": {
+          "description": "The time the instance last renewed its registration.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "region": {
+          "description": "The region of the assisted-service instance.",
+          "type": "string"
+        },
+        "registered_at": {
+          "description": "The time the instance first registered in the directory.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "url": {
+          "description": "The base URL of the assisted-service instance, e.g. https://assisted.us-east.example.com.",
+          "type": "string"
+        }
+      }
+    },
+    "federation-member-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/federation-member"
+      }
+    },
     "fencing-credentials-params": {
       "type": "object",
       "required": [
@@ -8696,6 +9850,24 @@ func init() {
         }
       }
     },
+    "host-ip-stack-readiness": {
+      "type": "object",
+      "properties": {
+        "address_families": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/address-family-readiness"
+          }
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        }
+      }
+    },
     "host-list": {
       "type": "array",
       "items": {
@@ -8931,6 +10103,9 @@ func init() {
         "openshift-ai-requirements-satisfied",
         "authorino-requirements-satisfied",
         "mtu-valid",
+        "bond-members-consistent",
+        "vlan-interfaces-consistent",
+        "vlan-subnets-consistent",
         "nmstate-requirements-satisfied",
         "amd-gpu-requirements-satisfied",
         "kmm-requirements-satisfied",
@@ -8944,7 +10119,8 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "declarative-operators-requirements-satisfied"
       ]
     },
     "host_network": {
@@ -9492,6 +10668,10 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
+        "conflicting_mac_address": {
+          "description": "MAC address of a device that is not part of the cluster and answers for the VIP, 'unknown' when the agent didn't report it.",
+          "type": "string"
+        },
         "ip": {
           "description": "The IP address.",
           "$ref": "#/definitions/ip"
@@ -9638,6 +10818,10 @@ func init() {
         "client_id": {
           "type": "string"
         },
+        "controller": {
+          "description": "Name of the bond or team interface that this interface is a port of. Not reported by older agents, the bond and team validation is skipped without it.",
+          "type": "string"
+        },
         "flags": {
           "type": "array",
           "items": {
@@ -9679,6 +10863,14 @@ func init() {
         },
         "vendor": {
           "type": "string"
+        },
+        "vlan_base_interface": {
+          "description": "Name of the interface that a VLAN interface is created on. Not reported by older agents, it is then parsed from the \u003cbase\u003e.\u003cid\u003e name of the interface.",
+          "type": "string"
+        },
+        "vlan_id": {
+          "description": "VLAN ID of a VLAN interface. Not reported by older agents, the VLAN ID is then parsed from the \u003cbase\u003e.\u003cid\u003e name of the interface.",
+          "type": "integer"
         }
       }
     },
@@ -9754,6 +10946,91 @@ func init() {
       "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
       "x-go-custom-tag": "gorm:\"primaryKey\""
     },
+    "ip-stack-check": {
+      "type": "object",
+      "required": [
+        "name",
+        "status"
+      ],
+      "properties": {
+        "message": {
+          "type": "string"
+        },
+        "name": {
+          "description": "The checked item. The dns checks verify that the domain names of the API, of the applications and of the release image registry resolve to addresses of the family, they do not connect to them. The registry-reachability check uses the image pulls reported by the agent, it is unknown when the pulls can't be attributed to the family.",
+          "type": "string",
+          "enum": [
+            "addresses",
+            "default-route",
+            "api-dns",
+            "apps-dns",
+            "ntp",
+            "registry-dns",
+            "registry-reachability",
+            "mtu"
+          ]
+        },
+        "status": {
+          "description": "The check result. Unknown means that the host has not reported the data needed for the check yet.",
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "unknown"
+          ]
+        }
+      }
+    },
+    "ip-stack-readiness": {
+      "description": "Readiness of the cluster hosts for each IP address family.",
+      "type": "object",
+      "required": [
+        "ipv6_primary_ready",
+        "ipv6_only_ready"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-ip-stack-readiness"
+          }
+        },
+        "ipv6_only_blockers": {
+          "description": "The reasons that prevent the cluster from being installed as a single-stack IPv6 cluster.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_only_ready": {
+          "description": "Whether the cluster can be installed as a single-stack IPv6 cluster, only the IPv6 checks are considered.",
+          "type": "boolean"
+        },
+        "ipv6_primary_blockers": {
+          "description": "The reasons that prevent the cluster from using IPv6 as the primary IP stack of a dual-stack cluster.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_primary_ready": {
+          "description": "Whether the cluster can use IPv6 as the primary IP stack of a dual-stack cluster, which requires both address families.",
+          "type": "boolean"
+        },
+        "primary_ip_stack": {
+          "description": "The primary IP stack currently configured for a dual-stack cluster, if any.",
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        }
+      }
+    },
     "iscsi": {
       "type": "object",
       "properties": {
@@ -10089,16 +11366,27 @@ func init() {
             "type": "StringArray"
           }
         },
+        "channel": {
+          "description": "The subscription channel the operator is pinned to. Empty for the default channel of the operator.",
+          "type": "string"
+        },
         "cluster_id": {
           "description": "The cluster that this operator is associated with.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
+        "day2": {
+          "description": "Whether the operator was installed after the installation of the cluster.",
+          "type": "boolean"
+        },
         "dependency_only": {
           "description": "Whether the operator can't be installed without being required by another operator.",
           "type": "boolean"
         },
+        "install_plan_approval": {
+          "$ref": "#/definitions/operator-install-plan-approval"
+        },
         "name": {
           "description": "Unique name of the operator.",
           "type": "string",
@@ -10116,6 +11404,10 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "starting_csv": {
+          "description": "The cluster service version the subscription of the operator starts from. Empty for the latest version of the channel.",
+          "type": "string"
+        },
         "status": {
           "$ref": "#/definitions/operator-status"
         },
@@ -10143,6 +11435,45 @@ func init() {
         }
       }
     },
+    "monitored-operator-health-history": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator-health-record"
+      }
+    },
+    "monitored-operator-health-record": {
+      "description": "A change of the status of an operator observed after the installation of the cluster.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this operator is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "id": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "operator_name": {
+          "description": "Unique name of the operator.",
+          "type": "string"
+        },
+        "reported_at": {
+          "description": "Time at which the status was observed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        }
+      }
+    },
     "monitored-operators-list": {
       "type": "array",
       "items": {
@@ -10163,6 +11494,107 @@ func init() {
         }
       }
     },
+    "network-topology": {
+      "description": "Graph of the hosts, interfaces, subnets and VIPs of a cluster and the reachability observed between the hosts.",
+      "type": "object",
+      "properties": {
+        "dot": {
+          "description": "Graphviz DOT rendering of the graph.",
+          "type": "string"
+        },
+        "edges": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-edge"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-node"
+          }
+        }
+      }
+    },
+    "network-topology-edge": {
+      "type": "object",
+      "required": [
+        "source",
+        "target",
+        "kind"
+      ],
+      "properties": {
+        "attributes": {
+          "description": "Additional properties of the edge, e.g. the address assigned to an interface or the outgoing NIC of a connectivity check.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "interface",
+            "address",
+            "vip",
+            "default-route",
+            "l2-connectivity",
+            "l3-connectivity"
+          ]
+        },
+        "source": {
+          "description": "Identifier of the node the edge starts from.",
+          "type": "string"
+        },
+        "successful": {
+          "description": "For connectivity edges, whether the check between the hosts succeeded.",
+          "type": "boolean"
+        },
+        "target": {
+          "description": "Identifier of the node the edge ends at.",
+          "type": "string"
+        }
+      }
+    },
+    "network-topology-node": {
+      "type": "object",
+      "required": [
+        "id",
+        "kind"
+      ],
+      "properties": {
+        "attributes": {
+          "description": "Additional properties of the node, e.g. the MAC address and MTU of an interface.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "host_id": {
+          "description": "The host the node belongs to. Set for host and interface nodes.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "description": "Unique identifier of the node within the graph.",
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "host",
+            "interface",
+            "subnet",
+            "vip",
+            "gateway"
+          ]
+        },
+        "label": {
+          "description": "Human readable name of the node.",
+          "type": "string"
+        }
+      }
+    },
     "next_step_cmd_request": {
       "type": "object",
       "required": [
@@ -10321,13 +11753,74 @@ func init() {
     "operator-create-params": {
       "type": "object",
       "properties": {
+        "channel": {
+          "description": "The subscription channel to pin the operator to, one of the channels known for the OpenShift version of the cluster.",
+          "type": "string"
+        },
+        "install_plan_approval": {
+          "$ref": "#/definitions/operator-install-plan-approval"
+        },
         "name": {
           "type": "string"
         },
         "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "description": "Blob of operator-dependent parameters that are required for installation. For the operators that describe\ntheir properties it is a JSON object validated against them.\n",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "starting_csv": {
+          "description": "The cluster service version the subscription of the operator starts from.",
+          "type": "string"
+        }
+      }
+    },
+    "operator-dependency-conflict": {
+      "type": "object",
+      "properties": {
+        "feature_id": {
+          "description": "The feature that the operator is incompatible with.",
+          "$ref": "#/definitions/feature-support-level-id"
+        },
+        "message": {
+          "type": "string"
+        },
+        "operator": {
+          "description": "Name of the operator of the graph.",
+          "type": "string"
+        }
+      }
+    },
+    "operator-dependency-node": {
+      "type": "object",
+      "properties": {
+        "dependencies": {
+          "description": "Names of the operators that this operator depends on.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Unique name of the operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "string"
+        },
+        "requested": {
+          "description": "Whether the operator was requested explicitly or is only pulled in as a dependency.",
+          "type": "boolean"
+        },
+        "required_by": {
+          "description": "Names of the operators of the graph that depend on this operator.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        },
+        "support_level": {
+          "description": "Support level of the operator, only set when the OpenShift version is known.",
+          "$ref": "#/definitions/support-level"
         }
       }
     },
@@ -10363,6 +11856,14 @@ func init() {
         }
       }
     },
+    "operator-install-plan-approval": {
+      "description": "Approval of the install plans of the operator subscription. Manual prevents OLM from upgrading the operator\npast the starting cluster service version. Empty for automatic approval.\n",
+      "type": "string",
+      "enum": [
+        "Automatic",
+        "Manual"
+      ]
+    },
     "operator-monitor-report": {
       "type": "object",
       "properties": {
@@ -10393,13 +11894,14 @@ func init() {
       "type": "object",
       "properties": {
         "data_type": {
-          "description": "Type of the property",
+          "description": "Type of the property, the items of array properties are strings",
           "type": "string",
           "enum": [
             "boolean",
             "string",
             "integer",
-            "float"
+            "float",
+            "array"
           ]
         },
         "default_value": {
@@ -10414,6 +11916,16 @@ func init() {
           "description": "Indicates whether the property is reqired",
           "type": "boolean"
         },
+        "maximum": {
+          "description": "Maximum value of integer and float properties",
+          "type": "number",
+          "x-nullable": true
+        },
+        "minimum": {
+          "description": "Minimum value of integer and float properties",
+          "type": "number",
+          "x-nullable": true
+        },
         "name": {
           "description": "Name of the property",
           "type": "string"
@@ -10424,6 +11936,10 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "pattern": {
+          "description": "Regular expression matching the values of string properties, or the items of array properties",
+          "type": "string"
         }
       }
     },
@@ -10444,6 +11960,65 @@ func init() {
         "olm"
       ]
     },
+    "operators-catalog-source": {
+      "description": "Catalog source used by the subscriptions of the OLM operators instead of the default catalogs, usually the index\nimage of a mirror registry for disconnected clusters. The catalog source is created during the installation.\n",
+      "type": "object",
+      "properties": {
+        "display_name": {
+          "description": "Name of the catalog source displayed in the console.",
+          "type": "string"
+        },
+        "index_image": {
+          "description": "Pull spec of the index image. An empty index image removes the catalog source of the cluster.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the CatalogSource created in the openshift-marketplace namespace.",
+          "type": "string"
+        },
+        "packages": {
+          "description": "Packages contained in the index image. The packages of the selected operators must be part of the list.\n",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:operators_catalog_source_\""
+    },
+    "operators-dependency-graph": {
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "Features that are incompatible with the operators of the graph.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-dependency-conflict"
+          }
+        },
+        "operators": {
+          "description": "The requested operators and all the operators they depend on.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-dependency-node"
+          }
+        },
+        "requirements": {
+          "description": "Aggregated hardware requirements of all the operators of the graph.",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
     "os-image": {
       "type": "object",
       "required": [
@@ -10884,6 +12459,132 @@ func init() {
         "$ref": "#/definitions/step-reply"
       }
     },
+    "storage-sizing": {
+      "type": "object",
+      "properties": {
+        "device_paths": {
+          "description": "Patterns of the paths of the disks used by the operator.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "hosts": {
+          "description": "The hosts used by the operator and their disks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/storage-sizing-host"
+          }
+        },
+        "operator_name": {
+          "description": "The storage operator.",
+          "type": "string"
+        },
+        "raw_capacity_bytes": {
+          "description": "Total size of the disks used by the operator.",
+          "type": "integer"
+        },
+        "replica": {
+          "description": "Number of copies of the data.",
+          "type": "integer"
+        },
+        "reserved_percentage": {
+          "description": "Percentage of the disks that can't be used for data.",
+          "type": "integer"
+        },
+        "target_capacity_bytes": {
+          "description": "The capacity needed, zero when no target was set.",
+          "type": "integer"
+        },
+        "usable_capacity_bytes": {
+          "description": "Capacity available for data, once the reserved space and the copies of the data are removed.",
+          "type": "integer"
+        },
+        "warnings": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "storage-sizing-disk": {
+      "type": "object",
+      "properties": {
+        "drive_type": {
+          "$ref": "#/definitions/drive_type"
+        },
+        "id": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        }
+      }
+    },
+    "storage-sizing-host": {
+      "type": "object",
+      "properties": {
+        "disks": {
+          "description": "The disks of the host used by the operator.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/storage-sizing-disk"
+          }
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "raw_capacity_bytes": {
+          "description": "Total size of the disks of the host used by the operator.",
+          "type": "integer"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        }
+      }
+    },
+    "storage-sizing-params": {
+      "type": "object",
+      "required": [
+        "operator_name"
+      ],
+      "properties": {
+        "device_paths": {
+          "description": "Patterns of the paths of the disks used by the operator, matched against the path and the by-id path of the\ndisks. All the disks are used when empty.\n",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operator_name": {
+          "description": "The storage operator, for example odf, lvm or lso.",
+          "type": "string"
+        },
+        "replica": {
+          "description": "Number of copies of the data. The default of the operator is used when not set.",
+          "type": "integer",
+          "minimum": 1
+        },
+        "reserved_percentage": {
+          "description": "Percentage of the disks that can't be used for data. The default of the operator is used when not set.",
+          "type": "integer",
+          "maximum": 99,
+          "x-nullable": true
+        },
+        "target_capacity_gb": {
+          "description": "The capacity needed, a warning is returned when the usable capacity is below it.",
+          "type": "integer"
+        }
+      }
+    },
     "subnet": {
       "type": "string",
       "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
@@ -11239,6 +12940,9 @@ func init() {
             "$ref": "#/definitions/operator-create-params"
           }
         },
+        "operators_catalog_source": {
+          "$ref": "#/definitions/operators-catalog-source"
+        },
         "platform": {
           "$ref": "#/definitions/platform"
         },
@@ -11292,6 +12996,11 @@ func init() {
       "description": "Single VIP verification result.",
       "type": "object",
       "properties": {
+        "conflicting_mac_address": {
+          "description": "MAC address that answered the ARP or NDP requests sent for the VIP, if any.",
+          "type": "string",
+          "format": "mac"
+        },
         "verification": {
           "$ref": "#/definitions/vip_verification"
         },
@@ -11369,6 +13078,55 @@ func init() {
         "type": "string"
       }
     },
+    "vip-candidate": {
+      "type": "object",
+      "properties": {
+        "ip": {
+          "$ref": "#/definitions/ip"
+        },
+        "score": {
+          "description": "Rank of the candidate. Candidates with a higher score are preferred.",
+          "type": "integer"
+        },
+        "verified": {
+          "description": "Whether the free addresses scan of all the hosts reported the address as unused.",
+          "type": "boolean"
+        }
+      }
+    },
+    "vip-suggestion": {
+      "type": "object",
+      "properties": {
+        "address_family": {
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        },
+        "api_vip_candidates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/vip-candidate"
+          }
+        },
+        "ingress_vip_candidates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/vip-candidate"
+          }
+        },
+        "machine_network_cidr": {
+          "$ref": "#/definitions/subnet"
+        }
+      }
+    },
+    "vip-suggestions": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/vip-suggestion"
+      }
+    },
     "vip_type": {
       "description": "The vip type.",
       "type": "string",
@@ -11437,6 +13195,10 @@ func init() {
       "description": "Events related to a cluster installation.",
       "name": "events"
     },
+    {
+      "description": "Directory of the assisted-service instances of a federation.",
+      "name": "federation"
+    },
     {
       "description": "General OpenShift cluster installation APIs.",
       "name": "installer"
@@ -11539,6 +13301,13 @@ func init() {
             "description": "If provided, returns only clusters that are owned by the specified user.",
             "name": "owner",
             "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include the clusters of all the member instances of the federation.",
+            "name": "federated",
+            "in": "query"
           }
         ],
         "responses": {
@@ -13203,7 +14972,73 @@ func init() {
           "400": {
             "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/ip-stack-readiness": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get a report of the readiness of the cluster hosts for each IP address family, including whether the cluster can use IPv6 as its primary IP stack.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterIPStackReadiness",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to evaluate.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ip-stack-readiness"
             }
           },
           "401": {
@@ -14080,6 +15915,214 @@ func init() {
             }
           }
         }
+      },
+      "post": {
+        "description": "Installs additional operators, and the operators they depend on, in an installed cluster.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2InstallClusterOperators",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The installed cluster where the operators are installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The operators to install.",
+            "name": "install-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/operator-create-params"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/monitored-operators/health-history": {
+      "get": {
+        "description": "Lists the changes of the status of the operators of a cluster that were observed after the installation of the\ncluster, oldest first.\n",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListClusterOperatorsHealthHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return the health history of its operators for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its health history.",
+            "name": "operator_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operator-health-history"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/network-topology": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get a graph of the cluster network built from the hosts inventories and connectivity reports.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterNetworkTopology",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return the network topology for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/network-topology"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
     "/v2/clusters/{cluster_id}/preflight-requirements": {
@@ -14097,23 +16140,91 @@ func init() {
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "v2GetPreflightRequirements",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return preflight requirements for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/preflight-hardware-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation finalizing progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateClusterFinalizingProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
+            "description": "The cluster being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "finalizing-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-finalizing-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -14144,44 +16255,54 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation finalizing progress.",
+    "/v2/clusters/{cluster_id}/storage-sizing": {
+      "post": {
+        "description": "Calculates the capacity that a storage operator provides with the disks of the hosts of the cluster, and warns\nwhen it is below the target capacity.\n",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "v2UpdateClusterFinalizingProgress",
+        "operationId": "V2CalculateClusterStorageSizing",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster being updated.",
+            "description": "The cluster whose hosts provide the storage.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "New progress value.",
-            "name": "finalizing-progress",
+            "description": "The storage operator and its configuration.",
+            "name": "sizing-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-finalizing-progress"
+              "$ref": "#/definitions/storage-sizing-params"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/storage-sizing"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -14212,12 +16333,6 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
@@ -14492,6 +16607,87 @@ func init() {
         }
       }
     },
+    "/v2/clusters/{cluster_id}/vip-suggestions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get ranked API and ingress VIP candidates for each machine network of the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterVipSuggestions",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to suggest VIPs for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 20,
+            "minimum": 1,
+            "type": "integer",
+            "default": 3,
+            "description": "The maximal number of candidates returned for each VIP type and machine network.",
+            "name": "max_candidates",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/vip-suggestions"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/component-versions": {
       "get": {
         "security": [
@@ -14668,6 +16864,13 @@ func init() {
             "description": "A comma-separated list of event categories.",
             "name": "categories",
             "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include the events of all the member instances of the federation.",
+            "name": "federated",
+            "in": "query"
           }
         ],
         "responses": {
@@ -14746,21 +16949,157 @@ func init() {
         "tags": [
           "events"
         ],
-        "operationId": "v2TriggerEvent",
+        "operationId": "v2TriggerEvent",
+        "parameters": [
+          {
+            "description": "The event to be created.",
+            "name": "trigger-event-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/event"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Cluster cannot accept new agents due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/federation/members": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the assisted-service instances registered in the federation directory.",
+        "tags": [
+          "federation"
+        ],
+        "operationId": "v2ListFederationMembers",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/federation-member-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Registers an assisted-service instance in the federation directory, or renews its registration.",
+        "tags": [
+          "federation"
+        ],
+        "operationId": "v2RegisterFederationMember",
         "parameters": [
           {
-            "description": "The event to be created.",
-            "name": "trigger-event-params",
+            "description": "The instance to register.",
+            "name": "member",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/event"
+              "$ref": "#/definitions/federation-member"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/federation-member"
+            }
           },
           "400": {
             "description": "Error.",
@@ -14786,35 +17125,11 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
@@ -15280,7 +17595,256 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-actions": {
+      "post": {
+        "description": "Runs an action on a host through its registered BMC, for example to boot the host from the discovery image of the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2RunBmcAction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose discovery image the host boots from.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The BMC reference of the host and the action to run.",
+            "name": "bmc_action_params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-action-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-inventory"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-references": {
+      "get": {
+        "description": "Lists the BMCs of the hosts of the infra-env, without their credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2ListBmcReferences",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose BMC references should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-reference-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Registers the BMC of a host of the infra-env.  The credentials of the BMC are kept in the secret store of the service and are never returned.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2RegisterBmcReference",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose hosts the BMC belongs to.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The address and the credentials of the BMC.",
+            "name": "bmc_reference_create_params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-reference-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-reference"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-references/{bmc_reference_id}": {
+      "delete": {
+        "description": "Deregisters the BMC of a host of the infra-env and deletes its credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2DeregisterBmcReference",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose hosts the BMC belongs to.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC reference that should be deregistered.",
+            "name": "bmc_reference_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -15291,12 +17855,6 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
@@ -17371,6 +19929,102 @@ func init() {
         }
       }
     },
+    "/v2/operators/dependency-graph": {
+      "get": {
+        "description": "Retrieves the operators that would be installed when the given operators are requested, why each of them is\npulled in, the conflicts with other features and the aggregated hardware requirements.\n",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get the dependency graph of a set of operators",
+        "operationId": "V2GetOperatorsDependencyGraph",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Names of the requested operators, for example ` + "`" + `openshift-ai` + "`" + ` or ` + "`" + `cnv` + "`" + `.",
+            "name": "operator_names",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster. If the parameter is not specified, support levels and conflicts aren't computed.",
+            "name": "openshift_version",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external"
+            ],
+            "type": "string",
+            "description": "The provider platform type. openshift_version must be set.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
+            "name": "external_platform_name",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect the dependencies (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/operators-dependency-graph"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/release-sources": {
       "get": {
         "security": [
@@ -17926,6 +20580,39 @@ func init() {
       },
       "x-go-name": "TangServerSignatures"
     },
+    "address-family-readiness": {
+      "type": "object",
+      "required": [
+        "address_family",
+        "ready"
+      ],
+      "properties": {
+        "address_family": {
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        },
+        "addresses": {
+          "description": "The addresses of the host in the address family.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ip-stack-check"
+          }
+        },
+        "ready": {
+          "description": "Whether none of the checks of the address family failed.",
+          "type": "boolean"
+        }
+      }
+    },
     "api_vip": {
       "description": "The virtual IP used to reach the OpenShift cluster's API.",
       "type": "object",
@@ -17936,6 +20623,10 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
+        "conflicting_mac_address": {
+          "description": "MAC address of a device that is not part of the cluster and answers for the VIP, 'unknown' when the agent didn't report it.",
+          "type": "string"
+        },
         "ip": {
           "description": "The IP address.",
           "$ref": "#/definitions/ip"
@@ -17987,54 +20678,219 @@ func init() {
         "url": {
           "description": "URL address of the API.",
           "type": "string"
-        },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
-          "type": "boolean"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
+      "type": "object",
+      "properties": {
+        "download_error": {
+          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
+          "type": "string"
+        },
+        "ignition": {
+          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
+          "type": "string"
+        },
+        "is_success": {
+          "description": "Whether the agent was able to download the ignition or not",
+          "type": "boolean"
+        },
+        "url": {
+          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
+          "type": "string"
+        }
+      }
+    },
+    "architecture-support-level-id": {
+      "type": "string",
+      "enum": [
+        "X86_64_ARCHITECTURE",
+        "ARM64_ARCHITECTURE",
+        "PPC64LE_ARCHITECTURE",
+        "S390X_ARCHITECTURE",
+        "MULTIARCH_RELEASE_IMAGE"
+      ]
+    },
+    "bind-host-params": {
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "bmc-action": {
+      "description": "The action to run through the BMC of a host.\nboot-discovery-image: inserts the discovery image of the infra-env as virtual media, sets it as the one-time boot device and power cycles the host.\npower-cycle: power cycles the host, or powers it on when it is off.\nget-inventory: only reads the inventory of the BMC.\n",
+      "type": "string",
+      "enum": [
+        "boot-discovery-image",
+        "power-cycle",
+        "get-inventory"
+      ]
+    },
+    "bmc-action-params": {
+      "type": "object",
+      "required": [
+        "action",
+        "bmc_reference_id"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/bmc-action"
+        },
+        "bmc_reference_id": {
+          "description": "The registered BMC of the host to run the action on.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "bmc-inventory": {
+      "description": "The inventory of a host as reported by its BMC.",
+      "type": "object",
+      "properties": {
+        "bios_version": {
+          "type": "string"
+        },
+        "cpu_count": {
+          "type": "integer"
+        },
+        "driver": {
+          "description": "The driver used to connect to the BMC.",
+          "type": "string",
+          "enum": [
+            "redfish",
+            "ipmi"
+          ]
+        },
+        "manufacturer": {
+          "type": "string"
+        },
+        "memory_gib": {
+          "type": "number"
+        },
+        "model": {
+          "type": "string"
+        },
+        "power_state": {
+          "description": "The power state of the host, for example On or Off.",
+          "type": "string"
+        },
+        "serial_number": {
+          "type": "string"
+        },
+        "virtual_media_supported": {
+          "description": "Whether the BMC can boot the host from virtual media.",
+          "type": "boolean"
+        }
+      }
+    },
+    "bmc-reference": {
+      "description": "The BMC of a host of an infra-env.  The credentials of the BMC are kept in the secret store of the service.",
+      "type": "object",
+      "required": [
+        "id",
+        "infra_env_id",
+        "address",
+        "username"
+      ],
+      "properties": {
+        "address": {
+          "description": "The address of the host's BMC.",
+          "type": "string"
+        },
+        "certificate_verification": {
+          "description": "Whether certificate verification is enabled or disabled when connecting to the host's BMC.",
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env whose hosts the BMC belongs to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "name": {
+          "description": "A name that identifies the host of the BMC, for example its hostname.",
+          "type": "string"
+        },
+        "username": {
+          "description": "The username to connect to the host's BMC.",
+          "type": "string"
         }
       }
     },
-    "api_vip_connectivity_response": {
-      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
+    "bmc-reference-create-params": {
       "type": "object",
+      "required": [
+        "address",
+        "username",
+        "password"
+      ],
       "properties": {
-        "download_error": {
-          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
+        "address": {
+          "description": "The address of the host's BMC, for example redfish-virtualmedia://bmc1.example.com/redfish/v1/Systems/1 or ipmi://bmc1.example.com:623.",
           "type": "string"
         },
-        "ignition": {
-          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
+        "certificate_verification": {
+          "description": "Whether to enable or disable certificate verification when connecting to the host's BMC.",
+          "type": "string",
+          "default": "Enabled",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "name": {
+          "description": "A name that identifies the host of the BMC, for example its hostname.",
           "type": "string"
         },
-        "is_success": {
-          "description": "Whether the agent was able to download the ignition or not",
-          "type": "boolean"
+        "password": {
+          "description": "The password to connect to the host's BMC.",
+          "type": "string",
+          "format": "password"
         },
-        "url": {
-          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
+        "username": {
+          "description": "The username to connect to the host's BMC.",
           "type": "string"
         }
       }
     },
-    "architecture-support-level-id": {
-      "type": "string",
-      "enum": [
-        "X86_64_ARCHITECTURE",
-        "ARM64_ARCHITECTURE",
-        "PPC64LE_ARCHITECTURE",
-        "S390X_ARCHITECTURE",
-        "MULTIARCH_RELEASE_IMAGE"
-      ]
-    },
-    "bind-host-params": {
-      "required": [
-        "cluster_id"
-      ],
-      "properties": {
-        "cluster_id": {
-          "type": "string",
-          "format": "uuid"
-        }
+    "bmc-reference-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/bmc-reference"
       }
     },
     "boot": {
@@ -18223,6 +21079,12 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "federation_instance_id": {
+          "description": "The federation member instance that owns the cluster, set in the federated responses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"-\"",
+          "readOnly": true
+        },
         "high_availability_mode": {
           "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
@@ -18408,6 +21270,9 @@ func init() {
           "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
+        "operators_catalog_source": {
+          "$ref": "#/definitions/operators-catalog-source"
+        },
         "org_id": {
           "type": "string"
         },
@@ -18694,6 +21559,9 @@ func init() {
           "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
+        "operators_catalog_source": {
+          "$ref": "#/definitions/operators-catalog-source"
+        },
         "platform": {
           "x-nullable": true,
           "$ref": "#/definitions/platform"
@@ -18869,6 +21737,7 @@ func init() {
         "api-vips-valid",
         "ingress-vips-defined",
         "ingress-vips-valid",
+        "vips-conflict-free",
         "all-hosts-are-ready-to-install",
         "sufficient-masters-count",
         "dns-domain-defined",
@@ -18905,7 +21774,8 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "declarative-operators-requirements-satisfied"
       ]
     },
     "cluster_default_config": {
@@ -19662,6 +22532,12 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "federation_instance_id": {
+          "description": "The federation member instance that reported the event, set in the federated responses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"-\"",
+          "readOnly": true
+        },
         "host_id": {
           "description": "Unique identifier of the host this event relates to.",
           "type": "string",
@@ -19790,10 +22666,50 @@ func init() {
         "METALLB",
         "DUAL_STACK_PRIMARY_IPV6",
         "LOKI",
-        "OPENSHIFT_LOGGING"
+        "OPENSHIFT_LOGGING",
+        "DECLARATIVE_OPERATOR"
       ],
       "x-nullable": false
     },
+    "federation-member": {
+      "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
+      "properties": {
+        "id": {
+          "description": "Unique identifier of the assisted-service instance in the federation.",
+          "type": "string"
+        },
+        "last_seen_at": {
+          "description": "The time the instance last renewed its registration.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "region": {
+          "description": "The region of the assisted-service instance.",
+          "type": "string"
+        },
+        "registered_at": {
+          "description": "The time the instance first registered in the directory.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "url": {
+          "description": "The base URL of the assisted-service instance, e.g. https://assisted.us-east.example.com.",
+          "type": "string"
+        }
+      }
+    },
+    "federation-member-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/federation-member"
+      }
+    },
     "fencing-credentials-params": {
       "type": "object",
       "required": [
@@ -20228,6 +23144,24 @@ func init() {
         }
       }
     },
+    "host-ip-stack-readiness": {
+      "type": "object",
+      "properties": {
+        "address_families": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/address-family-readiness"
+          }
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        }
+      }
+    },
     "host-list": {
       "type": "array",
       "items": {
@@ -20463,6 +23397,9 @@ func init() {
         "openshift-ai-requirements-satisfied",
         "authorino-requirements-satisfied",
         "mtu-valid",
+        "bond-members-consistent",
+        "vlan-interfaces-consistent",
+        "vlan-subnets-consistent",
         "nmstate-requirements-satisfied",
         "amd-gpu-requirements-satisfied",
         "kmm-requirements-satisfied",
@@ -20476,7 +23413,8 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "declarative-operators-requirements-satisfied"
       ]
     },
     "host_network": {
@@ -21026,6 +23964,10 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
+        "conflicting_mac_address": {
+          "description": "MAC address of a device that is not part of the cluster and answers for the VIP, 'unknown' when the agent didn't report it.",
+          "type": "string"
+        },
         "ip": {
           "description": "The IP address.",
           "$ref": "#/definitions/ip"
@@ -21172,6 +24114,10 @@ func init() {
         "client_id": {
           "type": "string"
         },
+        "controller": {
+          "description": "Name of the bond or team interface that this interface is a port of. Not reported by older agents, the bond and team validation is skipped without it.",
+          "type": "string"
+        },
         "flags": {
           "type": "array",
           "items": {
@@ -21213,6 +24159,14 @@ func init() {
         },
         "vendor": {
           "type": "string"
+        },
+        "vlan_base_interface": {
+          "description": "Name of the interface that a VLAN interface is created on. Not reported by older agents, it is then parsed from the \u003cbase\u003e.\u003cid\u003e name of the interface.",
+          "type": "string"
+        },
+        "vlan_id": {
+          "description": "VLAN ID of a VLAN interface. Not reported by older agents, the VLAN ID is then parsed from the \u003cbase\u003e.\u003cid\u003e name of the interface.",
+          "type": "integer"
         }
       }
     },
@@ -21288,6 +24242,91 @@ func init() {
       "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
       "x-go-custom-tag": "gorm:\"primaryKey\""
     },
+    "ip-stack-check": {
+      "type": "object",
+      "required": [
+        "name",
+        "status"
+      ],
+      "properties": {
+        "message": {
+          "type": "string"
+        },
+        "name": {
+          "description": "The checked item. The dns checks verify that the domain names of the API, of the applications and of the release image registry resolve to addresses of the family, they do not connect to them. The registry-reachability check uses the image pulls reported by the agent, it is unknown when the pulls can't be attributed to the family.",
+          "type": "string",
+          "enum": [
+            "addresses",
+            "default-route",
+            "api-dns",
+            "apps-dns",
+            "ntp",
+            "registry-dns",
+            "registry-reachability",
+            "mtu"
+          ]
+        },
+        "status": {
+          "description": "The check result. Unknown means that the host has not reported the data needed for the check yet.",
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "unknown"
+          ]
+        }
+      }
+    },
+    "ip-stack-readiness": {
+      "description": "Readiness of the cluster hosts for each IP address family.",
+      "type": "object",
+      "required": [
+        "ipv6_primary_ready",
+        "ipv6_only_ready"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-ip-stack-readiness"
+          }
+        },
+        "ipv6_only_blockers": {
+          "description": "The reasons that prevent the cluster from being installed as a single-stack IPv6 cluster.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_only_ready": {
+          "description": "Whether the cluster can be installed as a single-stack IPv6 cluster, only the IPv6 checks are considered.",
+          "type": "boolean"
+        },
+        "ipv6_primary_blockers": {
+          "description": "The reasons that prevent the cluster from using IPv6 as the primary IP stack of a dual-stack cluster.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_primary_ready": {
+          "description": "Whether the cluster can use IPv6 as the primary IP stack of a dual-stack cluster, which requires both address families.",
+          "type": "boolean"
+        },
+        "primary_ip_stack": {
+          "description": "The primary IP stack currently configured for a dual-stack cluster, if any.",
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        }
+      }
+    },
     "iscsi": {
       "type": "object",
       "properties": {
@@ -21612,16 +24651,27 @@ func init() {
             "type": "StringArray"
           }
         },
+        "channel": {
+          "description": "The subscription channel the operator is pinned to. Empty for the default channel of the operator.",
+          "type": "string"
+        },
         "cluster_id": {
           "description": "The cluster that this operator is associated with.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
+        "day2": {
+          "description": "Whether the operator was installed after the installation of the cluster.",
+          "type": "boolean"
+        },
         "dependency_only": {
           "description": "Whether the operator can't be installed without being required by another operator.",
           "type": "boolean"
         },
+        "install_plan_approval": {
+          "$ref": "#/definitions/operator-install-plan-approval"
+        },
         "name": {
           "description": "Unique name of the operator.",
           "type": "string",
@@ -21639,6 +24689,10 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "starting_csv": {
+          "description": "The cluster service version the subscription of the operator starts from. Empty for the latest version of the channel.",
+          "type": "string"
+        },
         "status": {
           "$ref": "#/definitions/operator-status"
         },
@@ -21666,22 +24720,162 @@ func init() {
         }
       }
     },
+    "monitored-operator-health-history": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator-health-record"
+      }
+    },
+    "monitored-operator-health-record": {
+      "description": "A change of the status of an operator observed after the installation of the cluster.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this operator is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "id": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "operator_name": {
+          "description": "Unique name of the operator.",
+          "type": "string"
+        },
+        "reported_at": {
+          "description": "Time at which the status was observed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        }
+      }
+    },
     "monitored-operators-list": {
       "type": "array",
       "items": {
         "$ref": "#/definitions/monitored-operator"
       }
     },
-    "mtu-report": {
+    "mtu-report": {
+      "type": "object",
+      "properties": {
+        "mtu_successful": {
+          "type": "boolean"
+        },
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        }
+      }
+    },
+    "network-topology": {
+      "description": "Graph of the hosts, interfaces, subnets and VIPs of a cluster and the reachability observed between the hosts.",
+      "type": "object",
+      "properties": {
+        "dot": {
+          "description": "Graphviz DOT rendering of the graph.",
+          "type": "string"
+        },
+        "edges": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-edge"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-node"
+          }
+        }
+      }
+    },
+    "network-topology-edge": {
+      "type": "object",
+      "required": [
+        "source",
+        "target",
+        "kind"
+      ],
+      "properties": {
+        "attributes": {
+          "description": "Additional properties of the edge, e.g. the address assigned to an interface or the outgoing NIC of a connectivity check.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "interface",
+            "address",
+            "vip",
+            "default-route",
+            "l2-connectivity",
+            "l3-connectivity"
+          ]
+        },
+        "source": {
+          "description": "Identifier of the node the edge starts from.",
+          "type": "string"
+        },
+        "successful": {
+          "description": "For connectivity edges, whether the check between the hosts succeeded.",
+          "type": "boolean"
+        },
+        "target": {
+          "description": "Identifier of the node the edge ends at.",
+          "type": "string"
+        }
+      }
+    },
+    "network-topology-node": {
       "type": "object",
+      "required": [
+        "id",
+        "kind"
+      ],
       "properties": {
-        "mtu_successful": {
-          "type": "boolean"
+        "attributes": {
+          "description": "Additional properties of the node, e.g. the MAC address and MTU of an interface.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         },
-        "outgoing_nic": {
+        "host_id": {
+          "description": "The host the node belongs to. Set for host and interface nodes.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "description": "Unique identifier of the node within the graph.",
           "type": "string"
         },
-        "remote_ip_address": {
+        "kind": {
+          "type": "string",
+          "enum": [
+            "host",
+            "interface",
+            "subnet",
+            "vip",
+            "gateway"
+          ]
+        },
+        "label": {
+          "description": "Human readable name of the node.",
           "type": "string"
         }
       }
@@ -21844,13 +25038,74 @@ func init() {
     "operator-create-params": {
       "type": "object",
       "properties": {
+        "channel": {
+          "description": "The subscription channel to pin the operator to, one of the channels known for the OpenShift version of the cluster.",
+          "type": "string"
+        },
+        "install_plan_approval": {
+          "$ref": "#/definitions/operator-install-plan-approval"
+        },
         "name": {
           "type": "string"
         },
         "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "description": "Blob of operator-dependent parameters that are required for installation. For the operators that describe\ntheir properties it is a JSON object validated against them.\n",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "starting_csv": {
+          "description": "The cluster service version the subscription of the operator starts from.",
+          "type": "string"
+        }
+      }
+    },
+    "operator-dependency-conflict": {
+      "type": "object",
+      "properties": {
+        "feature_id": {
+          "description": "The feature that the operator is incompatible with.",
+          "$ref": "#/definitions/feature-support-level-id"
+        },
+        "message": {
+          "type": "string"
+        },
+        "operator": {
+          "description": "Name of the operator of the graph.",
+          "type": "string"
+        }
+      }
+    },
+    "operator-dependency-node": {
+      "type": "object",
+      "properties": {
+        "dependencies": {
+          "description": "Names of the operators that this operator depends on.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Unique name of the operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "string"
+        },
+        "requested": {
+          "description": "Whether the operator was requested explicitly or is only pulled in as a dependency.",
+          "type": "boolean"
+        },
+        "required_by": {
+          "description": "Names of the operators of the graph that depend on this operator.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        },
+        "support_level": {
+          "description": "Support level of the operator, only set when the OpenShift version is known.",
+          "$ref": "#/definitions/support-level"
         }
       }
     },
@@ -21886,6 +25141,14 @@ func init() {
         }
       }
     },
+    "operator-install-plan-approval": {
+      "description": "Approval of the install plans of the operator subscription. Manual prevents OLM from upgrading the operator\npast the starting cluster service version. Empty for automatic approval.\n",
+      "type": "string",
+      "enum": [
+        "Automatic",
+        "Manual"
+      ]
+    },
     "operator-monitor-report": {
       "type": "object",
       "properties": {
@@ -21916,13 +25179,14 @@ func init() {
       "type": "object",
       "properties": {
         "data_type": {
-          "description": "Type of the property",
+          "description": "Type of the property, the items of array properties are strings",
           "type": "string",
           "enum": [
             "boolean",
             "string",
             "integer",
-            "float"
+            "float",
+            "array"
           ]
         },
         "default_value": {
@@ -21937,6 +25201,16 @@ func init() {
           "description": "Indicates whether the property is reqired",
           "type": "boolean"
         },
+        "maximum": {
+          "description": "Maximum value of integer and float properties",
+          "type": "number",
+          "x-nullable": true
+        },
+        "minimum": {
+          "description": "Minimum value of integer and float properties",
+          "type": "number",
+          "x-nullable": true
+        },
         "name": {
           "description": "Name of the property",
           "type": "string"
@@ -21947,6 +25221,10 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "pattern": {
+          "description": "Regular expression matching the values of string properties, or the items of array properties",
+          "type": "string"
         }
       }
     },
@@ -21967,6 +25245,65 @@ func init() {
         "olm"
       ]
     },
+    "operators-catalog-source": {
+      "description": "Catalog source used by the subscriptions of the OLM operators instead of the default catalogs, usually the index\nimage of a mirror registry for disconnected clusters. The catalog source is created during the installation.\n",
+      "type": "object",
+      "properties": {
+        "display_name": {
+          "description": "Name of the catalog source displayed in the console.",
+          "type": "string"
+        },
+        "index_image": {
+          "description": "Pull spec of the index image. An empty index image removes the catalog source of the cluster.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the CatalogSource created in the openshift-marketplace namespace.",
+          "type": "string"
+        },
+        "packages": {
+          "description": "Packages contained in the index image. The packages of the selected operators must be part of the list.\n",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:operators_catalog_source_\""
+    },
+    "operators-dependency-graph": {
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "Features that are incompatible with the operators of the graph.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-dependency-conflict"
+          }
+        },
+        "operators": {
+          "description": "The requested operators and all the operators they depend on.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-dependency-node"
+          }
+        },
+        "requirements": {
+          "description": "Aggregated hardware requirements of all the operators of the graph.",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
     "os-image": {
       "type": "object",
       "required": [
@@ -22407,6 +25744,134 @@ func init() {
         "$ref": "#/definitions/step-reply"
       }
     },
+    "storage-sizing": {
+      "type": "object",
+      "properties": {
+        "device_paths": {
+          "description": "Patterns of the paths of the disks used by the operator.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "hosts": {
+          "description": "The hosts used by the operator and their disks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/storage-sizing-host"
+          }
+        },
+        "operator_name": {
+          "description": "The storage operator.",
+          "type": "string"
+        },
+        "raw_capacity_bytes": {
+          "description": "Total size of the disks used by the operator.",
+          "type": "integer"
+        },
+        "replica": {
+          "description": "Number of copies of the data.",
+          "type": "integer"
+        },
+        "reserved_percentage": {
+          "description": "Percentage of the disks that can't be used for data.",
+          "type": "integer"
+        },
+        "target_capacity_bytes": {
+          "description": "The capacity needed, zero when no target was set.",
+          "type": "integer"
+        },
+        "usable_capacity_bytes": {
+          "description": "Capacity available for data, once the reserved space and the copies of the data are removed.",
+          "type": "integer"
+        },
+        "warnings": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "storage-sizing-disk": {
+      "type": "object",
+      "properties": {
+        "drive_type": {
+          "$ref": "#/definitions/drive_type"
+        },
+        "id": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        }
+      }
+    },
+    "storage-sizing-host": {
+      "type": "object",
+      "properties": {
+        "disks": {
+          "description": "The disks of the host used by the operator.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/storage-sizing-disk"
+          }
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "raw_capacity_bytes": {
+          "description": "Total size of the disks of the host used by the operator.",
+          "type": "integer"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        }
+      }
+    },
+    "storage-sizing-params": {
+      "type": "object",
+      "required": [
+        "operator_name"
+      ],
+      "properties": {
+        "device_paths": {
+          "description": "Patterns of the paths of the disks used by the operator, matched against the path and the by-id path of the\ndisks. All the disks are used when empty.\n",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operator_name": {
+          "description": "The storage operator, for example odf, lvm or lso.",
+          "type": "string"
+        },
+        "replica": {
+          "description": "Number of copies of the data. The default of the operator is used when not set.",
+          "type": "integer",
+          "minimum": 1
+        },
+        "reserved_percentage": {
+          "description": "Percentage of the disks that can't be used for data. The default of the operator is used when not set.",
+          "type": "integer",
+          "maximum": 99,
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "target_capacity_gb": {
+          "description": "The capacity needed, a warning is returned when the usable capacity is below it.",
+          "type": "integer",
+          "minimum": 0
+        }
+      }
+    },
     "subnet": {
       "type": "string",
       "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
@@ -22736,6 +26201,9 @@ func init() {
             "$ref": "#/definitions/operator-create-params"
           }
         },
+        "operators_catalog_source": {
+          "$ref": "#/definitions/operators-catalog-source"
+        },
         "platform": {
           "$ref": "#/definitions/platform"
         },
@@ -22789,6 +26257,11 @@ func init() {
       "description": "Single VIP verification result.",
       "type": "object",
       "properties": {
+        "conflicting_mac_address": {
+          "description": "MAC address that answered the ARP or NDP requests sent for the VIP, if any.",
+          "type": "string",
+          "format": "mac"
+        },
         "verification": {
           "$ref": "#/definitions/vip_verification"
         },
@@ -22866,6 +26339,55 @@ func init() {
         "type": "string"
       }
     },
+    "vip-candidate": {
+      "type": "object",
+      "properties": {
+        "ip": {
+          "$ref": "#/definitions/ip"
+        },
+        "score": {
+          "description": "Rank of the candidate. Candidates with a higher score are preferred.",
+          "type": "integer"
+        },
+        "verified": {
+          "description": "Whether the free addresses scan of all the hosts reported the address as unused.",
+          "type": "boolean"
+        }
+      }
+    },
+    "vip-suggestion": {
+      "type": "object",
+      "properties": {
+        "address_family": {
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        },
+        "api_vip_candidates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/vip-candidate"
+          }
+        },
+        "ingress_vip_candidates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/vip-candidate"
+          }
+        },
+        "machine_network_cidr": {
+          "$ref": "#/definitions/subnet"
+        }
+      }
+    },
+    "vip-suggestions": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/vip-suggestion"
+      }
+    },
     "vip_type": {
       "description": "The vip type.",
       "type": "string",
@@ -22934,6 +26456,10 @@ func init() {
       "description": "Events related to a cluster installation.",
       "name": "events"
     },
+    {
+      "description": "Directory of the assisted-service instances of a federation.",
+      "name": "federation"
+    },
     {
       "description": "General OpenShift cluster installation APIs.",
       "name": "installer"
@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/openshift/assisted-service/restapi/operations/federation"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
@@ -105,6 +106,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerUpdateInfraEnvHandler: installer.UpdateInfraEnvHandlerFunc(func(params installer.UpdateInfraEnvParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateInfraEnv has not yet been implemented")
 		}),
+		OperatorsV2CalculateClusterStorageSizingHandler: operators.V2CalculateClusterStorageSizingHandlerFunc(func(params operators.V2CalculateClusterStorageSizingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2CalculateClusterStorageSizing has not yet been implemented")
+		}),
 		InstallerV2CancelInstallationHandler: installer.V2CancelInstallationHandlerFunc(func(params installer.V2CancelInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2CancelInstallation has not yet been implemented")
 		}),
@@ -114,6 +118,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		ManifestsV2DeleteClusterManifestHandler: manifests.V2DeleteClusterManifestHandlerFunc(func(params manifests.V2DeleteClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2DeleteClusterManifest has not yet been implemented")
 		}),
+		InstallerV2DeregisterBmcReferenceHandler: installer.V2DeregisterBmcReferenceHandlerFunc(func(params installer.V2DeregisterBmcReferenceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DeregisterBmcReference has not yet been implemented")
+		}),
 		InstallerV2DownloadClusterCredentialsHandler: installer.V2DownloadClusterCredentialsHandlerFunc(func(params installer.V2DownloadClusterCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadClusterCredentials has not yet been implemented")
 		}),
@@ -129,24 +136,45 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2GetClusterDefaultConfigHandler: installer.V2GetClusterDefaultConfigHandlerFunc(func(params installer.V2GetClusterDefaultConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterDefaultConfig has not yet been implemented")
 		}),
+		InstallerV2GetClusterIPStackReadinessHandler: installer.V2GetClusterIPStackReadinessHandlerFunc(func(params installer.V2GetClusterIPStackReadinessParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterIPStackReadiness has not yet been implemented")
+		}),
+		InstallerV2GetClusterNetworkTopologyHandler: installer.V2GetClusterNetworkTopologyHandlerFunc(func(params installer.V2GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterNetworkTopology has not yet been implemented")
+		}),
 		InstallerV2GetClusterUISettingsHandler: installer.V2GetClusterUISettingsHandlerFunc(func(params installer.V2GetClusterUISettingsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterUISettings has not yet been implemented")
 		}),
+		InstallerV2GetClusterVipSuggestionsHandler: installer.V2GetClusterVipSuggestionsHandlerFunc(func(params installer.V2GetClusterVipSuggestionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterVipSuggestions has not yet been implemented")
+		}),
 		InstallerV2GetCredentialsHandler: installer.V2GetCredentialsHandlerFunc(func(params installer.V2GetCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetCredentials has not yet been implemented")
 		}),
+		OperatorsV2GetOperatorsDependencyGraphHandler: operators.V2GetOperatorsDependencyGraphHandlerFunc(func(params operators.V2GetOperatorsDependencyGraphParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2GetOperatorsDependencyGraph has not yet been implemented")
+		}),
 		InstallerV2GetPresignedForClusterCredentialsHandler: installer.V2GetPresignedForClusterCredentialsHandlerFunc(func(params installer.V2GetPresignedForClusterCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetPresignedForClusterCredentials has not yet been implemented")
 		}),
 		InstallerV2GetPresignedForClusterFilesHandler: installer.V2GetPresignedForClusterFilesHandlerFunc(func(params installer.V2GetPresignedForClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetPresignedForClusterFiles has not yet been implemented")
 		}),
+		OperatorsV2InstallClusterOperatorsHandler: operators.V2InstallClusterOperatorsHandlerFunc(func(params operators.V2InstallClusterOperatorsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2InstallClusterOperators has not yet been implemented")
+		}),
+		InstallerV2ListBmcReferencesHandler: installer.V2ListBmcReferencesHandlerFunc(func(params installer.V2ListBmcReferencesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListBmcReferences has not yet been implemented")
+		}),
 		OperatorsV2ListBundlesHandler: operators.V2ListBundlesHandlerFunc(func(params operators.V2ListBundlesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ListBundles has not yet been implemented")
 		}),
 		ManifestsV2ListClusterManifestsHandler: manifests.V2ListClusterManifestsHandlerFunc(func(params manifests.V2ListClusterManifestsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2ListClusterManifests has not yet been implemented")
 		}),
+		OperatorsV2ListClusterOperatorsHealthHistoryHandler: operators.V2ListClusterOperatorsHealthHistoryHandlerFunc(func(params operators.V2ListClusterOperatorsHealthHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2ListClusterOperatorsHealthHistory has not yet been implemented")
+		}),
 		ManagedDomainsV2ListManagedDomainsHandler: managed_domains.V2ListManagedDomainsHandlerFunc(func(params managed_domains.V2ListManagedDomainsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation managed_domains.V2ListManagedDomains has not yet been implemented")
 		}),
@@ -159,6 +187,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		OperatorsV2ListSupportedOperatorsHandler: operators.V2ListSupportedOperatorsHandlerFunc(func(params operators.V2ListSupportedOperatorsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ListSupportedOperators has not yet been implemented")
 		}),
+		InstallerV2RegisterBmcReferenceHandler: installer.V2RegisterBmcReferenceHandlerFunc(func(params installer.V2RegisterBmcReferenceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2RegisterBmcReference has not yet been implemented")
+		}),
+		InstallerV2RunBmcActionHandler: installer.V2RunBmcActionHandlerFunc(func(params installer.V2RunBmcActionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2RunBmcAction has not yet been implemented")
+		}),
 		InstallerV2UpdateClusterHandler: installer.V2UpdateClusterHandlerFunc(func(params installer.V2UpdateClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateCluster has not yet been implemented")
 		}),
@@ -228,6 +262,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		EventsV2ListEventsHandler: events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2ListEvents has not yet been implemented")
 		}),
+		FederationV2ListFederationMembersHandler: federation.V2ListFederationMembersHandlerFunc(func(params federation.V2ListFederationMembersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation federation.V2ListFederationMembers has not yet been implemented")
+		}),
 		InstallerV2ListHostsHandler: installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListHosts has not yet been implemented")
 		}),
@@ -246,6 +283,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2RegisterDisconnectedClusterHandler: installer.V2RegisterDisconnectedClusterHandlerFunc(func(params installer.V2RegisterDisconnectedClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterDisconnectedCluster has not yet been implemented")
 		}),
+		FederationV2RegisterFederationMemberHandler: federation.V2RegisterFederationMemberHandlerFunc(func(params federation.V2RegisterFederationMemberParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation federation.V2RegisterFederationMember has not yet been implemented")
+		}),
 		InstallerV2RegisterHostHandler: installer.V2RegisterHostHandlerFunc(func(params installer.V2RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterHost has not yet been implemented")
 		}),
@@ -426,12 +466,16 @@ type AssistedInstallAPI struct {
 	InstallerUnbindHostHandler installer.UnbindHostHandler
 	// InstallerUpdateInfraEnvHandler sets the operation handler for the update infra env operation
 	InstallerUpdateInfraEnvHandler installer.UpdateInfraEnvHandler
+	// OperatorsV2CalculateClusterStorageSizingHandler sets the operation handler for the v2 calculate cluster storage sizing operation
+	OperatorsV2CalculateClusterStorageSizingHandler operators.V2CalculateClusterStorageSizingHandler
 	// InstallerV2CancelInstallationHandler sets the operation handler for the v2 cancel installation operation
 	InstallerV2CancelInstallationHandler installer.V2CancelInstallationHandler
 	// ManifestsV2CreateClusterManifestHandler sets the operation handler for the v2 create cluster manifest operation
 	ManifestsV2CreateClusterManifestHandler manifests.V2CreateClusterManifestHandler
 	// ManifestsV2DeleteClusterManifestHandler sets the operation handler for the v2 delete cluster manifest operation
 	ManifestsV2DeleteClusterManifestHandler manifests.V2DeleteClusterManifestHandler
+	// InstallerV2DeregisterBmcReferenceHandler sets the operation handler for the v2 deregister bmc reference operation
+	InstallerV2DeregisterBmcReferenceHandler installer.V2DeregisterBmcReferenceHandler
 	// InstallerV2DownloadClusterCredentialsHandler sets the operation handler for the v2 download cluster credentials operation
 	InstallerV2DownloadClusterCredentialsHandler installer.V2DownloadClusterCredentialsHandler
 	// InstallerV2DownloadClusterFilesHandler sets the operation handler for the v2 download cluster files operation
@@ -442,18 +486,32 @@ type AssistedInstallAPI struct {
 	OperatorsV2GetBundleHandler operators.V2GetBundleHandler
 	// InstallerV2GetClusterDefaultConfigHandler sets the operation handler for the v2 get cluster default config operation
 	InstallerV2GetClusterDefaultConfigHandler installer.V2GetClusterDefaultConfigHandler
+	// InstallerV2GetClusterIPStackReadinessHandler sets the operation handler for the v2 get cluster IP stack readiness operation
+	InstallerV2GetClusterIPStackReadinessHandler installer.V2GetClusterIPStackReadinessHandler
+	// InstallerV2GetClusterNetworkTopologyHandler sets the operation handler for the v2 get cluster network topology operation
+	InstallerV2GetClusterNetworkTopologyHandler installer.V2GetClusterNetworkTopologyHandler
 	// InstallerV2GetClusterUISettingsHandler sets the operation handler for the v2 get cluster UI settings operation
 	InstallerV2GetClusterUISettingsHandler installer.V2GetClusterUISettingsHandler
+	// InstallerV2GetClusterVipSuggestionsHandler sets the operation handler for the v2 get cluster vip suggestions operation
+	InstallerV2GetClusterVipSuggestionsHandler installer.V2GetClusterVipSuggestionsHandler
 	// InstallerV2GetCredentialsHandler sets the operation handler for the v2 get credentials operation
 	InstallerV2GetCredentialsHandler installer.V2GetCredentialsHandler
+	// OperatorsV2GetOperatorsDependencyGraphHandler sets the operation handler for the v2 get operators dependency graph operation
+	OperatorsV2GetOperatorsDependencyGraphHandler operators.V2GetOperatorsDependencyGraphHandler
 	// InstallerV2GetPresignedForClusterCredentialsHandler sets the operation handler for the v2 get presigned for cluster credentials operation
 	InstallerV2GetPresignedForClusterCredentialsHandler installer.V2GetPresignedForClusterCredentialsHandler
 	// InstallerV2GetPresignedForClusterFilesHandler sets the operation handler for the v2 get presigned for cluster files operation
 	InstallerV2GetPresignedForClusterFilesHandler installer.V2GetPresignedForClusterFilesHandler
+	// OperatorsV2InstallClusterOperatorsHandler sets the operation handler for the v2 install cluster operators operation
+	OperatorsV2InstallClusterOperatorsHandler operators.V2InstallClusterOperatorsHandler
+	// InstallerV2ListBmcReferencesHandler sets the operation handler for the v2 list bmc references operation
+	InstallerV2ListBmcReferencesHandler installer.V2ListBmcReferencesHandler
 	// OperatorsV2ListBundlesHandler sets the operation handler for the v2 list bundles operation
 	OperatorsV2ListBundlesHandler operators.V2ListBundlesHandler
 	// ManifestsV2ListClusterManifestsHandler sets the operation handler for the v2 list cluster manifests operation
 	ManifestsV2ListClusterManifestsHandler manifests.V2ListClusterManifestsHandler
+	// OperatorsV2ListClusterOperatorsHealthHistoryHandler sets the operation handler for the v2 list cluster operators health history operation
+	OperatorsV2ListClusterOperatorsHealthHistoryHandler operators.V2ListClusterOperatorsHealthHistoryHandler
 	// ManagedDomainsV2ListManagedDomainsHandler sets the operation handler for the v2 list managed domains operation
 	ManagedDomainsV2ListManagedDomainsHandler managed_domains.V2ListManagedDomainsHandler
 	// OperatorsV2ListOfClusterOperatorsHandler sets the operation handler for the v2 list of cluster operators operation
@@ -462,6 +520,10 @@ type AssistedInstallAPI struct {
 	OperatorsV2ListOperatorPropertiesHandler operators.V2ListOperatorPropertiesHandler
 	// OperatorsV2ListSupportedOperatorsHandler sets the operation handler for the v2 list supported operators operation
 	OperatorsV2ListSupportedOperatorsHandler operators.V2ListSupportedOperatorsHandler
+	// InstallerV2RegisterBmcReferenceHandler sets the operation handler for the v2 register bmc reference operation
+	InstallerV2RegisterBmcReferenceHandler installer.V2RegisterBmcReferenceHandler
+	// InstallerV2RunBmcActionHandler sets the operation handler for the v2 run bmc action operation
+	InstallerV2RunBmcActionHandler installer.V2RunBmcActionHandler
 	// InstallerV2UpdateClusterHandler sets the operation handler for the v2 update cluster operation
 	InstallerV2UpdateClusterHandler installer.V2UpdateClusterHandler
 	// ManifestsV2UpdateClusterManifestHandler sets the operation handler for the v2 update cluster manifest operation
@@ -508,6 +570,8 @@ type AssistedInstallAPI struct {
 	VersionsV2ListComponentVersionsHandler versions.V2ListComponentVersionsHandler
 	// EventsV2ListEventsHandler sets the operation handler for the v2 list events operation
 	EventsV2ListEventsHandler events.V2ListEventsHandler
+	// FederationV2ListFederationMembersHandler sets the operation handler for the v2 list federation members operation
+	FederationV2ListFederationMembersHandler federation.V2ListFederationMembersHandler
 	// InstallerV2ListHostsHandler sets the operation handler for the v2 list hosts operation
 	InstallerV2ListHostsHandler installer.V2ListHostsHandler
 	// VersionsV2ListReleaseSourcesHandler sets the operation handler for the v2 list release sources operation
@@ -520,6 +584,8 @@ type AssistedInstallAPI struct {
 	InstallerV2RegisterClusterHandler installer.V2RegisterClusterHandler
 	// InstallerV2RegisterDisconnectedClusterHandler sets the operation handler for the v2 register disconnected cluster operation
 	InstallerV2RegisterDisconnectedClusterHandler installer.V2RegisterDisconnectedClusterHandler
+	// FederationV2RegisterFederationMemberHandler sets the operation handler for the v2 register federation member operation
+	FederationV2RegisterFederationMemberHandler federation.V2RegisterFederationMemberHandler
 	// InstallerV2RegisterHostHandler sets the operation handler for the v2 register host operation
 	InstallerV2RegisterHostHandler installer.V2RegisterHostHandler
 	// OperatorsV2ReportMonitoredOperatorStatusHandler sets the operation handler for the v2 report monitored operator status operation
@@ -708,6 +774,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerUpdateInfraEnvHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateInfraEnvHandler")
 	}
+	if o.OperatorsV2CalculateClusterStorageSizingHandler == nil {
+		unregistered = append(unregistered, "operators.V2CalculateClusterStorageSizingHandler")
+	}
 	if o.InstallerV2CancelInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.V2CancelInstallationHandler")
 	}
@@ -717,6 +786,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.ManifestsV2DeleteClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.V2DeleteClusterManifestHandler")
 	}
+	if o.InstallerV2DeregisterBmcReferenceHandler == nil {
+		unregistered = append(unregistered, "installer.V2DeregisterBmcReferenceHandler")
+	}
 	if o.InstallerV2DownloadClusterCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadClusterCredentialsHandler")
 	}
@@ -732,24 +804,45 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2GetClusterDefaultConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterDefaultConfigHandler")
 	}
+	if o.InstallerV2GetClusterIPStackReadinessHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterIPStackReadinessHandler")
+	}
+	if o.InstallerV2GetClusterNetworkTopologyHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterNetworkTopologyHandler")
+	}
 	if o.InstallerV2GetClusterUISettingsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterUISettingsHandler")
 	}
+	if o.InstallerV2GetClusterVipSuggestionsHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterVipSuggestionsHandler")
+	}
 	if o.InstallerV2GetCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetCredentialsHandler")
 	}
+	if o.OperatorsV2GetOperatorsDependencyGraphHandler == nil {
+		unregistered = append(unregistered, "operators.V2GetOperatorsDependencyGraphHandler")
+	}
 	if o.InstallerV2GetPresignedForClusterCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetPresignedForClusterCredentialsHandler")
 	}
 	if o.InstallerV2GetPresignedForClusterFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetPresignedForClusterFilesHandler")
 	}
+	if o.OperatorsV2InstallClusterOperatorsHandler == nil {
+		unregistered = append(unregistered, "operators.V2InstallClusterOperatorsHandler")
+	}
+	if o.InstallerV2ListBmcReferencesHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListBmcReferencesHandler")
+	}
 	if o.OperatorsV2ListBundlesHandler == nil {
 		unregistered = append(unregistered, "operators.V2ListBundlesHandler")
 	}
 	if o.ManifestsV2ListClusterManifestsHandler == nil {
 		unregistered = append(unregistered, "manifests.V2ListClusterManifestsHandler")
 	}
+	if o.OperatorsV2ListClusterOperatorsHealthHistoryHandler == nil {
+		unregistered = append(unregistered, "operators.V2ListClusterOperatorsHealthHistoryHandler")
+	}
 	if o.ManagedDomainsV2ListManagedDomainsHandler == nil {
 		unregistered = append(unregistered, "managed_domains.V2ListManagedDomainsHandler")
 	}
@@ -762,6 +855,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.OperatorsV2ListSupportedOperatorsHandler == nil {
 		unregistered = append(unregistered, "operators.V2ListSupportedOperatorsHandler")
 	}
+	if o.InstallerV2RegisterBmcReferenceHandler == nil {
+		unregistered = append(unregistered, "installer.V2RegisterBmcReferenceHandler")
+	}
+	if o.InstallerV2RunBmcActionHandler == nil {
+		unregistered = append(unregistered, "installer.V2RunBmcActionHandler")
+	}
 	if o.InstallerV2UpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterHandler")
 	}
@@ -831,6 +930,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.EventsV2ListEventsHandler == nil {
 		unregistered = append(unregistered, "events.V2ListEventsHandler")
 	}
+	if o.FederationV2ListFederationMembersHandler == nil {
+		unregistered = append(unregistered, "federation.V2ListFederationMembersHandler")
+	}
 	if o.InstallerV2ListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListHostsHandler")
 	}
@@ -849,6 +951,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2RegisterDisconnectedClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterDisconnectedClusterHandler")
 	}
+	if o.FederationV2RegisterFederationMemberHandler == nil {
+		unregistered = append(unregistered, "federation.V2RegisterFederationMemberHandler")
+	}
 	if o.InstallerV2RegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterHostHandler")
 	}
@@ -1093,6 +1198,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/storage-sizing"] = operators.NewV2CalculateClusterStorageSizing(o.context, o.OperatorsV2CalculateClusterStorageSizingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/cancel"] = installer.NewV2CancelInstallation(o.context, o.InstallerV2CancelInstallationHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1102,6 +1211,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/v2/clusters/{cluster_id}/manifests"] = manifests.NewV2DeleteClusterManifest(o.context, o.ManifestsV2DeleteClusterManifestHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/infra-envs/{infra_env_id}/bmc-references/{bmc_reference_id}"] = installer.NewV2DeregisterBmcReference(o.context, o.InstallerV2DeregisterBmcReferenceHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1125,19 +1238,43 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/ip-stack-readiness"] = installer.NewV2GetClusterIPStackReadiness(o.context, o.InstallerV2GetClusterIPStackReadinessHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/network-topology"] = installer.NewV2GetClusterNetworkTopology(o.context, o.InstallerV2GetClusterNetworkTopologyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/ui-settings"] = installer.NewV2GetClusterUISettings(o.context, o.InstallerV2GetClusterUISettingsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/vip-suggestions"] = installer.NewV2GetClusterVipSuggestions(o.context, o.InstallerV2GetClusterVipSuggestionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/credentials"] = installer.NewV2GetCredentials(o.context, o.InstallerV2GetCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/operators/dependency-graph"] = operators.NewV2GetOperatorsDependencyGraph(o.context, o.OperatorsV2GetOperatorsDependencyGraphHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/downloads/credentials-presigned"] = installer.NewV2GetPresignedForClusterCredentials(o.context, o.InstallerV2GetPresignedForClusterCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/downloads/files-presigned"] = installer.NewV2GetPresignedForClusterFiles(o.context, o.InstallerV2GetPresignedForClusterFilesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/monitored-operators"] = operators.NewV2InstallClusterOperators(o.context, o.OperatorsV2InstallClusterOperatorsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/bmc-references"] = installer.NewV2ListBmcReferences(o.context, o.InstallerV2ListBmcReferencesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1149,6 +1286,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/monitored-operators/health-history"] = operators.NewV2ListClusterOperatorsHealthHistory(o.context, o.OperatorsV2ListClusterOperatorsHealthHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/domains"] = managed_domains.NewV2ListManagedDomains(o.context, o.ManagedDomainsV2ListManagedDomainsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1162,6 +1303,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/supported-operators"] = operators.NewV2ListSupportedOperators(o.context, o.OperatorsV2ListSupportedOperatorsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/bmc-references"] = installer.NewV2RegisterBmcReference(o.context, o.InstallerV2RegisterBmcReferenceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/bmc-actions"] = installer.NewV2RunBmcAction(o.context, o.InstallerV2RunBmcActionHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1257,6 +1406,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/federation/members"] = federation.NewV2ListFederationMembers(o.context, o.FederationV2ListFederationMembersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2ListHosts(o.context, o.InstallerV2ListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1281,6 +1434,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/federation/members"] = federation.NewV2RegisterFederationMember(o.context, o.FederationV2RegisterFederationMemberHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2RegisterHost(o.context, o.InstallerV2RegisterHostHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -24,10 +24,14 @@ func NewV2ListEventsParams() V2ListEventsParams {
 	var (
 		// initialize parameters with default values
 
+		federatedDefault = bool(false)
+
 		orderDefault = string("ascending")
 	)
 
 	return V2ListEventsParams{
+		Federated: &federatedDefault,
+
 		Order: &orderDefault,
 	}
 }
@@ -57,6 +61,11 @@ type V2ListEventsParams struct {
 	  In: query
 	*/
 	DeletedHosts *bool
+	/*Include the events of all the member instances of the federation.
+	  In: query
+	  Default: false
+	*/
+	Federated *bool
 	/*A host in the specified cluster to return events for (DEPRECATED. Use `host_ids` instead).
 	  In: query
 	*/
@@ -123,6 +132,11 @@ func (o *V2ListEventsParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	qFederated, qhkFederated, _ := qs.GetOK("federated")
+	if err := o.bindFederated(qFederated, qhkFederated, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qHostID, qhkHostID, _ := qs.GetOK("host_id")
 	if err := o.bindHostID(qHostID, qhkHostID, route.Formats); err != nil {
 		res = append(res, err)
@@ -278,6 +292,30 @@ func (o *V2ListEventsParams) bindDeletedHosts(rawData []string, hasKey bool, for
 	return nil
 }
 
+// bindFederated binds and validates parameter Federated from query.
+func (o *V2ListEventsParams) bindFederated(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2ListEventsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("federated", "query", "bool", raw)
+	}
+	o.Federated = &value
+
+	return nil
+}
+
 // bindHostID binds and validates parameter HostID from query.
 func (o *V2ListEventsParams) bindHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
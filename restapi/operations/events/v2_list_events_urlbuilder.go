@@ -20,6 +20,7 @@ type V2ListEventsURL struct {
 	ClusterID    *strfmt.UUID
 	ClusterLevel *bool
 	DeletedHosts *bool
+	Federated    *bool
 	HostID       *strfmt.UUID
 	HostIds      []strfmt.UUID
 	InfraEnvID   *strfmt.UUID
@@ -104,6 +105,14 @@ func (o *V2ListEventsURL) Build() (*url.URL, error) {
 		qs.Set("deleted_hosts", deletedHostsQ)
 	}
 
+	var federatedQ string
+	if o.Federated != nil {
+		federatedQ = swag.FormatBool(*o.Federated)
+	}
+	if federatedQ != "" {
+		qs.Set("federated", federatedQ)
+	}
+
 	var hostIDQ string
 	if o.HostID != nil {
 		hostIDQ = o.HostID.String()
@@ -23,12 +23,15 @@ func NewV2ListClustersParams() V2ListClustersParams {
 	var (
 		// initialize parameters with default values
 
+		federatedDefault               = bool(false)
 		getUnregisteredClustersDefault = bool(false)
 
 		withHostsDefault = bool(false)
 	)
 
 	return V2ListClustersParams{
+		Federated: &federatedDefault,
+
 		GetUnregisteredClusters: &getUnregisteredClustersDefault,
 
 		WithHosts: withHostsDefault,
@@ -48,6 +51,11 @@ type V2ListClustersParams struct {
 	  In: query
 	*/
 	AmsSubscriptionIds []string
+	/*Include the clusters of all the member instances of the federation.
+	  In: query
+	  Default: false
+	*/
+	Federated *bool
 	/*Whether to return clusters that have been unregistered.
 	  In: header
 	  Default: false
@@ -84,6 +92,11 @@ func (o *V2ListClustersParams) BindRequest(r *http.Request, route *middleware.Ma
 		res = append(res, err)
 	}
 
+	qFederated, qhkFederated, _ := qs.GetOK("federated")
+	if err := o.bindFederated(qFederated, qhkFederated, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.bindGetUnregisteredClusters(r.Header[http.CanonicalHeaderKey("get_unregistered_clusters")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
@@ -135,6 +148,30 @@ func (o *V2ListClustersParams) bindAmsSubscriptionIds(rawData []string, hasKey b
 	return nil
 }
 
+// bindFederated binds and validates parameter Federated from query.
+func (o *V2ListClustersParams) bindFederated(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2ListClustersParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("federated", "query", "bool", raw)
+	}
+	o.Federated = &value
+
+	return nil
+}
+
 // bindGetUnregisteredClusters binds and validates parameter GetUnregisteredClusters from header.
 func (o *V2ListClustersParams) bindGetUnregisteredClusters(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
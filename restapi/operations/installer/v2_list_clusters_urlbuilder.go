@@ -17,6 +17,7 @@ import (
 // V2ListClustersURL generates an URL for the v2 list clusters operation
 type V2ListClustersURL struct {
 	AmsSubscriptionIds []string
+	Federated          *bool
 	OpenshiftClusterID *strfmt.UUID
 	Owner              *string
 	WithHosts          bool
@@ -72,6 +73,14 @@ func (o *V2ListClustersURL) Build() (*url.URL, error) {
 		}
 	}
 
+	var federatedQ string
+	if o.Federated != nil {
+		federatedQ = swag.FormatBool(*o.Federated)
+	}
+	if federatedQ != "" {
+		qs.Set("federated", federatedQ)
+	}
+
 	var openshiftClusterIDQ string
 	if o.OpenshiftClusterID != nil {
 		openshiftClusterIDQ = o.OpenshiftClusterID.String()